@@ -25,8 +25,10 @@ type apiServer struct {
 	log          logger.Logger
 	api          resolvers.ApiResolver
 	srv          *http.Server
+	adm          *http.Server
 	closed       chan interface{}
 	isVersionReq bool
+	command      []string
 }
 
 // init initializes the API server
@@ -54,8 +56,15 @@ func (app *apiServer) init() {
 	svc.SetConfig(app.cfg)
 	svc.SetLogger(app.log)
 
+	// a command requested instead of the server? no HTTP server is needed
+	app.command = flag.Args()
+	if len(app.command) > 0 {
+		return
+	}
+
 	// make the HTTP server
 	app.makeHttpServer()
+	app.makeAdminServer()
 }
 
 // run executes the API server function.
@@ -66,6 +75,15 @@ func (app *apiServer) run() {
 		return
 	}
 
+	// execute the requested command, if any
+	if len(app.command) > 0 {
+		if err := app.execute(app.command); err != nil {
+			app.log.Criticalf("%s failed; %s", app.command[0], err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	// make sure to capture terminate signals
 	app.observeSignals()
 
@@ -76,6 +94,11 @@ func (app *apiServer) run() {
 	app.log.Infof("welcome to Fantom GraphQL API server")
 	app.log.Infof("listening for requests on %s", app.cfg.Server.BindAddress)
 
+	// start the administrative API, if configured
+	if app.adm != nil {
+		go app.runAdminServer()
+	}
+
 	// listen the interface
 	err := app.srv.ListenAndServe()
 	if err != nil {
@@ -105,6 +128,35 @@ func (app *apiServer) makeHttpServer() {
 	app.setupHandlers(srvMux)
 }
 
+// makeAdminServer creates the HTTP server of the administrative API, if enabled.
+// The administrative API is served on a separate interface, so it's not exposed with the public API.
+func (app *apiServer) makeAdminServer() {
+	if app.cfg.Admin.BindAddress == "" {
+		return
+	}
+	if app.cfg.Admin.Token == "" {
+		app.log.Errorf("admin API token not configured; admin API disabled")
+		return
+	}
+
+	app.adm = &http.Server{
+		Addr:              app.cfg.Admin.BindAddress,
+		ReadTimeout:       time.Second * time.Duration(app.cfg.Server.ReadTimeout),
+		WriteTimeout:      time.Second * time.Duration(app.cfg.Server.WriteTimeout),
+		IdleTimeout:       time.Second * time.Duration(app.cfg.Server.IdleTimeout),
+		ReadHeaderTimeout: time.Second * time.Duration(app.cfg.Server.HeaderTimeout),
+		Handler:           handlers.Admin(app.cfg, app.log),
+	}
+}
+
+// runAdminServer listens for the administrative API requests.
+func (app *apiServer) runAdminServer() {
+	app.log.Noticef("listening for admin requests on %s", app.cfg.Admin.BindAddress)
+	if err := app.adm.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		app.log.Errorf("admin API server failed; %s", err.Error())
+	}
+}
+
 // setupHandlers initializes an array of handlers for our HTTP API end-points.
 func (app *apiServer) setupHandlers(mux *http.ServeMux) {
 	// create root resolver
@@ -121,6 +173,7 @@ func (app *apiServer) setupHandlers(mux *http.ServeMux) {
 
 	// setup gas price estimator REST API resolver
 	mux.Handle("/json/gas", handlers.GasPrice(app.log))
+	mux.Handle("/json/status", handlers.ServicesStatus(app.log))
 	mux.Handle("/html/validators/down", handlers.ValidatorsDownHandler(app.log))
 
 	// handle GraphiQL interface
@@ -153,6 +206,11 @@ func (app *apiServer) observeSignals() {
 		if err := app.srv.Shutdown(ct); err != nil {
 			app.log.Errorf("could not terminate HTTP listener; %s", err.Error())
 		}
+		if app.adm != nil {
+			if err := app.adm.Shutdown(ct); err != nil {
+				app.log.Errorf("could not terminate admin HTTP listener; %s", err.Error())
+			}
+		}
 
 		// we closed
 		cancel()
@@ -0,0 +1,69 @@
+// Package main implements the API server entry point.
+package main
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/repository/db"
+	"fmt"
+)
+
+// execute runs the given command of the API server instead of the server itself.
+// The supported commands are:
+//
+//	snapshot export <file>	write a snapshot archive of the indexed database into the file
+//	snapshot import <file>	restore a snapshot archive into an empty database
+//	signatures import <file>	add function signatures of the file to the database of known signatures
+func (app *apiServer) execute(args []string) error {
+	switch args[0] {
+	case "snapshot":
+		return app.snapshot(args[1:])
+	case "signatures":
+		return app.signatures(args[1:])
+	default:
+		return fmt.Errorf("unknown command %s", args[0])
+	}
+}
+
+// snapshot executes the snapshot export, or import of the indexed database.
+func (app *apiServer) snapshot(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: apiserver [options] snapshot export|import <file>")
+	}
+
+	var man *db.SnapshotManifest
+	var err error
+
+	switch args[0] {
+	case "export":
+		man, err = repository.ExportSnapshot(args[1])
+	case "import":
+		man, err = repository.ImportSnapshot(args[1])
+	default:
+		return fmt.Errorf("unknown snapshot command %s", args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	var docs uint64
+	for _, sc := range man.Collections {
+		docs += sc.Documents
+	}
+	app.log.Noticef("snapshot %s of %s done; %d collections, %d documents, last known block #%d",
+		args[0], args[1], len(man.Collections), docs, man.LastKnownBlock)
+	return nil
+}
+
+// signatures executes the import of function signatures used to decode transaction calls.
+func (app *apiServer) signatures(args []string) error {
+	if len(args) != 2 || args[0] != "import" {
+		return fmt.Errorf("usage: apiserver [options] signatures import <file>")
+	}
+
+	total, added, err := repository.ImportFunctionSignatures(args[1])
+	if err != nil {
+		return err
+	}
+	app.log.Noticef("signatures import of %s done; %d signatures found, %d new signatures added", args[1], total, added)
+	return nil
+}
@@ -20,6 +20,9 @@ type Config struct {
 	// Server configuration
 	Server Server `mapstructure:"server"`
 
+	// Admin represents the administrative API server configuration
+	Admin Admin `mapstructure:"admin"`
+
 	// Logger configuration
 	Log Log `mapstructure:"log"`
 
@@ -50,6 +53,9 @@ type Config struct {
 	// Governance configuration
 	Governance Governance `mapstructure:"governance"`
 
+	// Indexer configuration of custom contract events
+	Indexer Indexer `mapstructure:"indexer"`
+
 	// TokenLogoFilePath contains the path to JSON file with the map
 	// of known ERC20 tokens to their logo URLs.
 	// The file will be loaded on configuration loading.
@@ -65,22 +71,77 @@ type Config struct {
 
 // RepoCmd represents a repository command configuration.
 type RepoCmd struct {
-	BlockScanReScan uint64
-	RestoreStake    string
+	BlockScanReScan  uint64
+	BlockScanWorkers int
+	ReorgDepth       uint64
+	Confirmations    uint64
+	Backfill         string
+	RestoreStake     string
 }
 
 // Server represents the GraphQL server configuration
 type Server struct {
-	BindAddress     string   `mapstructure:"bind"`
-	DomainAddress   string   `mapstructure:"domain"`
-	Origin          string   `mapstructure:"origin"`
-	Peers           []string `mapstructure:"peers"`
-	CorsOrigin      []string `mapstructure:"cors_origins"`
-	ReadTimeout     int64    `mapstructure:"read_timeout"`
-	WriteTimeout    int64    `mapstructure:"write_timeout"`
-	IdleTimeout     int64    `mapstructure:"idle_timeout"`
-	HeaderTimeout   int64    `mapstructure:"header_timeout"`
-	ResolverTimeout int64    `mapstructure:"resolver_timeout"`
+	BindAddress     string        `mapstructure:"bind"`
+	DomainAddress   string        `mapstructure:"domain"`
+	Origin          string        `mapstructure:"origin"`
+	Peers           []string      `mapstructure:"peers"`
+	CorsOrigin      []string      `mapstructure:"cors_origins"`
+	ReadTimeout     int64         `mapstructure:"read_timeout"`
+	WriteTimeout    int64         `mapstructure:"write_timeout"`
+	IdleTimeout     int64         `mapstructure:"idle_timeout"`
+	HeaderTimeout   int64         `mapstructure:"header_timeout"`
+	ResolverTimeout int64         `mapstructure:"resolver_timeout"`
+	Limits          Limits        `mapstructure:"limits"`
+	ResponseCache   ResponseCache `mapstructure:"response_cache"`
+}
+
+// Limits represents the GraphQL queries limits configuration.
+// Queries nested deeper than MaxDepth, or scoring more than MaxComplexity are refused.
+// Each client, identified by an allowed API key sent in the ApiKeyHeader or the IP address,
+// can spend Rate complexity units per second, up to Burst units at once. Zero limits are not enforced.
+type Limits struct {
+	MaxDepth       int         `mapstructure:"max_depth"`
+	MaxComplexity  int64       `mapstructure:"max_complexity"`
+	ListSize       int64       `mapstructure:"list_size"`
+	FieldCosts     []FieldCost `mapstructure:"field_costs"`
+	Rate           float64     `mapstructure:"rate"`
+	Burst          int64       `mapstructure:"burst"`
+	ApiKeyHeader   string      `mapstructure:"api_key_header"`
+	ApiKeys        []string    `mapstructure:"api_keys"`
+	TrustForwarded bool        `mapstructure:"trust_forwarded"`
+}
+
+// ResponseCache represents the GraphQL responses cache configuration.
+// Responses of queries are kept until a new block is processed, but no longer than the TTL,
+// or the shortest TTL of the queried fields listed in FieldTTL. Queries of a field with zero TTL
+// are not cached.
+type ResponseCache struct {
+	Enabled    bool           `mapstructure:"enabled"`
+	TTL        time.Duration  `mapstructure:"ttl"`
+	MaxEntries int            `mapstructure:"max_entries"`
+	FieldTTL   []FieldTTLHint `mapstructure:"field_ttl"`
+}
+
+// FieldTTLHint represents the max time a response containing the GraphQL field can be cached,
+// the field is identified by the type and the field name, i.e. "Query.gasPrice".
+type FieldTTLHint struct {
+	Field string        `mapstructure:"field"`
+	TTL   time.Duration `mapstructure:"ttl"`
+}
+
+// FieldCost represents a custom complexity cost of a GraphQL field,
+// the field is identified by the type and the field name, i.e. "Account.txList".
+type FieldCost struct {
+	Field string `mapstructure:"field"`
+	Cost  int64  `mapstructure:"cost"`
+}
+
+// Admin represents the administrative API server configuration.
+// The administrative API is not available if the bind address is not set.
+// All the requests have to be authorized by the bearer token.
+type Admin struct {
+	BindAddress string `mapstructure:"bind"`
+	Token       string `mapstructure:"token"`
 }
 
 // ServerSignature represents the signature used by this server
@@ -99,6 +160,37 @@ type Log struct {
 // OperaNode represents the Opera network node access configuration
 type OperaNode struct {
 	ApiNodeUrl string `mapstructure:"url"`
+
+	// Transport selects the node transport; "live", "record", or "replay".
+	// The record and replay transports keep the node communication in Fixtures directory.
+	Transport string `mapstructure:"transport"`
+	Fixtures  string `mapstructure:"fixtures"`
+
+	// Upstreams represents a pool of nodes used by the live transport instead of the ApiNodeUrl.
+	// Nodes lagging more than MaxLag blocks behind the pool head are not used until they catch up,
+	// calls not responded in CallTimeout are repeated on another node.
+	Upstreams   []Upstream    `mapstructure:"upstreams"`
+	MaxLag      int64         `mapstructure:"max_lag"`
+	HealthCheck time.Duration `mapstructure:"health_check"`
+	CallTimeout time.Duration `mapstructure:"call_timeout"`
+
+	// Multicall represents the address of the Multicall3 contract used to aggregate concurrent
+	// contract reads; JSON-RPC batch requests are used if the address is empty.
+	// Reads arriving within BatchWindow are sent together, up to BatchSize calls in one batch;
+	// zero BatchWindow disables the batching.
+	Multicall   common.Address `mapstructure:"multicall"`
+	BatchWindow time.Duration  `mapstructure:"batch_window"`
+	BatchSize   int            `mapstructure:"batch_size"`
+}
+
+// Upstream represents an upstream node of the Opera network node pool.
+// Archive nodes serve calls on historical state, other calls are served by them
+// only if no other node is available.
+type Upstream struct {
+	Name    string `mapstructure:"name"`
+	Url     string `mapstructure:"url"`
+	Weight  int    `mapstructure:"weight"`
+	Archive bool   `mapstructure:"archive"`
 }
 
 // PeerNetworking defines configuration for Opera p2p protocol.
@@ -124,11 +216,17 @@ type Cache struct {
 type Compiler struct {
 	CompilerTempPath       string `mapstructure:"temp"`
 	DefaultSolCompilerPath string `mapstructure:"sol"`
+	SolReleasesPath        string `mapstructure:"sol_releases"`
+	MaxCompilations        int    `mapstructure:"max_compilations"`
 }
 
 // Repository represents the repository configuration.
 type Repository struct {
 	MonitorStakers bool `mapstructure:"stakers"`
+
+	// TraceInternal enables tracing of transactions to collect internal transactions;
+	// it requires the node to provide tracing API on archive state.
+	TraceInternal bool `mapstructure:"trace"`
 }
 
 // Staking represents the PoS Staking module configuration.
@@ -173,6 +271,22 @@ type GovernanceContract struct {
 	Type       string         `mapstructure:"type"`
 }
 
+// Indexer represents the custom contract events indexing configuration.
+type Indexer struct {
+	Contracts []IndexedContract `mapstructure:"contracts"`
+}
+
+// IndexedContract represents a single contract configuration for events indexing.
+// Events emitted by any contract are indexed if the address is not set.
+// The ABI is provided either directly, or by a path to the ABI JSON file.
+// If the list of events is empty, all the events of the ABI are indexed.
+type IndexedContract struct {
+	Address common.Address `mapstructure:"address"`
+	Abi     string         `mapstructure:"abi"`
+	AbiFile string         `mapstructure:"abi_file"`
+	Events  []string       `mapstructure:"events"`
+}
+
 // DeFiFLend represents the fLend DeFi module configuration.
 type DeFiFLend struct {
 	LendingPool common.Address `mapstructure:"lending_pool"`
@@ -30,6 +30,17 @@ const (
 	defHeaderTimeout   = 1
 	defResolverTimeout = 30
 
+	// default GraphQL queries limits
+	defLimitsMaxDepth      = 12
+	defLimitsMaxComplexity = 10000
+	defLimitsListSize      = 50
+	defLimitsRate          = 1000
+	defLimitsBurst         = 20000
+
+	// default GraphQL responses cache options
+	defResponseCacheTTL        = 10 * time.Second
+	defResponseCacheMaxEntries = 10000
+
 	// defServerDomain holds default API server domain address
 	defServerDomain = "localhost:16761"
 
@@ -44,6 +55,27 @@ const (
 	// defLachesisUrl holds default Opera network connection string
 	defLachesisUrl = "~/.lachesis/data/lachesis.ipc"
 
+	// defNodeTransport holds the default transport of the node communication
+	defNodeTransport = "live"
+
+	// defNodeMaxLag holds the default number of blocks an upstream node can lag behind the pool head
+	defNodeMaxLag = 10
+
+	// defNodeHealthCheck holds the default period of the upstream nodes health check
+	defNodeHealthCheck = 5 * time.Second
+
+	// defNodeCallTimeout holds the default time limit of a call to an upstream node
+	defNodeCallTimeout = 30 * time.Second
+
+	// defNodeMulticall holds the default address of the Multicall3 contract
+	defNodeMulticall = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+	// defNodeBatchWindow holds the default time contract reads wait to be batched together
+	defNodeBatchWindow = 2 * time.Millisecond
+
+	// defNodeBatchSize holds the default max number of contract reads in a batch
+	defNodeBatchSize = 100
+
 	// defMongoUrl holds default MongoDB connection string
 	defMongoUrl = "mongodb://localhost:27017"
 
@@ -59,6 +91,12 @@ const (
 	// defSolCompilerPath represents the default SOL compiler path
 	defSolCompilerPath = "/usr/bin/solc"
 
+	// defSolReleasesPath represents the default path of the directory with SOL compiler releases
+	defSolReleasesPath = "/usr/local/lib/solc"
+
+	// defMaxCompilations represents the default max number of SOL compilations running at once
+	defMaxCompilations = 2
+
 	// defApiStateOrigin represents the default origin used for API state syncing
 	defApiStateOrigin = "https://localhost"
 
@@ -82,6 +120,15 @@ const (
 
 	// defBlockScanRescanDepth represents the amount of blocks re-scanned on server start
 	defBlockScanRescanDepth = 200
+
+	// defBlockScanWorkers represents the number of blocks fetched concurrently by the block scanner
+	defBlockScanWorkers = 8
+
+	// defReorgDepth represents the max number of blocks reverted on a chain reorganization
+	defReorgDepth = 64
+
+	// defConfirmations represents the number of blocks on top of a block before the block is considered final
+	defConfirmations = 3
 )
 
 // default list of API peers
@@ -109,9 +156,19 @@ func applyDefaults(cfg *viper.Viper) {
 	cfg.SetDefault(keyLoggingLevel, defLoggingLevel)
 	cfg.SetDefault(keyLoggingFormat, defLoggingFormat)
 	cfg.SetDefault(keyLachesisUrl, defLachesisUrl)
+	cfg.SetDefault(keyNodeTransport, defNodeTransport)
+	cfg.SetDefault(keyNodeFixtures, "")
+	cfg.SetDefault(keyNodeMaxLag, defNodeMaxLag)
+	cfg.SetDefault(keyNodeHealth, defNodeHealthCheck)
+	cfg.SetDefault(keyNodeTimeout, defNodeCallTimeout)
+	cfg.SetDefault(keyNodeMulticall, defNodeMulticall)
+	cfg.SetDefault(keyNodeBatchWin, defNodeBatchWindow)
+	cfg.SetDefault(keyNodeBatchSize, defNodeBatchSize)
 	cfg.SetDefault(keyMongoUrl, defMongoUrl)
 	cfg.SetDefault(keyMongoDatabase, defMongoDatabase)
 	cfg.SetDefault(keySolCompilerPath, defSolCompilerPath)
+	cfg.SetDefault(keySolReleasesPath, defSolReleasesPath)
+	cfg.SetDefault(keyMaxCompilations, defMaxCompilations)
 	cfg.SetDefault(keyApiPeers, defApiPeers)
 	cfg.SetDefault(keyApiStateOrigin, defApiStateOrigin)
 	cfg.SetDefault(keyErc20TokenMapFilePath, defTokenLogoFilePath)
@@ -128,6 +185,19 @@ func applyDefaults(cfg *viper.Viper) {
 	cfg.SetDefault(keyTimeoutIdle, defIdleTimeout)
 	cfg.SetDefault(keyTimeoutResolver, defResolverTimeout)
 
+	// GraphQL queries limits
+	cfg.SetDefault(keyLimitsMaxDepth, defLimitsMaxDepth)
+	cfg.SetDefault(keyLimitsMaxComplexity, defLimitsMaxComplexity)
+	cfg.SetDefault(keyLimitsListSize, defLimitsListSize)
+	cfg.SetDefault(keyLimitsRate, defLimitsRate)
+	cfg.SetDefault(keyLimitsBurst, defLimitsBurst)
+	cfg.SetDefault(keyLimitsApiKeyHeader, "")
+
+	// GraphQL responses cache
+	cfg.SetDefault(keyResponseCacheEnabled, false)
+	cfg.SetDefault(keyResponseCacheTTL, defResponseCacheTTL)
+	cfg.SetDefault(keyResponseCacheMaxEntries, defResponseCacheMaxEntries)
+
 	// no voting sources by default
 	cfg.SetDefault(keyVotingSources, defVotingSources)
 
@@ -6,12 +6,16 @@ const (
 	configFileName = "apiserver"
 
 	// configuration options
-	keyAppName                  = "app_name"
-	keyConfigFilePath           = "cfg"
-	keyConfigCmdBlockScanStart  = "cmd.blk_from"
-	keyConfigCmdBlockScanEnd    = "cmd.blk_to"
-	keyConfigCmdBlockScanReScan = "cmd.rescan"
-	keyConfigCmdRestoreStake    = "cmd.fix_stake"
+	keyAppName                   = "app_name"
+	keyConfigFilePath            = "cfg"
+	keyConfigCmdBlockScanStart   = "cmd.blk_from"
+	keyConfigCmdBlockScanEnd     = "cmd.blk_to"
+	keyConfigCmdBlockScanReScan  = "cmd.rescan"
+	keyConfigCmdBlockScanWorkers = "cmd.workers"
+	keyConfigCmdReorgDepth       = "cmd.reorg_depth"
+	keyConfigCmdConfirmations    = "cmd.confirmations"
+	keyConfigCmdBackfill         = "cmd.backfill"
+	keyConfigCmdRestoreStake     = "cmd.fix_stake"
 
 	// server related keys
 	keyBindAddress      = "server.bind"
@@ -27,6 +31,19 @@ const (
 	keyTimeoutHeader   = "server.header_timeout"
 	keyTimeoutResolver = "server.resolver_timeout"
 
+	// GraphQL queries limits
+	keyLimitsMaxDepth      = "server.limits.max_depth"
+	keyLimitsMaxComplexity = "server.limits.max_complexity"
+	keyLimitsListSize      = "server.limits.list_size"
+	keyLimitsRate          = "server.limits.rate"
+	keyLimitsBurst         = "server.limits.burst"
+	keyLimitsApiKeyHeader  = "server.limits.api_key_header"
+
+	// GraphQL responses cache
+	keyResponseCacheEnabled    = "server.response_cache.enabled"
+	keyResponseCacheTTL        = "server.response_cache.ttl"
+	keyResponseCacheMaxEntries = "server.response_cache.max_entries"
+
 	// API server signature related keys
 	keySignatureAddress    = "me.address"
 	keySignaturePrivateKey = "me.pkey"
@@ -36,7 +53,15 @@ const (
 	keyLoggingFormat = "log.format"
 
 	// node connection related options
-	keyLachesisUrl = "lachesis.url"
+	keyLachesisUrl   = "lachesis.url"
+	keyNodeTransport = "node.transport"
+	keyNodeFixtures  = "node.fixtures"
+	keyNodeMaxLag    = "node.max_lag"
+	keyNodeHealth    = "node.health_check"
+	keyNodeTimeout   = "node.call_timeout"
+	keyNodeMulticall = "node.multicall"
+	keyNodeBatchWin  = "node.batch_window"
+	keyNodeBatchSize = "node.batch_size"
 
 	// off-chain database related options
 	keyMongoUrl      = "db.url"
@@ -48,6 +73,8 @@ const (
 
 	// contract validation related
 	keySolCompilerPath = "compiler.sol"
+	keySolReleasesPath = "compiler.sol_releases"
+	keyMaxCompilations = "compiler.max_compilations"
 
 	// utility options
 	keyVotingSources         = "voting.sources"
@@ -41,6 +41,9 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
+	// make sure the node options are usable
+	checkNodeConfig(&config.Opera)
+
 	// try to load the logo map file
 	loadErc20LogMap(&config)
 
@@ -51,6 +54,10 @@ func Load() (*Config, error) {
 // attachCliFlags connects CLI flags to certain configuration options.
 func attachCliFlags(cfg *Config) {
 	flag.Uint64Var(&cfg.RepoCommand.BlockScanReScan, keyConfigCmdBlockScanReScan, defBlockScanRescanDepth, "How many blocks are re-scanned on the server start.")
+	flag.IntVar(&cfg.RepoCommand.BlockScanWorkers, keyConfigCmdBlockScanWorkers, defBlockScanWorkers, "How many blocks are fetched concurrently by the block scanner.")
+	flag.Uint64Var(&cfg.RepoCommand.ReorgDepth, keyConfigCmdReorgDepth, defReorgDepth, "How many blocks can be reverted on a chain reorganization.")
+	flag.Uint64Var(&cfg.RepoCommand.Confirmations, keyConfigCmdConfirmations, defConfirmations, "How many blocks must be on top of a block before it's considered final.")
+	flag.StringVar(&cfg.RepoCommand.Backfill, keyConfigCmdBackfill, "", "Comma separated list of log handler families to be back-filled from the first block.")
 	flag.StringVar(&cfg.RepoCommand.RestoreStake, keyConfigCmdRestoreStake, "", "Owner of the stake to be restored.")
 }
 
@@ -82,6 +89,22 @@ func readConfigFile() (*viper.Viper, error) {
 	return cfg, nil
 }
 
+// checkNodeConfig replaces invalid node options with their default values.
+func checkNodeConfig(cfg *OperaNode) {
+	if cfg.HealthCheck <= 0 {
+		log.Printf("invalid node health check period %s, using %s", cfg.HealthCheck, defNodeHealthCheck)
+		cfg.HealthCheck = defNodeHealthCheck
+	}
+	if cfg.CallTimeout <= 0 {
+		log.Printf("invalid node call timeout %s, using %s", cfg.CallTimeout, defNodeCallTimeout)
+		cfg.CallTimeout = defNodeCallTimeout
+	}
+	if cfg.MaxLag < 0 {
+		log.Printf("invalid node max lag %d, using %d", cfg.MaxLag, defNodeMaxLag)
+		cfg.MaxLag = defNodeMaxLag
+	}
+}
+
 // loadErc20LogMap loads the map of ERC20 token logos.
 func loadErc20LogMap(cfg *Config) {
 	// is there any path at all?
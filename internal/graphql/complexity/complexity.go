@@ -0,0 +1,280 @@
+// Package complexity implements static complexity analysis of GraphQL queries.
+package complexity
+
+import (
+	"encoding/json"
+	"fantom-api-graphql/internal/config"
+	"fmt"
+	"github.com/graph-gophers/graphql-go/types"
+	"math"
+	"sort"
+	"strings"
+)
+
+// maxComplexity caps the score to avoid overflow on absurdly nested queries.
+const maxComplexity = int64(1) << 50
+
+// countArgument represents the name of the argument limiting the size of list fields.
+const countArgument = "count"
+
+// Analyzer scores GraphQL queries by the static complexity of their selections.
+// Each field costs one unit unless configured otherwise. The selections of a field
+// with the count argument are multiplied by the absolute value of the count, the selections
+// of other list fields are multiplied by the configured list size.
+type Analyzer struct {
+	schema   *types.Schema
+	costs    map[string]int64
+	listSize int64
+}
+
+// Score represents the complexity and the depth of an analysed query operation.
+// Fields lists the scored fields of the operation as "Type.field".
+type Score struct {
+	Operation  string
+	Complexity int64
+	Depth      int
+	Fields     []string
+}
+
+// scoring represents the state of a single query analysis.
+type scoring struct {
+	*Analyzer
+	doc      *document
+	vars     map[string]interface{}
+	defaults map[string]interface{}
+	visiting map[string]bool
+	fields   map[string]bool
+}
+
+// New creates a new query complexity analyzer of the given schema.
+func New(schema *types.Schema, cfg *config.Limits) *Analyzer {
+	an := Analyzer{
+		schema:   schema,
+		costs:    make(map[string]int64, len(cfg.FieldCosts)),
+		listSize: cfg.ListSize,
+	}
+	for _, fc := range cfg.FieldCosts {
+		an.costs[fc.Field] = fc.Cost
+	}
+	return &an
+}
+
+// Analyze scores the operation of the given query with the given variables.
+// Introspection fields are not scored.
+func (an *Analyzer) Analyze(query string, operationName string, variables map[string]interface{}) (*Score, error) {
+	doc, err := parse(query)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := doc.operation(operationName)
+	if err != nil {
+		return nil, err
+	}
+
+	root, ok := an.schema.EntryPoints[op.kind]
+	if !ok {
+		return nil, fmt.Errorf("%s operation not supported", op.kind)
+	}
+
+	sc := scoring{
+		Analyzer: an,
+		doc:      doc,
+		vars:     variables,
+		defaults: op.defaults,
+		visiting: make(map[string]bool),
+		fields:   make(map[string]bool),
+	}
+
+	cost, depth := sc.selections(root.TypeName(), op.selections, false)
+
+	fields := make([]string, 0, len(sc.fields))
+	for f := range sc.fields {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+
+	return &Score{Operation: op.kind, Complexity: cost, Depth: depth, Fields: fields}, nil
+}
+
+// operation picks the operation of the given name from the query document.
+func (doc *document) operation(name string) (*operation, error) {
+	if name == "" {
+		if len(doc.operations) != 1 {
+			return nil, fmt.Errorf("operation name required")
+		}
+		return doc.operations[0], nil
+	}
+
+	for _, op := range doc.operations {
+		if op.name == name {
+			return op, nil
+		}
+	}
+	return nil, fmt.Errorf("operation %s not found", name)
+}
+
+// selections scores the given selection set of the given type.
+// The paged flag marks the selections of a field with the count argument,
+// their list fields are already multiplied by the count.
+func (sc *scoring) selections(typeName string, list []*selection, paged bool) (cost int64, depth int) {
+	for _, sel := range list {
+		var c int64
+		var d int
+
+		switch {
+		case sel.spread != "":
+			frag, ok := sc.doc.fragments[sel.spread]
+			if !ok || sc.visiting[sel.spread] {
+				continue
+			}
+			sc.visiting[sel.spread] = true
+			c, d = sc.selections(sc.condition(frag.on, typeName), frag.selections, paged)
+			delete(sc.visiting, sel.spread)
+		case sel.inline:
+			c, d = sc.selections(sc.condition(sel.on, typeName), sel.selections, paged)
+		default:
+			c, d = sc.field(typeName, sel, paged)
+		}
+
+		cost = add(cost, c)
+		if d > depth {
+			depth = d
+		}
+	}
+	return cost, depth
+}
+
+// field scores the given field selection of the given type.
+func (sc *scoring) field(typeName string, sel *selection, paged bool) (int64, int) {
+	if strings.HasPrefix(sel.field, "__") {
+		return 0, 0
+	}
+
+	def := sc.definition(typeName, sel.field)
+	if def == nil {
+		return 0, 0
+	}
+
+	key := typeName + "." + sel.field
+	sc.fields[key] = true
+
+	cost, ok := sc.costs[key]
+	if !ok {
+		cost = 1
+	}
+	if len(sel.selections) == 0 {
+		return cost, 1
+	}
+
+	named, isList := unwrap(def.Type)
+	count, hasCount := sc.count(def, sel.args)
+	if !hasCount {
+		count = 1
+		if isList && !paged {
+			count = sc.listSize
+		}
+	}
+
+	c, d := sc.selections(named, sel.selections, hasCount)
+	return add(cost, mul(count, c)), d + 1
+}
+
+// definition provides the definition of the given field of the given type; nil if not known.
+func (sc *scoring) definition(typeName string, field string) *types.FieldDefinition {
+	switch t := sc.schema.Types[typeName].(type) {
+	case *types.ObjectTypeDefinition:
+		return t.Fields.Get(field)
+	case *types.InterfaceTypeDefinition:
+		return t.Fields.Get(field)
+	}
+	return nil
+}
+
+// condition provides the type of fragment selections; the enclosing type is used if not specified.
+func (sc *scoring) condition(on string, typeName string) string {
+	if _, ok := sc.schema.Types[on]; ok {
+		return on
+	}
+	return typeName
+}
+
+// count provides the absolute value of the count argument of the given field, if the field has one.
+// Variables and missing values are resolved to the operation and the schema default values.
+func (sc *scoring) count(def *types.FieldDefinition, args map[string]interface{}) (int64, bool) {
+	ad := def.Arguments.Get(countArgument)
+	if ad == nil {
+		return 0, false
+	}
+
+	v, ok := args[countArgument]
+	if vr, isVar := v.(variable); isVar {
+		v, ok = sc.vars[string(vr)]
+		if !ok {
+			v, ok = sc.defaults[string(vr)]
+		}
+	}
+	if (!ok || v == nil) && ad.Default != nil {
+		v = ad.Default.Deserialize(nil)
+	}
+
+	var n float64
+	switch val := v.(type) {
+	case int64:
+		n = float64(val)
+	case int32:
+		n = float64(val)
+	case int:
+		n = float64(val)
+	case float64:
+		n = val
+	case json.Number:
+		f, err := val.Float64()
+		if err != nil {
+			return sc.listSize, true
+		}
+		n = f
+	default:
+		return sc.listSize, true
+	}
+
+	n = math.Abs(n)
+	if n > float64(maxComplexity) {
+		return maxComplexity, true
+	}
+	return int64(n), true
+}
+
+// unwrap provides the name of the named type of the given type and marks lists.
+func unwrap(t types.Type) (string, bool) {
+	isList := false
+	for {
+		switch tt := t.(type) {
+		case *types.NonNull:
+			t = tt.OfType
+		case *types.List:
+			isList = true
+			t = tt.OfType
+		case types.NamedType:
+			return tt.TypeName(), isList
+		default:
+			return "", isList
+		}
+	}
+}
+
+// add sums the given scores capped to the max complexity.
+func add(a, b int64) int64 {
+	if a+b > maxComplexity {
+		return maxComplexity
+	}
+	return a + b
+}
+
+// mul multiplies the given scores capped to the max complexity.
+func mul(a, b int64) int64 {
+	if a != 0 && b > maxComplexity/a {
+		return maxComplexity
+	}
+	return a * b
+}
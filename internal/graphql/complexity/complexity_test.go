@@ -0,0 +1,77 @@
+package complexity
+
+import (
+	"fantom-api-graphql/internal/config"
+	gqlSchema "fantom-api-graphql/internal/graphql/schema"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/onsi/gomega"
+	"testing"
+)
+
+// complexityTest represents a single query complexity test.
+type complexityTest struct {
+	query      string
+	vars       map[string]interface{}
+	complexity int64
+	depth      int
+}
+
+// the list of scored queries of the API schema.
+var complexityTests = []complexityTest{
+	{
+		query:      `{ block { number hash } }`,
+		complexity: 3,
+		depth:      2,
+	},
+	{
+		// the edges are multiplied by the count only, not by the list size
+		query:      `query { account(address: "0x0") { address txList(count: -10) { totalCount edges { transaction { hash } } } } }`,
+		complexity: 1 + 1 + (1 + 10*(1+(1+(1+1)))),
+		depth:      5,
+	},
+	{
+		// variables and schema default values of the count
+		query:      `query Q($n: Int = 5) { a: account(address: "0x0") { txList(count: $n) { totalCount } } erc20TokenList { address } }`,
+		vars:       map[string]interface{}{"n": float64(20)},
+		complexity: (1 + (1 + 20*1)) + (1 + 50*1),
+		depth:      3,
+	},
+	{
+		// lists without the count are multiplied by the list size
+		query:      `fragment S on Staker { id delegations { totalCount } } { stakers { ...S ... on Staker { id } } }`,
+		complexity: 1 + 50*((1+(1+25*1))+1),
+		depth:      3,
+	},
+	{
+		// introspection is free
+		query:      `{ __schema { types { name fields { name type { ofType { ofType { name } } } } } } }`,
+		complexity: 0,
+		depth:      0,
+	},
+}
+
+func TestAnalyze(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	schema := graphql.MustParseSchema(gqlSchema.Schema(), nil)
+	an := New(schema.ASTSchema(), &config.Limits{ListSize: 50})
+
+	for _, ct := range complexityTests {
+		score, err := an.Analyze(ct.query, "", ct.vars)
+		g.Expect(err).To(gomega.BeNil())
+		g.Expect(score.Complexity).To(gomega.Equal(ct.complexity), ct.query)
+		g.Expect(score.Depth).To(gomega.Equal(ct.depth), ct.query)
+	}
+
+	// configured field costs replace the default unit cost
+	an = New(schema.ASTSchema(), &config.Limits{ListSize: 50, FieldCosts: []config.FieldCost{{Field: "Query.block", Cost: 100}}})
+	score, err := an.Analyze(`{ block { number } }`, "", nil)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(score.Complexity).To(gomega.Equal(int64(101)))
+
+	// broken queries are not analysed
+	_, err = an.Analyze(`{ block { number }`, "", nil)
+	g.Expect(err).NotTo(gomega.BeNil())
+	_, err = an.Analyze(`query A { block { number } } query B { block { hash } }`, "", nil)
+	g.Expect(err).NotTo(gomega.BeNil())
+}
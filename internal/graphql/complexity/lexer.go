@@ -0,0 +1,243 @@
+// Package complexity implements static complexity analysis of GraphQL queries.
+package complexity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// token kinds of the GraphQL query lexer
+const (
+	tokEOF = iota
+	tokPunct
+	tokName
+	tokInt
+	tokFloat
+	tokString
+)
+
+// token represents a lexical token of a GraphQL query.
+type token struct {
+	kind int
+	text string
+	pos  int
+}
+
+// lexer splits a GraphQL query into tokens.
+// Commas, white spaces and comments are insignificant and skipped.
+type lexer struct {
+	src string
+	pos int
+	tok token
+}
+
+// newLexer creates a new lexer of the given query positioned on the first token.
+func newLexer(src string) (*lexer, error) {
+	lx := lexer{src: strings.TrimPrefix(src, "\uFEFF")}
+	if err := lx.next(); err != nil {
+		return nil, err
+	}
+	return &lx, nil
+}
+
+// Normalize provides the canonical form of the given query with insignificant
+// characters and comments removed, so equal queries are represented by the same text.
+func Normalize(query string) (string, error) {
+	lx, err := newLexer(query)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for lx.tok.kind != tokEOF {
+		if sb.Len() > 0 {
+			sb.WriteByte(' ')
+		}
+		if lx.tok.kind == tokString {
+			sb.WriteString(strconv.Quote(lx.tok.text))
+		} else {
+			sb.WriteString(lx.tok.text)
+		}
+		if err := lx.next(); err != nil {
+			return "", err
+		}
+	}
+	return sb.String(), nil
+}
+
+// next advances the lexer to the next token.
+func (lx *lexer) next() error {
+	lx.skip()
+	if lx.pos >= len(lx.src) {
+		lx.tok = token{kind: tokEOF, pos: lx.pos}
+		return nil
+	}
+
+	start, c := lx.pos, lx.src[lx.pos]
+	switch {
+	case strings.IndexByte("!$&():=@[]{}|", c) >= 0:
+		lx.pos++
+		lx.tok = token{kind: tokPunct, text: string(c), pos: start}
+	case c == '.':
+		if !strings.HasPrefix(lx.src[lx.pos:], "...") {
+			return lx.errorf("unexpected character %q", c)
+		}
+		lx.pos += 3
+		lx.tok = token{kind: tokPunct, text: "...", pos: start}
+	case c == '_' || isLetter(c):
+		for lx.pos < len(lx.src) && (lx.src[lx.pos] == '_' || isLetter(lx.src[lx.pos]) || isDigit(lx.src[lx.pos])) {
+			lx.pos++
+		}
+		lx.tok = token{kind: tokName, text: lx.src[start:lx.pos], pos: start}
+	case c == '-' || isDigit(c):
+		return lx.number()
+	case c == '"':
+		return lx.string()
+	default:
+		return lx.errorf("unexpected character %q", c)
+	}
+	return nil
+}
+
+// skip moves the lexer behind insignificant characters and comments.
+func (lx *lexer) skip() {
+	for lx.pos < len(lx.src) {
+		switch lx.src[lx.pos] {
+		case ' ', '\t', '\n', '\r', ',':
+			lx.pos++
+		case '#':
+			for lx.pos < len(lx.src) && lx.src[lx.pos] != '\n' && lx.src[lx.pos] != '\r' {
+				lx.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// number reads an integer or a float value token.
+func (lx *lexer) number() error {
+	start, kind := lx.pos, tokInt
+	if lx.src[lx.pos] == '-' {
+		lx.pos++
+	}
+	if !lx.digits() {
+		return lx.errorf("invalid number")
+	}
+	if lx.pos < len(lx.src) && lx.src[lx.pos] == '.' {
+		kind = tokFloat
+		lx.pos++
+		if !lx.digits() {
+			return lx.errorf("invalid number")
+		}
+	}
+	if lx.pos < len(lx.src) && (lx.src[lx.pos] == 'e' || lx.src[lx.pos] == 'E') {
+		kind = tokFloat
+		lx.pos++
+		if lx.pos < len(lx.src) && (lx.src[lx.pos] == '+' || lx.src[lx.pos] == '-') {
+			lx.pos++
+		}
+		if !lx.digits() {
+			return lx.errorf("invalid number")
+		}
+	}
+	lx.tok = token{kind: kind, text: lx.src[start:lx.pos], pos: start}
+	return nil
+}
+
+// digits reads a sequence of digits; returns false if there is none.
+func (lx *lexer) digits() bool {
+	start := lx.pos
+	for lx.pos < len(lx.src) && isDigit(lx.src[lx.pos]) {
+		lx.pos++
+	}
+	return lx.pos > start
+}
+
+// string reads a string or a block string value token.
+func (lx *lexer) string() error {
+	start := lx.pos
+	if strings.HasPrefix(lx.src[lx.pos:], `"""`) {
+		lx.pos += 3
+		for lx.pos < len(lx.src) {
+			switch {
+			case strings.HasPrefix(lx.src[lx.pos:], `\"""`):
+				lx.pos += 4
+			case strings.HasPrefix(lx.src[lx.pos:], `"""`):
+				lx.pos += 3
+				lx.tok = token{kind: tokString, text: lx.src[start+3 : lx.pos-3], pos: start}
+				return nil
+			default:
+				lx.pos++
+			}
+		}
+		return lx.errorf("unterminated string")
+	}
+
+	lx.pos++
+	for lx.pos < len(lx.src) {
+		switch lx.src[lx.pos] {
+		case '\\':
+			lx.pos += 2
+		case '"':
+			lx.pos++
+			text, err := strconv.Unquote(lx.src[start:lx.pos])
+			if err != nil {
+				text = lx.src[start+1 : lx.pos-1]
+			}
+			lx.tok = token{kind: tokString, text: text, pos: start}
+			return nil
+		case '\n', '\r':
+			return lx.errorf("unterminated string")
+		default:
+			lx.pos++
+		}
+	}
+	return lx.errorf("unterminated string")
+}
+
+// is checks if the current token is the given punctuator, or name.
+func (lx *lexer) is(text string) bool {
+	return (lx.tok.kind == tokPunct || lx.tok.kind == tokName) && lx.tok.text == text
+}
+
+// skipIf advances the lexer if the current token is the given punctuator, or name.
+func (lx *lexer) skipIf(text string) (bool, error) {
+	if !lx.is(text) {
+		return false, nil
+	}
+	return true, lx.next()
+}
+
+// expect advances the lexer over the given punctuator, or name; fails if not found.
+func (lx *lexer) expect(text string) error {
+	if !lx.is(text) {
+		return lx.errorf("expected %q, found %q", text, lx.tok.text)
+	}
+	return lx.next()
+}
+
+// name reads a name token.
+func (lx *lexer) name() (string, error) {
+	if lx.tok.kind != tokName {
+		return "", lx.errorf("expected name, found %q", lx.tok.text)
+	}
+	name := lx.tok.text
+	return name, lx.next()
+}
+
+// errorf creates a syntax error at the current position.
+func (lx *lexer) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("syntax error at %d: %s", lx.pos, fmt.Sprintf(format, args...))
+}
+
+// isLetter checks if the given character is an ASCII letter.
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// isDigit checks if the given character is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,370 @@
+// Package complexity implements static complexity analysis of GraphQL queries.
+package complexity
+
+import (
+	"strconv"
+)
+
+// document represents a parsed GraphQL query document.
+type document struct {
+	operations []*operation
+	fragments  map[string]*fragment
+}
+
+// operation represents an operation of a query document.
+type operation struct {
+	kind       string
+	name       string
+	defaults   map[string]interface{}
+	selections []*selection
+}
+
+// fragment represents a named fragment of a query document.
+type fragment struct {
+	on         string
+	selections []*selection
+}
+
+// selection represents a field, a fragment spread, or an inline fragment of a selection set.
+type selection struct {
+	field      string
+	args       map[string]interface{}
+	spread     string
+	on         string
+	inline     bool
+	selections []*selection
+}
+
+// variable represents a reference to an operation variable in argument values.
+type variable string
+
+// parse parses the given GraphQL query document.
+func parse(query string) (*document, error) {
+	lx, err := newLexer(query)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := document{fragments: make(map[string]*fragment)}
+	for lx.tok.kind != tokEOF {
+		if lx.is("fragment") {
+			name, frag, err := parseFragment(lx)
+			if err != nil {
+				return nil, err
+			}
+			doc.fragments[name] = frag
+			continue
+		}
+
+		op, err := parseOperation(lx)
+		if err != nil {
+			return nil, err
+		}
+		doc.operations = append(doc.operations, op)
+	}
+	return &doc, nil
+}
+
+// parseOperation parses an operation definition, or a query shorthand.
+func parseOperation(lx *lexer) (op *operation, err error) {
+	op = &operation{kind: "query", defaults: make(map[string]interface{})}
+	if !lx.is("{") {
+		if op.kind, err = lx.name(); err != nil {
+			return nil, err
+		}
+		if op.kind != "query" && op.kind != "mutation" && op.kind != "subscription" {
+			return nil, lx.errorf("unexpected %q", op.kind)
+		}
+		if lx.tok.kind == tokName {
+			if op.name, err = lx.name(); err != nil {
+				return nil, err
+			}
+		}
+		if err = parseVariables(lx, op.defaults); err != nil {
+			return nil, err
+		}
+		if err = parseDirectives(lx); err != nil {
+			return nil, err
+		}
+	}
+
+	op.selections, err = parseSelectionSet(lx)
+	return op, err
+}
+
+// parseFragment parses a named fragment definition.
+func parseFragment(lx *lexer) (name string, frag *fragment, err error) {
+	if err = lx.expect("fragment"); err != nil {
+		return "", nil, err
+	}
+	if name, err = lx.name(); err != nil {
+		return "", nil, err
+	}
+	if err = lx.expect("on"); err != nil {
+		return "", nil, err
+	}
+
+	frag = new(fragment)
+	if frag.on, err = lx.name(); err != nil {
+		return "", nil, err
+	}
+	if err = parseDirectives(lx); err != nil {
+		return "", nil, err
+	}
+	frag.selections, err = parseSelectionSet(lx)
+	return name, frag, err
+}
+
+// parseVariables parses variable definitions and collects their default values.
+func parseVariables(lx *lexer, defaults map[string]interface{}) error {
+	if ok, err := lx.skipIf("("); !ok || err != nil {
+		return err
+	}
+
+	for !lx.is(")") {
+		if err := lx.expect("$"); err != nil {
+			return err
+		}
+		name, err := lx.name()
+		if err != nil {
+			return err
+		}
+		if err = lx.expect(":"); err != nil {
+			return err
+		}
+		if err = parseType(lx); err != nil {
+			return err
+		}
+
+		if ok, err := lx.skipIf("="); err != nil {
+			return err
+		} else if ok {
+			if defaults[name], err = parseValue(lx); err != nil {
+				return err
+			}
+		}
+		if err = parseDirectives(lx); err != nil {
+			return err
+		}
+	}
+	return lx.next()
+}
+
+// parseType parses a variable type reference.
+func parseType(lx *lexer) error {
+	if ok, err := lx.skipIf("["); err != nil {
+		return err
+	} else if ok {
+		if err = parseType(lx); err != nil {
+			return err
+		}
+		if err = lx.expect("]"); err != nil {
+			return err
+		}
+	} else if _, err = lx.name(); err != nil {
+		return err
+	}
+
+	_, err := lx.skipIf("!")
+	return err
+}
+
+// parseDirectives skips directives, they do not change the query complexity.
+func parseDirectives(lx *lexer) error {
+	for lx.is("@") {
+		if err := lx.next(); err != nil {
+			return err
+		}
+		if _, err := lx.name(); err != nil {
+			return err
+		}
+		if _, err := parseArguments(lx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseSelectionSet parses a selection set enclosed in braces.
+func parseSelectionSet(lx *lexer) ([]*selection, error) {
+	if err := lx.expect("{"); err != nil {
+		return nil, err
+	}
+
+	list := make([]*selection, 0)
+	for !lx.is("}") {
+		if lx.tok.kind == tokEOF {
+			return nil, lx.errorf("unexpected end of query")
+		}
+
+		sel, err := parseSelection(lx)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, sel)
+	}
+	return list, lx.next()
+}
+
+// parseSelection parses a single field, fragment spread, or inline fragment.
+func parseSelection(lx *lexer) (sel *selection, err error) {
+	sel = new(selection)
+	if ok, err := lx.skipIf("..."); err != nil {
+		return nil, err
+	} else if ok {
+		return parseFragmentSelection(lx, sel)
+	}
+
+	if sel.field, err = lx.name(); err != nil {
+		return nil, err
+	}
+
+	// the alias does not matter, we need the field name
+	if ok, err := lx.skipIf(":"); err != nil {
+		return nil, err
+	} else if ok {
+		if sel.field, err = lx.name(); err != nil {
+			return nil, err
+		}
+	}
+
+	if sel.args, err = parseArguments(lx); err != nil {
+		return nil, err
+	}
+	if err = parseDirectives(lx); err != nil {
+		return nil, err
+	}
+	if lx.is("{") {
+		sel.selections, err = parseSelectionSet(lx)
+	}
+	return sel, err
+}
+
+// parseFragmentSelection parses a fragment spread, or an inline fragment following the spread operator.
+func parseFragmentSelection(lx *lexer, sel *selection) (*selection, error) {
+	var err error
+	if lx.tok.kind == tokName && !lx.is("on") {
+		if sel.spread, err = lx.name(); err != nil {
+			return nil, err
+		}
+		return sel, parseDirectives(lx)
+	}
+
+	sel.inline = true
+	if ok, err := lx.skipIf("on"); err != nil {
+		return nil, err
+	} else if ok {
+		if sel.on, err = lx.name(); err != nil {
+			return nil, err
+		}
+	}
+	if err = parseDirectives(lx); err != nil {
+		return nil, err
+	}
+	sel.selections, err = parseSelectionSet(lx)
+	return sel, err
+}
+
+// parseArguments parses an optional list of arguments.
+func parseArguments(lx *lexer) (map[string]interface{}, error) {
+	if ok, err := lx.skipIf("("); !ok || err != nil {
+		return nil, err
+	}
+
+	args := make(map[string]interface{})
+	for !lx.is(")") {
+		name, err := lx.name()
+		if err != nil {
+			return nil, err
+		}
+		if err = lx.expect(":"); err != nil {
+			return nil, err
+		}
+		if args[name], err = parseValue(lx); err != nil {
+			return nil, err
+		}
+	}
+	return args, lx.next()
+}
+
+// parseValue parses an input value literal, or a variable reference.
+func parseValue(lx *lexer) (interface{}, error) {
+	tok := lx.tok
+	switch {
+	case tok.kind == tokInt:
+		v, err := strconv.ParseInt(tok.text, 10, 64)
+		if err != nil {
+			return nil, lx.errorf("invalid integer %s", tok.text)
+		}
+		return v, lx.next()
+	case tok.kind == tokFloat:
+		v, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, lx.errorf("invalid float %s", tok.text)
+		}
+		return v, lx.next()
+	case tok.kind == tokString:
+		return tok.text, lx.next()
+	case lx.is("$"):
+		if err := lx.next(); err != nil {
+			return nil, err
+		}
+		name, err := lx.name()
+		return variable(name), err
+	case lx.is("["):
+		return parseList(lx)
+	case lx.is("{"):
+		return parseObject(lx)
+	case tok.kind == tokName:
+		var v interface{} = tok.text
+		switch tok.text {
+		case "true":
+			v = true
+		case "false":
+			v = false
+		case "null":
+			v = nil
+		}
+		return v, lx.next()
+	}
+	return nil, lx.errorf("unexpected %q", tok.text)
+}
+
+// parseList parses a list value.
+func parseList(lx *lexer) (interface{}, error) {
+	if err := lx.expect("["); err != nil {
+		return nil, err
+	}
+
+	list := make([]interface{}, 0)
+	for !lx.is("]") {
+		v, err := parseValue(lx)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, lx.next()
+}
+
+// parseObject parses an input object value.
+func parseObject(lx *lexer) (interface{}, error) {
+	if err := lx.expect("{"); err != nil {
+		return nil, err
+	}
+
+	obj := make(map[string]interface{})
+	for !lx.is("}") {
+		name, err := lx.name()
+		if err != nil {
+			return nil, err
+		}
+		if err = lx.expect(":"); err != nil {
+			return nil, err
+		}
+		if obj[name], err = parseValue(lx); err != nil {
+			return nil, err
+		}
+	}
+	return obj, lx.next()
+}
@@ -89,15 +89,25 @@ func (acc *Account) TxCount() (hexutil.Uint64, error) {
 // TxList resolves list of transaction associated with the account.
 func (acc *Account) TxList(args struct {
 	Recipient *common.Address
+	Filter    *TransactionFilter
 	Cursor    *Cursor
 	Count     int32
 }) (*TransactionList, error) {
+	// validate the optional filter
+	var tf *types.TransactionFilter
+	if args.Filter != nil {
+		var err error
+		if tf, err = args.Filter.filter(); err != nil {
+			return nil, err
+		}
+	}
+
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
 	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
 
 	// get the transaction hash list from repository
-	bl, err := repository.R().AccountTransactions(&acc.Address, args.Recipient, (*string)(args.Cursor), args.Count)
+	bl, err := repository.R().AccountTransactions(&acc.Address, args.Recipient, tf, (*string)(args.Cursor), args.Count)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +115,24 @@ func (acc *Account) TxList(args struct {
 	return NewTransactionList(bl), nil
 }
 
+// InternalTxList resolves list of internal transactions sent or received by the account.
+func (acc *Account) InternalTxList(args struct {
+	Cursor *Cursor
+	Count  int32
+	Sort   *string
+}) (*InternalTransactionList, error) {
+	// limit query size; the count can be either positive or negative
+	// this controls the loading direction
+	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
+
+	tl, err := repository.R().AccountInternalTransactions(&acc.Address, (*string)(args.Cursor), args.Count,
+		listSort(args.Sort, types.FiInternalTrxOrdinal, types.FiInternalTrxOrdinal))
+	if err != nil {
+		return nil, err
+	}
+	return NewInternalTransactionList(tl), nil
+}
+
 // Erc20TxList resolves list of ERC20 transactions associated with the account.
 func (acc *Account) Erc20TxList(args struct {
 	Cursor *Cursor
@@ -210,13 +238,15 @@ func (acc *Account) Staker() (*Staker, error) {
 func (acc *Account) Delegations(args *struct {
 	Cursor *Cursor
 	Count  int32
+	Sort   *string
 }) (*DelegationList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// pull the list
-	dl, err := repository.R().DelegationsByAddress(&acc.Address, (*string)(args.Cursor), args.Count)
+	dl, err := repository.R().DelegationsByAddress(&acc.Address, (*string)(args.Cursor), args.Count,
+		listSort(args.Sort, types.FiDelegationOrdinal, types.FiDelegationValue))
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@
 package resolvers
 
 import (
+	"context"
 	"fantom-api-graphql/internal/repository"
 	"fantom-api-graphql/internal/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -22,19 +23,39 @@ func NewBlock(blk *types.Block) *Block {
 }
 
 // Block resolves blockchain block by number or by hash. If neither is provided, the most recent block is given.
+// Only a final block is resolved if finalized is requested.
 func (rs *rootResolver) Block(args *struct {
-	Number *hexutil.Uint64
-	Hash   *common.Hash
+	Number    *hexutil.Uint64
+	Hash      *common.Hash
+	Finalized bool
 }) (*Block, error) {
+	// the most recent final block is the top one if finalized is requested
+	if args.Finalized && args.Number == nil && args.Hash == nil {
+		fin, err := repository.R().FinalizedBlock()
+		if err != nil {
+			return nil, err
+		}
+		args.Number = (*hexutil.Uint64)(&fin)
+	}
+
 	// do we have the number, or hash is not given?
+	var b *types.Block
+	var err error
 	if args.Number != nil || args.Hash == nil {
-		b, err := repository.R().BlockByNumber(args.Number)
+		b, err = repository.R().BlockByNumber(args.Number)
+	} else {
+		b, err = repository.R().BlockByHash(args.Hash)
+	}
+	if err != nil || b == nil || !args.Finalized {
 		return NewBlock(b), err
 	}
 
-	// simply pull the block by hash
-	b, err := repository.R().BlockByHash(args.Hash)
-	return NewBlock(b), err
+	// make sure the block is final
+	isFinal, err := repository.R().IsFinalized(uint64(b.Number))
+	if err != nil || !isFinal {
+		return nil, err
+	}
+	return NewBlock(b), nil
 }
 
 // Parent resolves parent block information to the given block.
@@ -55,9 +76,10 @@ func (blk *Block) TxHashList() []common.Hash {
 }
 
 // TxList resolves list of transaction details of the transactions bundled in the block.
-func (blk *Block) TxList() ([]*Transaction, error) {
+func (blk *Block) TxList(ctx context.Context) ([]*Transaction, error) {
 	// make the container
 	txs := make([]*Transaction, len(blk.Txs))
+	list := make([]*types.Transaction, len(blk.Txs))
 
 	// loop the hashes and extract transactions
 	for i, hash := range blk.Txs {
@@ -68,8 +90,11 @@ func (blk *Block) TxList() ([]*Transaction, error) {
 
 		// make a resolvable transaction
 		txs[i] = NewTransaction(trx)
+		list[i] = trx
 	}
 
+	// senders and recipients of the transactions are loaded in a single batch
+	primeTransactions(ctx, list)
 	return txs, nil
 }
 
@@ -78,3 +103,14 @@ func (blk *Block) TransactionCount() *int32 {
 	count := int32(len(blk.Txs))
 	return &count
 }
+
+// Confirmations resolves the number of blocks observed on top of the block.
+func (blk *Block) Confirmations() (hexutil.Uint64, error) {
+	val, err := repository.R().Confirmations(uint64(blk.Number))
+	return hexutil.Uint64(val), err
+}
+
+// Finalized resolves the finality of the block.
+func (blk *Block) Finalized() (bool, error) {
+	return repository.R().IsFinalized(uint64(blk.Number))
+}
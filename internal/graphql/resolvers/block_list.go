@@ -29,8 +29,9 @@ func NewBlockList(blocks *types.BlockList, totalCount *hexutil.Big) *BlockList {
 
 // Blocks resolves list of blockchain blocks encapsulated in a listable structure.
 func (rs *rootResolver) Blocks(args *struct {
-	Cursor *Cursor
-	Count  int32
+	Cursor    *Cursor
+	Count     int32
+	Finalized bool
 }) (*BlockList, error) {
 	// do we have a cursor? try to decode it into an actual block number
 	var num *uint64
@@ -53,7 +54,12 @@ func (rs *rootResolver) Blocks(args *struct {
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the block list from repository
-	bl, err := repository.R().Blocks(num, args.Count)
+	var bl *types.BlockList
+	if args.Finalized {
+		bl, err = repository.R().FinalizedBlocks(num, args.Count)
+	} else {
+		bl, err = repository.R().Blocks(num, args.Count)
+	}
 	if err != nil {
 		log.Errorf("can not get blocks list; %s", err.Error())
 		return nil, err
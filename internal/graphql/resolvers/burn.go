@@ -15,7 +15,7 @@ func (rs *rootResolver) FtmBurnedTotal() hexutil.Big {
 		log.Criticalf("failed to load burned total; %s", err.Error())
 		return hexutil.Big{}
 	}
-	return hexutil.Big(*new(big.Int).Mul(big.NewInt(val), types.BurnDecimalsCorrection))
+	return hexutil.Big(*val)
 }
 
 // FtmBurnedTotalAmount resolves total amount of burned FTM tokens in FTM units.
@@ -25,7 +25,13 @@ func (rs *rootResolver) FtmBurnedTotalAmount() float64 {
 		log.Criticalf("failed to load burned total; %s", err.Error())
 		return 0
 	}
-	return float64(val) / types.BurnFTMDecimalsCorrection
+	return weiToFtm(val)
+}
+
+// weiToFtm converts the given amount in WEI units into FTM units.
+func weiToFtm(val *big.Int) float64 {
+	ftm, _ := new(big.Float).Quo(new(big.Float).SetInt(val), new(big.Float).SetInt(weiToFtmDecimals)).Float64()
+	return ftm
 }
 
 // FtmLatestBlockBurnList resolves a list of the latest block burns.
@@ -4,6 +4,7 @@ package resolvers
 import (
 	"crypto/sha256"
 	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/solidity"
 	"fantom-api-graphql/internal/types"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
@@ -25,6 +26,9 @@ const (
 	// scMaxSupportLinkLength is the maximum accepted length of smart contract
 	// support link.
 	scMaxSupportLinkLength = 64
+
+	// scMaxCompilerLength is the maximum accepted length of a smart contract compiler version.
+	scMaxCompilerLength = 64
 )
 
 // scVersionSyntaxRegexp represents a regular expression for testing smart contract
@@ -68,6 +72,11 @@ type ContractValidationInput struct {
 	// during the contract compilation.
 	OptimizeRuns int32 `json:"optimizeRuns"`
 
+	// Compiler represents an optional version of the Solidity compiler
+	// used to compile the contract, i.e. "v0.7.5+commit.eb77ed08".
+	// The default compiler of the API server is used if not specified.
+	Compiler *string `json:"compiler,omitempty"`
+
 	// SourceCode represents the Solidity source code to be validated.
 	SourceCode string `json:"sourceCode"`
 }
@@ -131,7 +140,17 @@ func isValidationValid(in *ContractValidationInput) error {
 		return fmt.Errorf("invalid version information provided")
 	}
 
-	// validate the version syntax
+	// check the compiler version
+	if res, in.Compiler = sanitizeStringOption(in.Compiler, scMaxCompilerLength); !res {
+		return fmt.Errorf("compiler version is too long to be valid")
+	}
+	if in.Compiler != nil {
+		if _, ok := solidity.Release(*in.Compiler); !ok {
+			return fmt.Errorf("invalid compiler version provided")
+		}
+	}
+
+	// validate the number of optimization runs
 	if in.OptimizeRuns < 0 {
 		return fmt.Errorf("invalid number of optimization runs provided")
 	}
@@ -173,6 +192,12 @@ func updateContractFromInput(con *ContractValidationInput, sc *types.Contract) {
 	if con.SupportContact != nil {
 		sc.SupportContact = *con.SupportContact
 	}
+
+	// pass the requested compiler; the default one is used otherwise
+	sc.Compiler = ""
+	if con.Compiler != nil {
+		sc.Compiler = *con.Compiler
+	}
 }
 
 // ValidateContract resolves smart contract source code vs. deployed byte code and marks
@@ -191,6 +216,9 @@ func (rs *rootResolver) ValidateContract(args *struct{ Contract ContractValidati
 		log.Errorf("contract [%s] not found", args.Contract.Address.String())
 		return nil, err
 	}
+	if sc == nil {
+		return nil, fmt.Errorf("contract %s not found", args.Contract.Address.String())
+	}
 
 	// if we already have this source code, no need to do any updates
 	hash := sourceHash(args.Contract.SourceCode)
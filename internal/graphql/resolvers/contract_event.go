@@ -0,0 +1,79 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ContractEvent represents resolvable decoded contract event.
+type ContractEvent struct {
+	types.ContractEvent
+}
+
+// ContractEventArgFilter represents a filter input of contract events by an argument value.
+type ContractEventArgFilter struct {
+	Name  string
+	Value string
+}
+
+// NewContractEvent creates new instance of resolvable contract event.
+func NewContractEvent(ce *types.ContractEvent) *ContractEvent {
+	return &ContractEvent{ContractEvent: *ce}
+}
+
+// ContractEvents resolves list of decoded contract events.
+func (rs *rootResolver) ContractEvents(args struct {
+	Address *common.Address
+	Event   *string
+	Filter  *[]ContractEventArgFilter
+	Cursor  *Cursor
+	Count   int32
+	Sort    *string
+}) (*ContractEventList, error) {
+	// limit query size; the count can be either positive or negative
+	// this controls the loading direction
+	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
+
+	// collect argument filters
+	var filter []types.ContractEventArg
+	if args.Filter != nil {
+		filter = make([]types.ContractEventArg, len(*args.Filter))
+		for i, f := range *args.Filter {
+			filter[i] = types.ContractEventArg{Name: f.Name, Value: f.Value}
+		}
+	}
+
+	list, err := repository.R().ContractEvents(args.Address, args.Event, filter, (*string)(args.Cursor), args.Count,
+		listSort(args.Sort, types.FiContractEventOrdinal, types.FiContractEventOrdinal))
+	if err != nil {
+		return nil, err
+	}
+	return NewContractEventList(list), nil
+}
+
+// TrxHash resolves the hash of the transaction emitting the event.
+func (ce *ContractEvent) TrxHash() common.Hash {
+	return ce.ContractEvent.Transaction
+}
+
+// Transaction resolves the transaction emitting the event.
+func (ce *ContractEvent) Transaction() (*Transaction, error) {
+	trx, err := repository.R().Transaction(&ce.ContractEvent.Transaction)
+	if err != nil {
+		return nil, err
+	}
+	return NewTransaction(trx), nil
+}
+
+// LogIndex resolves the index of the event log in the block.
+func (ce *ContractEvent) LogIndex() hexutil.Uint64 {
+	return hexutil.Uint64(ce.ContractEvent.LogIndex)
+}
+
+// TimeStamp resolves the time stamp of the block the event was emitted in.
+func (ce *ContractEvent) TimeStamp() hexutil.Uint64 {
+	return hexutil.Uint64(ce.ContractEvent.TimeStamp.Unix())
+}
@@ -0,0 +1,60 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ContractEventList represents resolvable list of decoded contract event edges structure.
+type ContractEventList struct {
+	types.ContractEventList
+}
+
+// ContractEventListEdge represents a single edge of a contract event list structure.
+type ContractEventListEdge struct {
+	Event *ContractEvent
+}
+
+// NewContractEventList builds new resolvable list of contract events.
+func NewContractEventList(dl *types.ContractEventList) *ContractEventList {
+	return &ContractEventList{*dl}
+}
+
+// TotalCount resolves the total number of contract events in the list.
+func (cel *ContractEventList) TotalCount() hexutil.Uint64 {
+	return hexutil.Uint64(cel.Total)
+}
+
+// PageInfo resolves the current page information for the contract events list.
+func (cel *ContractEventList) PageInfo() (*ListPageInfo, error) {
+	// do we have any items?
+	if cel.Collection == nil || len(cel.Collection) == 0 {
+		return NewListPageInfo(nil, nil, false, false)
+	}
+
+	// get the first and last elements
+	first := Cursor(cel.Collection[0].Pk())
+	last := Cursor(cel.Collection[len(cel.Collection)-1].Pk())
+	return NewListPageInfo(&first, &last, !cel.IsEnd, !cel.IsStart)
+}
+
+// Edges resolves list of contract event list edges for the linked contract events list.
+func (cel *ContractEventList) Edges() []*ContractEventListEdge {
+	// do we have any items? return empty list if not
+	if cel.Collection == nil || len(cel.Collection) == 0 {
+		return make([]*ContractEventListEdge, 0)
+	}
+
+	// make the list
+	edges := make([]*ContractEventListEdge, len(cel.Collection))
+	for i, d := range cel.Collection {
+		edges[i] = &ContractEventListEdge{Event: NewContractEvent(d)}
+	}
+	return edges
+}
+
+// Cursor generates the list edge cursor.
+func (cee *ContractEventListEdge) Cursor() Cursor {
+	return Cursor(cee.Event.Pk())
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fantom-api-graphql/internal/solidity"
 	"fantom-api-graphql/internal/types"
 	"net/http"
 	"sync"
@@ -48,6 +49,11 @@ func contractSyncInput(con *types.Contract) ContractValidationInput {
 		cInput.License = &con.License
 	}
 
+	// transfer the compiler version, if any
+	if rel, ok := solidity.Release(con.Compiler); ok {
+		cInput.Compiler = &rel
+	}
+
 	return cInput
 }
 
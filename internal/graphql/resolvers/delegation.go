@@ -150,13 +150,15 @@ func (del Delegation) WithdrawRequests(args struct {
 func (del Delegation) RewardClaims(args struct {
 	Cursor *Cursor
 	Count  int32
+	Sort   *string
 }) (*RewardClaimList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// pull list of withdrawals
-	cl, err := repository.R().RewardClaims(&del.Address, (*big.Int)(del.Delegation.ToStakerId), (*string)(args.Cursor), args.Count)
+	cl, err := repository.R().RewardClaims(&del.Address, (*big.Int)(del.Delegation.ToStakerId), (*string)(args.Cursor), args.Count,
+		listSort(args.Sort, types.FiRewardClaimOrdinal, types.FiRewardClaimedValue))
 	if err != nil {
 		return nil, err
 	}
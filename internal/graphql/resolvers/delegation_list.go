@@ -66,13 +66,15 @@ func (rs *rootResolver) DelegationsOf(args *struct {
 	Staker hexutil.Big
 	Cursor *Cursor
 	Count  int32
+	Sort   *string
 }) (*DelegationList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the list
-	dl, err := repository.R().DelegationsOfValidator(&args.Staker, (*string)(args.Cursor), args.Count)
+	dl, err := repository.R().DelegationsOfValidator(&args.Staker, (*string)(args.Cursor), args.Count,
+		listSort(args.Sort, types.FiDelegationOrdinal, types.FiDelegationValue))
 	if err != nil {
 		return nil, err
 	}
@@ -86,13 +88,15 @@ func (rs *rootResolver) DelegationsByAddress(args *struct {
 	Address common.Address
 	Cursor  *Cursor
 	Count   int32
+	Sort    *string
 }) (*DelegationList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the list of delegations
-	dl, err := repository.R().DelegationsByAddress(&args.Address, (*string)(args.Cursor), args.Count)
+	dl, err := repository.R().DelegationsByAddress(&args.Address, (*string)(args.Cursor), args.Count,
+		listSort(args.Sort, types.FiDelegationOrdinal, types.FiDelegationValue))
 	if err != nil {
 		return nil, err
 	}
@@ -5,7 +5,6 @@ import (
 	"fantom-api-graphql/internal/repository"
 	"fantom-api-graphql/internal/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"math/big"
 )
 
 // Epoch represents a resolvable Epoch representation
@@ -50,7 +49,7 @@ func (rs *rootResolver) FtmTreasuryTotal() hexutil.Big {
 		log.Criticalf("failed to load treasury total; %s", err.Error())
 		return hexutil.Big{}
 	}
-	return hexutil.Big(*new(big.Int).Mul(big.NewInt(val), types.BurnDecimalsCorrection))
+	return hexutil.Big(*val)
 }
 
 // FtmTreasuryTotalAmount resolves total amount of FTM tokens in FTM units sent into treasury.
@@ -60,5 +59,5 @@ func (rs *rootResolver) FtmTreasuryTotalAmount() float64 {
 		log.Criticalf("failed to load treasury total; %s", err.Error())
 		return 0
 	}
-	return float64(val) / types.BurnFTMDecimalsCorrection
+	return weiToFtm(val)
 }
@@ -6,6 +6,8 @@ import (
 	"fantom-api-graphql/internal/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"math/big"
 	"time"
 )
 
@@ -56,40 +58,60 @@ func (fd *FeeFlowDaily) Date() graphql.Time {
 
 // Fee returns Long encoded fee amount.
 func (fd *FeeFlowDaily) Fee() hexutil.Uint64 {
-	return (hexutil.Uint64)(uint64(fd.FeeAmount))
+	return feeFlowLong(fd.FeeAmount)
 }
 
 // FeeFTM returns Long encoded fee amount in FTM units.
 func (fd *FeeFlowDaily) FeeFTM() float64 {
-	return float64(fd.FeeAmount) / types.BurnFTMDecimalsCorrection
+	return feeFlowFTM(fd.FeeAmount)
 }
 
 // Burned returns Long encoded burned fee slice amount.
 func (fd *FeeFlowDaily) Burned() hexutil.Uint64 {
-	return (hexutil.Uint64)(uint64(fd.BurnedAmount))
+	return feeFlowLong(fd.BurnedAmount)
 }
 
 // BurnedFTM returns Long encoded burned fee slice amount in FTM units.
 func (fd *FeeFlowDaily) BurnedFTM() float64 {
-	return float64(fd.BurnedAmount) / types.BurnFTMDecimalsCorrection
+	return feeFlowFTM(fd.BurnedAmount)
 }
 
 // Treasury returns Long encoded treasury fee slice amount.
 func (fd *FeeFlowDaily) Treasury() hexutil.Uint64 {
-	return (hexutil.Uint64)(uint64(fd.TreasuryAmount))
+	return feeFlowLong(fd.TreasuryAmount)
 }
 
 // TreasuryFTM returns Long encoded treasury fee slice amount in FTM units.
 func (fd *FeeFlowDaily) TreasuryFTM() float64 {
-	return float64(fd.TreasuryAmount) / types.BurnFTMDecimalsCorrection
+	return feeFlowFTM(fd.TreasuryAmount)
 }
 
 // Rewards returns Long encoded rewards fee slice amount.
 func (fd *FeeFlowDaily) Rewards() hexutil.Uint64 {
-	return (hexutil.Uint64)(uint64(fd.RewardsAmount))
+	return feeFlowLong(fd.RewardsAmount)
 }
 
 // RewardsFTM returns Long encoded rewards fee slice amount in FTM units.
 func (fd *FeeFlowDaily) RewardsFTM() float64 {
-	return float64(fd.RewardsAmount) / types.BurnFTMDecimalsCorrection
+	return feeFlowFTM(fd.RewardsAmount)
+}
+
+// feeFlowAmount decodes the given aggregated fee flow amount in WEI.
+func feeFlowAmount(val primitive.Decimal128) *big.Int {
+	am, err := types.BigFromDecimal(val)
+	if err != nil {
+		log.Errorf("invalid fee flow amount; %s", err.Error())
+		return new(big.Int)
+	}
+	return am
+}
+
+// feeFlowLong provides the given fee flow amount with the reduced burn precision.
+func feeFlowLong(val primitive.Decimal128) hexutil.Uint64 {
+	return hexutil.Uint64(new(big.Int).Div(feeFlowAmount(val), types.BurnDecimalsCorrection).Uint64())
+}
+
+// feeFlowFTM provides the given fee flow amount in FTM units.
+func feeFlowFTM(val primitive.Decimal128) float64 {
+	return weiToFtm(feeFlowAmount(val))
 }
@@ -35,6 +35,16 @@ type ApiResolver interface {
 		Count         int32
 	}) (*ContractList, error)
 
+	// ContractEvents resolves list of decoded events of contracts configured for the events indexer.
+	ContractEvents(struct {
+		Address *common.Address
+		Event   *string
+		Filter  *[]ContractEventArgFilter
+		Cursor  *Cursor
+		Count   int32
+		Sort    *string
+	}) (*ContractEventList, error)
+
 	// ValidateContract resolves smart contract source code vs. deployed byte code and marks
 	// the contract as validated if the match is found. Peer API points are ringed on success
 	// to notify them about the change.
@@ -42,21 +52,34 @@ type ApiResolver interface {
 
 	// Block resolves blockchain block by number or by hash. If neither is provided, the most recent block is given.
 	Block(*struct {
-		Number *hexutil.Uint64
-		Hash   *common.Hash
+		Number    *hexutil.Uint64
+		Hash      *common.Hash
+		Finalized bool
 	}) (*Block, error)
 
 	// Blocks resolves list of blockchain blocks encapsulated in a listable structure.
 	Blocks(*struct {
-		Cursor *Cursor
-		Count  int32
+		Cursor    *Cursor
+		Count     int32
+		Finalized bool
 	}) (*BlockList, error)
 
 	// Transaction resolves blockchain transaction by hash.
-	Transaction(*struct{ Hash common.Hash }) (*Transaction, error)
+	Transaction(*struct {
+		Hash      common.Hash
+		Finalized bool
+	}) (*Transaction, error)
 
 	// Transactions resolves list of blockchain transactions encapsulated in a listable structure.
 	Transactions(*struct {
+		Cursor    *Cursor
+		Count     int32
+		Finalized bool
+	}) (*TransactionList, error)
+
+	// TransactionsFiltered resolves list of blockchain transactions matching the given filter.
+	TransactionsFiltered(*struct {
+		Filter TransactionFilter
 		Cursor *Cursor
 		Count  int32
 	}) (*TransactionList, error)
@@ -67,6 +90,17 @@ type ApiResolver interface {
 	// OnTransaction resolves subscription to new transactions' event broadcast.
 	OnTransaction(ctx context.Context) <-chan *Transaction
 
+	// PendingTransactions resolves list of pending transactions sent from, and/or to the given addresses.
+	PendingTransactions(*struct {
+		From   *common.Address
+		To     *common.Address
+		Cursor *Cursor
+		Count  int32
+	}) (*TransactionList, error)
+
+	// OnPendingTransaction resolves subscription to new pending transactions' event broadcast.
+	OnPendingTransaction(context.Context, *struct{ Filter *PendingTransactionFilter }) <-chan *Transaction
+
 	// CurrentEpoch resolves id of the current epoch.
 	CurrentEpoch() (hexutil.Uint64, error)
 
@@ -86,7 +120,7 @@ type ApiResolver interface {
 	}) (*Staker, error)
 
 	// Stakers resolves a list of staker information from SFC smart contract.
-	Stakers() ([]*Staker, error)
+	Stakers(context.Context) ([]*Staker, error)
 
 	// Delegation resolves details of a delegator by its address.
 	Delegation(*struct {
@@ -99,6 +133,7 @@ type ApiResolver interface {
 		Staker hexutil.Big
 		Cursor *Cursor
 		Count  int32
+		Sort   *string
 	}) (*DelegationList, error)
 
 	// DelegationsByAddress a list of own delegations by the account address.
@@ -106,6 +141,7 @@ type ApiResolver interface {
 		Address common.Address
 		Cursor  *Cursor
 		Count   int32
+		Sort    *string
 	}) (*DelegationList, error)
 
 	// Price resolves price details of the Opera blockchain token for the given target symbols.
@@ -0,0 +1,38 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// InternalTransaction represents resolvable internal transaction made by a contract.
+type InternalTransaction struct {
+	types.InternalTransaction
+}
+
+// NewInternalTransaction creates new instance of resolvable internal transaction.
+func NewInternalTransaction(itx *types.InternalTransaction) *InternalTransaction {
+	return &InternalTransaction{InternalTransaction: *itx}
+}
+
+// TrxHash resolves the hash of the parent transaction.
+func (itx *InternalTransaction) TrxHash() common.Hash {
+	return itx.InternalTransaction.Transaction
+}
+
+// Transaction resolves the parent transaction of the internal transaction.
+func (itx *InternalTransaction) Transaction() (*Transaction, error) {
+	trx, err := repository.R().Transaction(&itx.InternalTransaction.Transaction)
+	if err != nil {
+		return nil, err
+	}
+	return NewTransaction(trx), nil
+}
+
+// TimeStamp resolves the time stamp of the parent transaction.
+func (itx *InternalTransaction) TimeStamp() hexutil.Uint64 {
+	return hexutil.Uint64(itx.InternalTransaction.TimeStamp.Unix())
+}
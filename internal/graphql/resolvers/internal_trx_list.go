@@ -0,0 +1,60 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// InternalTransactionList represents resolvable list of decoded internal transaction edges structure.
+type InternalTransactionList struct {
+	types.InternalTransactionList
+}
+
+// InternalTransactionListEdge represents a single edge of a internal transaction list structure.
+type InternalTransactionListEdge struct {
+	Transaction *InternalTransaction
+}
+
+// NewInternalTransactionList builds new resolvable list of internal transactions.
+func NewInternalTransactionList(dl *types.InternalTransactionList) *InternalTransactionList {
+	return &InternalTransactionList{*dl}
+}
+
+// TotalCount resolves the total number of internal transactions in the list.
+func (itl *InternalTransactionList) TotalCount() hexutil.Uint64 {
+	return hexutil.Uint64(itl.Total)
+}
+
+// PageInfo resolves the current page information for the internal transactions list.
+func (itl *InternalTransactionList) PageInfo() (*ListPageInfo, error) {
+	// do we have any items?
+	if itl.Collection == nil || len(itl.Collection) == 0 {
+		return NewListPageInfo(nil, nil, false, false)
+	}
+
+	// get the first and last elements
+	first := Cursor(itl.Collection[0].Pk())
+	last := Cursor(itl.Collection[len(itl.Collection)-1].Pk())
+	return NewListPageInfo(&first, &last, !itl.IsEnd, !itl.IsStart)
+}
+
+// Edges resolves list of internal transaction list edges for the linked internal transactions list.
+func (itl *InternalTransactionList) Edges() []*InternalTransactionListEdge {
+	// do we have any items? return empty list if not
+	if itl.Collection == nil || len(itl.Collection) == 0 {
+		return make([]*InternalTransactionListEdge, 0)
+	}
+
+	// make the list
+	edges := make([]*InternalTransactionListEdge, len(itl.Collection))
+	for i, d := range itl.Collection {
+		edges[i] = &InternalTransactionListEdge{Transaction: NewInternalTransaction(d)}
+	}
+	return edges
+}
+
+// Cursor generates the list edge cursor.
+func (ile *InternalTransactionListEdge) Cursor() Cursor {
+	return Cursor(ile.Transaction.Pk())
+}
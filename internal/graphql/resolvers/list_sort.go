@@ -0,0 +1,22 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import "fantom-api-graphql/internal/types"
+
+// listSort converts the requested list sort order into the sort of a list with the given ordinal
+// and amount fields. Nil is provided for the default order of the list.
+func listSort(sort *string, ordinal string, amount string) *types.ListSort {
+	if sort == nil {
+		return nil
+	}
+
+	switch *sort {
+	case "OLDEST":
+		return &types.ListSort{Field: ordinal, Ascending: true}
+	case "LARGEST":
+		return &types.ListSort{Field: amount}
+	case "SMALLEST":
+		return &types.ListSort{Field: amount, Ascending: true}
+	}
+	return nil
+}
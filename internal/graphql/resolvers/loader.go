@@ -0,0 +1,96 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"sync"
+	"time"
+)
+
+// loaderBatchWindow represents the time a loader collects keys
+// of an execution tick before they are fetched in a single batch.
+const loaderBatchWindow = 2 * time.Millisecond
+
+// batchLoader collects keys requested by resolvers executed in parallel
+// and loads them in a single batch using the fetch function.
+// Loaded values are kept for the lifetime of the loader, e.g. a single API request.
+type batchLoader struct {
+	fetch func(keys []string) (map[string]interface{}, error)
+	wait  time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*loaderEntry
+	queue   []string
+	timer   *time.Timer
+}
+
+// loaderEntry represents a single key of the loader.
+type loaderEntry struct {
+	done chan struct{}
+	val  interface{}
+	err  error
+}
+
+// newBatchLoader creates a new batch loader using the given fetch function.
+func newBatchLoader(fetch func(keys []string) (map[string]interface{}, error)) *batchLoader {
+	return &batchLoader{
+		fetch:   fetch,
+		wait:    loaderBatchWindow,
+		entries: make(map[string]*loaderEntry),
+	}
+}
+
+// prime adds the given keys to the next batch without waiting for it.
+// List resolvers prime keys of all their items, so the first item loaded
+// pulls the whole page at once.
+func (bl *batchLoader) prime(keys ...string) {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+
+	for _, key := range keys {
+		bl.entry(key)
+	}
+}
+
+// load provides the value of the given key; nil if the key does not exist.
+func (bl *batchLoader) load(key string) (interface{}, error) {
+	bl.mu.Lock()
+	le := bl.entry(key)
+	if len(bl.queue) > 0 && bl.timer == nil {
+		bl.timer = time.AfterFunc(bl.wait, bl.dispatch)
+	}
+	bl.mu.Unlock()
+
+	<-le.done
+	return le.val, le.err
+}
+
+// entry provides the entry of the given key, queuing a new one for the next batch.
+// The caller is expected to hold the lock.
+func (bl *batchLoader) entry(key string) *loaderEntry {
+	le, ok := bl.entries[key]
+	if !ok {
+		le = &loaderEntry{done: make(chan struct{})}
+		bl.entries[key] = le
+		bl.queue = append(bl.queue, key)
+	}
+	return le
+}
+
+// dispatch fetches all the queued keys in a single batch.
+func (bl *batchLoader) dispatch() {
+	bl.mu.Lock()
+	keys := bl.queue
+	bl.queue, bl.timer = nil, nil
+
+	list := make([]*loaderEntry, len(keys))
+	for i, key := range keys {
+		list[i] = bl.entries[key]
+	}
+	bl.mu.Unlock()
+
+	values, err := bl.fetch(keys)
+	for i, le := range list {
+		le.val, le.err = values[keys[i]], err
+		close(le.done)
+	}
+}
@@ -0,0 +1,64 @@
+package resolvers
+
+import (
+	"fmt"
+	"github.com/onsi/gomega"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBatchLoader(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	var calls, fetched int32
+	bl := newBatchLoader(func(keys []string) (map[string]interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		atomic.AddInt32(&fetched, int32(len(keys)))
+
+		res := make(map[string]interface{}, len(keys))
+		for _, key := range keys {
+			if key != "missing" {
+				res[key] = "value of " + key
+			}
+		}
+		return res, nil
+	})
+
+	// primed keys are fetched along with the first key loaded
+	keys := make([]string, 50)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key%d", i)
+	}
+	bl.prime(keys...)
+
+	var wg sync.WaitGroup
+	for _, key := range append(keys, "missing") {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			val, err := bl.load(key)
+			g.Expect(err).To(gomega.BeNil())
+			if key == "missing" {
+				g.Expect(val).To(gomega.BeNil())
+				return
+			}
+			g.Expect(val).To(gomega.Equal("value of " + key))
+		}(key)
+	}
+	wg.Wait()
+	g.Expect(atomic.LoadInt32(&calls) <= 2).To(gomega.BeTrue())
+
+	// loaded keys are kept for the lifetime of the loader
+	val, err := bl.load("key7")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(val).To(gomega.Equal("value of key7"))
+	g.Expect(atomic.LoadInt32(&fetched)).To(gomega.Equal(int32(51)))
+
+	// fetch errors are reported to all the keys of the batch
+	bl = newBatchLoader(func(keys []string) (map[string]interface{}, error) {
+		return nil, fmt.Errorf("failed")
+	})
+	_, err = bl.load("key")
+	g.Expect(err).NotTo(gomega.BeNil())
+}
@@ -0,0 +1,188 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"context"
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// loadersContextKey represents the type of the request loaders context key.
+type loadersContextKey struct{}
+
+// loadersKey represents the context key of the request loaders.
+var loadersKey = loadersContextKey{}
+
+// loaders represents the set of batch loaders of a single API request.
+type loaders struct {
+	accounts   *batchLoader
+	blocks     *batchLoader
+	stakerInfo *batchLoader
+}
+
+// WithLoaders provides a new context carrying a fresh set of batch loaders
+// used by the resolvers of a single API request.
+func WithLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey, &loaders{
+		accounts:   newBatchLoader(fetchAccounts),
+		blocks:     newBatchLoader(fetchBlocks),
+		stakerInfo: newBatchLoader(fetchStakerInfo),
+	})
+}
+
+// loadersOf provides the batch loaders of the given context; nil if not available.
+func loadersOf(ctx context.Context) *loaders {
+	if ctx == nil {
+		return nil
+	}
+	l, _ := ctx.Value(loadersKey).(*loaders)
+	return l
+}
+
+// loadAccount resolves the account of the given address using the request loader, if available.
+func loadAccount(ctx context.Context, addr *common.Address) (*types.Account, error) {
+	l := loadersOf(ctx)
+	if l == nil {
+		return repository.R().Account(addr)
+	}
+
+	val, err := l.accounts.load(addr.String())
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return repository.R().Account(addr)
+	}
+	return val.(*types.Account), nil
+}
+
+// loadBlock resolves the block of the given number using the request loader, if available.
+func loadBlock(ctx context.Context, num *hexutil.Uint64) (*types.Block, error) {
+	l := loadersOf(ctx)
+	if l == nil {
+		return repository.R().BlockByNumber(num)
+	}
+
+	val, err := l.blocks.load(num.String())
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, repository.ErrBlockNotFound
+	}
+	return val.(*types.Block), nil
+}
+
+// loadStakerInfo resolves the extended information of the given staker using the request loader, if available.
+func loadStakerInfo(ctx context.Context, id *hexutil.Big) *types.StakerInfo {
+	l := loadersOf(ctx)
+	if l == nil {
+		return repository.R().RetrieveStakerInfo(id)
+	}
+
+	val, err := l.stakerInfo.load(id.String())
+	if err != nil || val == nil {
+		return nil
+	}
+	return val.(*types.StakerInfo)
+}
+
+// primeTransactions queues senders, recipients and blocks of the given transactions
+// to be loaded in a single batch.
+func primeTransactions(ctx context.Context, txs []*types.Transaction) {
+	l := loadersOf(ctx)
+	if l == nil {
+		return
+	}
+
+	accounts := make([]string, 0, 2*len(txs))
+	blocks := make([]string, 0, len(txs))
+	for _, t := range txs {
+		accounts = append(accounts, t.From.String())
+		if t.To != nil {
+			accounts = append(accounts, t.To.String())
+		}
+		if t.BlockNumber != nil {
+			blocks = append(blocks, t.BlockNumber.String())
+		}
+	}
+	l.accounts.prime(accounts...)
+	l.blocks.prime(blocks...)
+}
+
+// primeStakers queues extended information of the given stakers to be loaded in a single batch.
+func primeStakers(ctx context.Context, list []*Staker) {
+	l := loadersOf(ctx)
+	if l == nil {
+		return
+	}
+
+	ids := make([]string, len(list))
+	for i, st := range list {
+		ids[i] = st.Id.String()
+	}
+	l.stakerInfo.prime(ids...)
+}
+
+// fetchAccounts loads a batch of accounts by their addresses.
+func fetchAccounts(keys []string) (map[string]interface{}, error) {
+	addrs := make([]common.Address, len(keys))
+	for i, key := range keys {
+		addrs[i] = common.HexToAddress(key)
+	}
+
+	list, err := repository.R().Accounts(addrs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]interface{}, len(list))
+	for i, key := range keys {
+		if acc, ok := list[addrs[i]]; ok {
+			res[key] = acc
+		}
+	}
+	return res, nil
+}
+
+// fetchBlocks loads a batch of blocks by their numbers.
+func fetchBlocks(keys []string) (map[string]interface{}, error) {
+	nums := make([]hexutil.Uint64, len(keys))
+	for i, key := range keys {
+		if err := nums[i].UnmarshalText([]byte(key)); err != nil {
+			return nil, err
+		}
+	}
+
+	list, err := repository.R().BlocksByNumber(nums)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]interface{}, len(list))
+	for i, key := range keys {
+		if blk, ok := list[nums[i]]; ok {
+			res[key] = blk
+		}
+	}
+	return res, nil
+}
+
+// fetchStakerInfo loads a batch of extended staker information by the staker ids.
+func fetchStakerInfo(keys []string) (map[string]interface{}, error) {
+	ids := make([]hexutil.Big, len(keys))
+	for i, key := range keys {
+		if err := ids[i].UnmarshalText([]byte(key)); err != nil {
+			return nil, err
+		}
+	}
+
+	list := repository.R().RetrieveStakerInfos(ids)
+	res := make(map[string]interface{}, len(list))
+	for key, sti := range list {
+		res[key] = sti
+	}
+	return res, nil
+}
@@ -0,0 +1,75 @@
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/logger"
+	"fantom-api-graphql/internal/repository/rpc"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+	"testing"
+)
+
+// fixtureChain is the recorded chain fixture shared with the node bridge tests.
+const fixtureChain = "../../repository/rpc/testdata/chain"
+
+func TestReplayResolvers(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	log := logger.New(&config.Config{Log: config.Log{Level: "ERROR", Format: "%{message}"}})
+	br, err := rpc.New(&config.Config{Opera: config.OperaNode{Transport: "replay", Fixtures: fixtureChain}}, log)
+	g.Expect(err).To(gomega.BeNil())
+	defer br.Close()
+
+	tag := "0x3e8"
+	b, err := br.Block(&tag)
+	g.Expect(err).To(gomega.BeNil())
+
+	blk := NewBlock(b)
+	g.Expect(*blk.TransactionCount()).To(gomega.Equal(int32(2)))
+	g.Expect(blk.TxHashList()).To(gomega.HaveLen(2))
+
+	// transactions are resolved as stored by the block pipeline
+	list := make([]*Transaction, 0, len(b.Txs))
+	for _, th := range blk.TxHashList() {
+		tx, err := br.Transaction(&th)
+		g.Expect(err).To(gomega.BeNil())
+
+		data, err := bson.Marshal(tx)
+		g.Expect(err).To(gomega.BeNil())
+
+		var stored types.Transaction
+		g.Expect(bson.Unmarshal(data, &stored)).To(gomega.Succeed())
+		list = append(list, NewTransaction(&stored))
+	}
+
+	native := list[0]
+	g.Expect(native.Hash).To(gomega.Equal(*b.Txs[0]))
+	g.Expect(uint64(*native.Status)).To(gomega.Equal(uint64(1)))
+	g.Expect(uint64(*native.GasUsed)).To(gomega.Equal(uint64(21000)))
+	g.Expect(native.Value.ToInt().String()).To(gomega.Equal("1000000000000000000"))
+	g.Expect(native.Logs()).To(gomega.BeEmpty())
+
+	input, err := native.InputData()
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(input).To(gomega.BeEmpty())
+
+	// the token transfer carries the call input and the Transfer event
+	token := list[1]
+	g.Expect(token.From).To(gomega.Equal(native.From))
+	g.Expect(*token.To).To(gomega.Equal(common.HexToAddress("0x04068da6c83afcfa0e13ba15a6696662335d5b75")))
+
+	input, err = token.InputData()
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(input.String()).To(gomega.ContainSubstring("0xa9059cbb"))
+
+	logs := token.Logs()
+	g.Expect(logs).To(gomega.HaveLen(1))
+	g.Expect(logs[0].Address()).To(gomega.Equal(*token.To))
+	g.Expect(logs[0].Index()).To(gomega.BeNumerically("==", 0))
+	g.Expect(logs[0].Topics()).To(gomega.HaveLen(3))
+	g.Expect(logs[0].Topics()[0]).To(gomega.Equal(common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")))
+	g.Expect(logs[0].Topics()[1]).To(gomega.Equal(common.BytesToHash(token.From.Bytes())))
+	g.Expect(logs[0].Data().String()).To(gomega.Equal("0x00000000000000000000000000000000000000000000000000000000004c4b40"))
+}
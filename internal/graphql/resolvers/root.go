@@ -41,6 +41,12 @@ type rootResolver struct {
 	unsubscribeOnTrx chan string
 	trxSubscribers   map[string]*subscriptOnTrx
 	onTrxEvents      chan *types.Transaction
+
+	// pending transaction subscriptions management
+	subscribeOnPending   chan *subscriptOnPending
+	unsubscribeOnPending chan string
+	pendingSubscribers   map[string]*subscriptOnPending
+	onPendingEvents      chan *types.Transaction
 }
 
 // log represents the logger to be used by the repository.
@@ -87,6 +93,12 @@ func New() ApiResolver {
 		unsubscribeOnTrx: make(chan string, subscriptionQueueCapacity),
 		trxSubscribers:   make(map[string]*subscriptOnTrx, subscriptionInitialCapacity),
 		onTrxEvents:      make(chan *types.Transaction, onBlockChannelCapacity),
+
+		// pending transaction events subscription basics
+		subscribeOnPending:   make(chan *subscriptOnPending, subscriptionQueueCapacity),
+		unsubscribeOnPending: make(chan string, subscriptionQueueCapacity),
+		pendingSubscribers:   make(map[string]*subscriptOnPending, subscriptionInitialCapacity),
+		onPendingEvents:      make(chan *types.Transaction, onPendingChannelCapacity),
 	}
 
 	// pass subscription data source channels to the service manager
@@ -94,6 +106,7 @@ func New() ApiResolver {
 	sm := svc.Manager()
 	sm.SetBlockChannel(rs.onBlockEvents)
 	sm.SetTrxChannel(rs.onTrxEvents)
+	sm.SetPendingTrxChannel(rs.onPendingEvents)
 
 	// handle broadcast and subscriptions in a separate routine
 	rs.wg.Add(1)
@@ -136,17 +149,26 @@ func (rs *rootResolver) run() {
 		case id := <-rs.unsubscribeOnTrx:
 			delete(rs.trxSubscribers, id)
 
+		case id := <-rs.unsubscribeOnPending:
+			delete(rs.pendingSubscribers, id)
+
 		case sub := <-rs.subscribeOnBlock:
 			rs.addBlockSubscriber(sub)
 
 		case sub := <-rs.subscribeOnTrx:
 			rs.addTrxSubscriber(sub)
 
+		case sub := <-rs.subscribeOnPending:
+			rs.addPendingSubscriber(sub)
+
 		case evt := <-rs.onBlockEvents:
 			rs.dispatchOnBlock(evt)
 
 		case evt := <-rs.onTrxEvents:
 			rs.dispatchOnTransaction(evt)
+
+		case evt := <-rs.onPendingEvents:
+			rs.dispatchOnPending(evt)
 		}
 	}
 }
@@ -2,6 +2,7 @@
 package resolvers
 
 import (
+	"context"
 	"fantom-api-graphql/internal/repository"
 	"fantom-api-graphql/internal/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -42,13 +43,15 @@ func NewStaker(st *types.Validator) *Staker {
 func (st Staker) Delegations(args struct {
 	Cursor *Cursor
 	Count  int32
+	Sort   *string
 }) (*DelegationList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
 	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
 
 	// get delegations
-	dl, err := repository.R().DelegationsOfValidator(&st.Id, (*string)(args.Cursor), args.Count)
+	dl, err := repository.R().DelegationsOfValidator(&st.Id, (*string)(args.Cursor), args.Count,
+		listSort(args.Sort, types.FiDelegationOrdinal, types.FiDelegationValue))
 	if err != nil {
 		return nil, err
 	}
@@ -57,8 +60,8 @@ func (st Staker) Delegations(args struct {
 }
 
 // StakerInfo resolves extended staker information if available.
-func (st Staker) StakerInfo() *types.StakerInfo {
-	return repository.R().RetrieveStakerInfo(&st.Id)
+func (st Staker) StakerInfo(ctx context.Context) *types.StakerInfo {
+	return loadStakerInfo(ctx, &st.Id)
 }
 
 // DelegationLock returns information about validator lock.
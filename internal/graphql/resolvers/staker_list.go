@@ -1,6 +1,7 @@
 package resolvers
 
 import (
+	"context"
 	"fantom-api-graphql/internal/repository"
 	"fantom-api-graphql/internal/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -9,13 +10,13 @@ import (
 )
 
 // Stakers resolves a list of staker information from SFC smart contract.
-func (rs *rootResolver) Stakers() ([]*Staker, error) {
-	return loadStakersFiltered(func(v *types.Validator) bool { return v != nil })
+func (rs *rootResolver) Stakers(ctx context.Context) ([]*Staker, error) {
+	return loadStakersFiltered(ctx, func(v *types.Validator) bool { return v != nil })
 }
 
 // StakersWithFlag resolves a list of stakers for the given type of flag.
-func (rs *rootResolver) StakersWithFlag(args struct{ Flag string }) ([]*Staker, error) {
-	return loadStakersFiltered(func(v *types.Validator) bool {
+func (rs *rootResolver) StakersWithFlag(ctx context.Context, args struct{ Flag string }) ([]*Staker, error) {
+	return loadStakersFiltered(ctx, func(v *types.Validator) bool {
 		if v == nil {
 			return false
 		}
@@ -37,8 +38,8 @@ func (rs *rootResolver) StakersWithFlag(args struct{ Flag string }) ([]*Staker,
 }
 
 // loadStakersFiltered loads list of validators check each one if it can be added to the output list
-// using a provided callback check.
-func loadStakersFiltered(check func(*types.Validator) bool) ([]*Staker, error) {
+// using a provided callback check. Extended information of the listed stakers is loaded in a single batch.
+func loadStakersFiltered(ctx context.Context, check func(*types.Validator) bool) ([]*Staker, error) {
 	// get the number
 	num, err := repository.R().LastValidatorId()
 	if err != nil {
@@ -73,6 +74,7 @@ func loadStakersFiltered(check func(*types.Validator) bool) ([]*Staker, error) {
 
 	// sort the list by total amount delegated and return the result
 	sort.Sort(StakesByTotalStaked(list))
+	primeStakers(ctx, list)
 	return list, nil
 }
 
@@ -0,0 +1,123 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"context"
+	"fantom-api-graphql/internal/svc"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"time"
+)
+
+// onPendingChannelCapacity is the number of new pending transaction events held in memory for being broadcast to subscriber.
+const onPendingChannelCapacity = 500
+
+// PendingTransactionFilter represents a filter of pending transactions by their sender and/or recipient.
+type PendingTransactionFilter struct {
+	From *common.Address
+	To   *common.Address
+}
+
+// subscriptOnPending represents reference to a subscriber to onPendingTransaction events broadcast.
+type subscriptOnPending struct {
+	stop   <-chan struct{}
+	events chan<- *Transaction
+	filter *PendingTransactionFilter
+}
+
+// PendingTransactions resolves list of pending transactions sent from, and/or to the given addresses.
+func (rs *rootResolver) PendingTransactions(args *struct {
+	From   *common.Address
+	To     *common.Address
+	Cursor *Cursor
+	Count  int32
+}) (*TransactionList, error) {
+	// limit query size; the count can be either positive or negative
+	// this controls the loading direction
+	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
+
+	var cursor *common.Hash
+	if args.Cursor != nil {
+		hash := common.HexToHash(string(*args.Cursor))
+		cursor = &hash
+	}
+	return NewTransactionList(svc.Manager().PendingTransactions(args.From, args.To, cursor, args.Count)), nil
+}
+
+// OnPendingTransaction resolves subscription to new pending transactions' event broadcast.
+func (rs *rootResolver) OnPendingTransaction(ctx context.Context, args *struct {
+	Filter *PendingTransactionFilter
+}) <-chan *Transaction {
+	// make the stream
+	c := make(chan *Transaction, onPendingChannelCapacity)
+
+	// subscribe to event dispatch
+	rs.subscribeOnPending <- &subscriptOnPending{
+		stop:   ctx.Done(),
+		events: c,
+		filter: args.Filter,
+	}
+	return c
+}
+
+// addPendingSubscriber adds a new subscription to onPendingTransaction events.
+func (rs *rootResolver) addPendingSubscriber(sub *subscriptOnPending) {
+	id, err := uuid()
+	if err == nil {
+		// add the subscriber to the map
+		rs.pendingSubscribers[id] = sub
+	} else {
+		// log critical issue
+		log.Critical("can not generate UUID for new onPendingTransaction subscriber")
+		log.Critical(err)
+	}
+}
+
+// dispatchOnPending dispatches onPendingTransaction event to registered subscribers.
+func (rs *rootResolver) dispatchOnPending(trx *types.Transaction) {
+	// prep the transaction
+	transaction := NewTransaction(trx)
+
+	// broadcast the event in separate go routines so we don't block here
+	for id, sub := range rs.pendingSubscribers {
+		if sub.filter.matches(trx) {
+			go rs.notifyOnPending(transaction, sub, id)
+		}
+	}
+}
+
+// notifyOnPending broadcasts onPendingTransaction event to given subscriber.
+func (rs *rootResolver) notifyOnPending(trx *Transaction, sub *subscriptOnPending, id string) {
+	// check if the context isn't already closed in which case we just unsub and leave
+	select {
+	case <-sub.stop:
+		rs.unsubscribeOnPending <- id
+		return
+	default:
+	}
+
+	// broadcast
+	select {
+	case <-sub.stop:
+		// just unsub on broken context
+		rs.unsubscribeOnPending <- id
+
+	case sub.events <- trx:
+		// push the transaction to subscriber
+
+	case <-time.After(time.Second):
+		// timeout reached without response? just remove the subscriber
+		rs.unsubscribeOnPending <- id
+	}
+}
+
+// matches checks if the given transaction passes the filter; an empty filter passes all transactions.
+func (fi *PendingTransactionFilter) matches(trx *types.Transaction) bool {
+	if fi == nil {
+		return true
+	}
+	if fi.From != nil && trx.From != *fi.From {
+		return false
+	}
+	return fi.To == nil || (trx.To != nil && *trx.To == *fi.To)
+}
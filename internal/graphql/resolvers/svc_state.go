@@ -0,0 +1,54 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/svc"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ServiceState represents resolvable state of a blockchain data processing service.
+type ServiceState struct {
+	svc.ServiceStatus
+}
+
+// Services resolves the state of the blockchain data processing services of the API server.
+func (cst CurrentState) Services() []ServiceState {
+	list := svc.Manager().Status()
+
+	res := make([]ServiceState, len(list))
+	for i, st := range list {
+		res[i] = ServiceState{st}
+	}
+	return res
+}
+
+// LastError resolves the last error reported by the service, if any.
+func (ss ServiceState) LastError() *string {
+	if ss.ServiceStatus.LastError == "" {
+		return nil
+	}
+	return &ss.ServiceStatus.LastError
+}
+
+// LastProgress resolves the time of the last progress reported by the service.
+func (ss ServiceState) LastProgress() hexutil.Uint64 {
+	return ss.unix(ss.ServiceStatus.LastProgress.Unix())
+}
+
+// Since resolves the time the service has been started.
+func (ss ServiceState) Since() hexutil.Uint64 {
+	return ss.unix(ss.ServiceStatus.Since.Unix())
+}
+
+// Restarts resolves the number of restarts of the service.
+func (ss ServiceState) Restarts() int32 {
+	return int32(ss.ServiceStatus.Restarts)
+}
+
+// unix converts the given Unix time to the time stamp; zero time is provided for unknown time.
+func (ss ServiceState) unix(ts int64) hexutil.Uint64 {
+	if ts < 0 {
+		return 0
+	}
+	return hexutil.Uint64(ts)
+}
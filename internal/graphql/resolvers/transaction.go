@@ -2,6 +2,7 @@
 package resolvers
 
 import (
+	"context"
 	"fantom-api-graphql/internal/repository"
 	"fantom-api-graphql/internal/types"
 	"fmt"
@@ -25,7 +26,11 @@ func NewTransaction(trx *types.Transaction) *Transaction {
 }
 
 // Transaction resolves blockchain transaction by transaction hash.
-func (rs *rootResolver) Transaction(args *struct{ Hash common.Hash }) (tx *Transaction, err error) {
+// Only a transaction of a final block is resolved if finalized is requested.
+func (rs *rootResolver) Transaction(args *struct {
+	Hash      common.Hash
+	Finalized bool
+}) (tx *Transaction, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			log.Criticalf("transaction loader crashed on %s", args.Hash.String())
@@ -46,6 +51,17 @@ func (rs *rootResolver) Transaction(args *struct{ Hash common.Hash }) (tx *Trans
 		log.Errorf("transaction %s not found", args.Hash.String())
 		return nil, fmt.Errorf("transaction %s not found", args.Hash.String())
 	}
+
+	// make sure the transaction is final, if requested
+	if args.Finalized {
+		if trx.BlockNumber == nil {
+			return nil, nil
+		}
+		isFinal, err := repository.R().IsFinalized(uint64(*trx.BlockNumber))
+		if err != nil || !isFinal {
+			return nil, err
+		}
+	}
 	return NewTransaction(trx), nil
 }
 
@@ -62,9 +78,9 @@ func (rs *rootResolver) SendTransaction(args *struct{ Tx hexutil.Bytes }) (*Tran
 }
 
 // Sender resolves sender's account of the transaction.
-func (trx *Transaction) Sender() (*Account, error) {
+func (trx *Transaction) Sender(ctx context.Context) (*Account, error) {
 	// get the sender by address
-	acc, err := repository.R().Account(&trx.From)
+	acc, err := loadAccount(ctx, &trx.From)
 	if err != nil {
 		return nil, err
 	}
@@ -73,14 +89,14 @@ func (trx *Transaction) Sender() (*Account, error) {
 }
 
 // Recipient resolves recipient's account of the transaction.
-func (trx *Transaction) Recipient() (*Account, error) {
+func (trx *Transaction) Recipient(ctx context.Context) (*Account, error) {
 	// no recipient available
 	if trx.To == nil {
 		return nil, nil
 	}
 
 	// get the recipient by address
-	acc, err := repository.R().Account(trx.To)
+	acc, err := loadAccount(ctx, trx.To)
 	if err != nil {
 		return nil, err
 	}
@@ -89,14 +105,14 @@ func (trx *Transaction) Recipient() (*Account, error) {
 }
 
 // Block resolves block the transaction is bundled in, nil if it's pending and not added to a block yet.
-func (trx *Transaction) Block() (*Block, error) {
+func (trx *Transaction) Block(ctx context.Context) (*Block, error) {
 	// no recipient available
 	if trx.BlockNumber == nil {
 		return nil, nil
 	}
 
 	// get the sender by address
-	blk, err := repository.R().BlockByNumber(trx.BlockNumber)
+	blk, err := loadBlock(ctx, trx.BlockNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -187,3 +203,39 @@ func (trx *Transaction) Erc1155Transactions() ([]*ERC1155Transaction, error) {
 	}
 	return list, nil
 }
+
+// InternalTransactions resolves list of internal transactions made by contracts
+// during execution of this transaction.
+func (trx *Transaction) InternalTransactions() ([]*InternalTransaction, error) {
+	tl, err := repository.R().InternalTransactions(&trx.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*InternalTransaction, len(tl))
+	for i, itx := range tl {
+		list[i] = NewInternalTransaction(itx)
+	}
+	return list, nil
+}
+
+// Confirmations resolves the number of blocks observed on top of the block of the transaction.
+func (trx *Transaction) Confirmations() (*hexutil.Uint64, error) {
+	if trx.BlockNumber == nil {
+		return nil, nil
+	}
+
+	val, err := repository.R().Confirmations(uint64(*trx.BlockNumber))
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Uint64)(&val), nil
+}
+
+// Finalized resolves the finality of the transaction; pending transactions are never final.
+func (trx *Transaction) Finalized() (bool, error) {
+	if trx.BlockNumber == nil {
+		return false, nil
+	}
+	return repository.R().IsFinalized(uint64(*trx.BlockNumber))
+}
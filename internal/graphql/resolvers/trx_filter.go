@@ -0,0 +1,127 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/graph-gophers/graphql-go"
+	"math/big"
+	"time"
+)
+
+// TransactionFilter represents a filter of listed transactions.
+type TransactionFilter struct {
+	From            *common.Address
+	To              *common.Address
+	Party           *common.Address
+	ContractCreated *bool
+	MinValue        *hexutil.Big
+	MaxValue        *hexutil.Big
+	MinTime         *graphql.Time
+	MaxTime         *graphql.Time
+	MinBlock        *hexutil.Uint64
+	MaxBlock        *hexutil.Uint64
+	Status          *hexutil.Uint64
+	Selector        *hexutil.Bytes
+	MinGasPrice     *hexutil.Big
+	MaxGasPrice     *hexutil.Big
+}
+
+// TransactionsFiltered resolves list of blockchain transactions matching the given filter.
+func (rs *rootResolver) TransactionsFiltered(args *struct {
+	Filter TransactionFilter
+	Cursor *Cursor
+	Count  int32
+}) (*TransactionList, error) {
+	tf, err := args.Filter.filter()
+	if err != nil {
+		return nil, err
+	}
+
+	// limit query size; the count can be either positive or negative
+	// this controls the loading direction
+	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
+
+	txs, err := repository.R().TransactionsFiltered(tf, (*string)(args.Cursor), args.Count)
+	if err != nil {
+		log.Errorf("can not get filtered transactions list; %s", err.Error())
+		return nil, err
+	}
+	return NewTransactionList(txs), nil
+}
+
+// filter validates the transaction filter and converts it into the repository filter.
+func (tf *TransactionFilter) filter() (*types.TransactionFilter, error) {
+	if tf.Status != nil && *tf.Status > 1 {
+		return nil, fmt.Errorf("invalid transaction status %d, expected 0 or 1", uint64(*tf.Status))
+	}
+
+	if err := trxFilterRange("value", trxFilterBig(tf.MinValue), trxFilterBig(tf.MaxValue)); err != nil {
+		return nil, err
+	}
+	if err := trxFilterRange("gas price", trxFilterBig(tf.MinGasPrice), trxFilterBig(tf.MaxGasPrice)); err != nil {
+		return nil, err
+	}
+	if tf.MinBlock != nil && tf.MaxBlock != nil && *tf.MinBlock > *tf.MaxBlock {
+		return nil, fmt.Errorf("invalid block range, %d is above %d", uint64(*tf.MinBlock), uint64(*tf.MaxBlock))
+	}
+	if tf.MinTime != nil && tf.MaxTime != nil && tf.MinTime.After(tf.MaxTime.Time) {
+		return nil, fmt.Errorf("invalid time range, %s is after %s", tf.MinTime.String(), tf.MaxTime.String())
+	}
+
+	out := types.TransactionFilter{
+		From:            tf.From,
+		To:              tf.To,
+		Party:           tf.Party,
+		ContractCreated: tf.ContractCreated,
+		MinValue:        trxFilterBig(tf.MinValue),
+		MaxValue:        trxFilterBig(tf.MaxValue),
+		MinTime:         trxFilterTime(tf.MinTime),
+		MaxTime:         trxFilterTime(tf.MaxTime),
+		MinBlock:        (*uint64)(tf.MinBlock),
+		MaxBlock:        (*uint64)(tf.MaxBlock),
+		Status:          (*uint64)(tf.Status),
+		MinGasPrice:     trxFilterBig(tf.MinGasPrice),
+		MaxGasPrice:     trxFilterBig(tf.MaxGasPrice),
+	}
+
+	if tf.Selector != nil {
+		var sel types.TrxSelector
+		if len(*tf.Selector) != len(sel) {
+			return nil, fmt.Errorf("invalid method selector %s, expected %d bytes", tf.Selector.String(), len(sel))
+		}
+		copy(sel[:], *tf.Selector)
+		out.Selector = &sel
+	}
+	return &out, nil
+}
+
+// trxFilterRange validates the given range of amounts of the transaction filter.
+func trxFilterRange(name string, min *big.Int, max *big.Int) error {
+	if (min != nil && min.Sign() < 0) || (max != nil && max.Sign() < 0) {
+		return fmt.Errorf("invalid %s range, negative amount", name)
+	}
+	if min != nil && max != nil && min.Cmp(max) > 0 {
+		return fmt.Errorf("invalid %s range, %s is above %s", name, min.String(), max.String())
+	}
+	return nil
+}
+
+// trxFilterBig converts the optional amount of the transaction filter.
+func trxFilterBig(val *hexutil.Big) *big.Int {
+	if val == nil {
+		return nil
+	}
+	return val.ToInt()
+}
+
+// trxFilterTime converts the optional time of the transaction filter.
+func trxFilterTime(val *graphql.Time) *time.Time {
+	if val == nil {
+		return nil
+	}
+	return &val.Time
+}
@@ -118,7 +118,11 @@ func trxVolumeRange(args struct {
 
 // Amount resolves the amount of native tokens transferred.
 func (dtv *DailyTrxVolume) Amount() hexutil.Big {
-	val := new(big.Int).Mul(new(big.Int).SetInt64(dtv.DailyTrxVolume.AmountAdjusted), types.TransactionDecimalsCorrection)
+	val, err := types.BigFromDecimal(dtv.DailyTrxVolume.Amount)
+	if err != nil {
+		log.Errorf("invalid daily trx volume amount; %s", err.Error())
+		return hexutil.Big{}
+	}
 	return hexutil.Big(*val)
 }
 
@@ -2,6 +2,7 @@
 package resolvers
 
 import (
+	"context"
 	"fantom-api-graphql/internal/repository"
 	"fantom-api-graphql/internal/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -28,15 +29,22 @@ func NewTransactionList(txs *types.TransactionList) *TransactionList {
 
 // Transactions resolves list of blockchain transactions encapsulated in a listable structure.
 func (rs *rootResolver) Transactions(args *struct {
-	Cursor *Cursor
-	Count  int32
+	Cursor    *Cursor
+	Count     int32
+	Finalized bool
 }) (*TransactionList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the transaction hash list from repository
-	txs, err := repository.R().Transactions((*string)(args.Cursor), args.Count)
+	var txs *types.TransactionList
+	var err error
+	if args.Finalized {
+		txs, err = repository.R().FinalizedTransactions((*string)(args.Cursor), args.Count)
+	} else {
+		txs, err = repository.R().Transactions((*string)(args.Cursor), args.Count)
+	}
 	if err != nil {
 		log.Errorf("can not get transactions list; %s", err.Error())
 		return nil, err
@@ -64,12 +72,15 @@ func (tl *TransactionList) PageInfo() (*ListPageInfo, error) {
 }
 
 // Edges resolves list of transaction list edges for the linked transaction list.
-func (tl *TransactionList) Edges() []*TransactionListEdge {
+func (tl *TransactionList) Edges(ctx context.Context) []*TransactionListEdge {
 	// do we have any items? return empty list if not
 	if tl.Collection == nil || len(tl.Collection) == 0 {
 		return make([]*TransactionListEdge, 0)
 	}
 
+	// senders, recipients and blocks of the page are loaded in a single batch
+	primeTransactions(ctx, tl.Collection)
+
 	// make the list
 	edges := make([]*TransactionListEdge, len(tl.Collection))
 	for i, t := range tl.Collection {
@@ -0,0 +1,58 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	retypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// TransactionLog represents resolvable log record emitted by a transaction.
+type TransactionLog struct {
+	retypes.Log
+}
+
+// LogEvent represents resolvable decoded event of a transaction log record.
+type LogEvent struct {
+	types.LogEvent
+}
+
+// Logs resolves the list of log records emitted by the transaction.
+func (trx *Transaction) Logs() []*TransactionLog {
+	list := make([]*TransactionLog, len(trx.Transaction.Logs))
+	for i, lg := range trx.Transaction.Logs {
+		list[i] = &TransactionLog{Log: lg}
+	}
+	return list
+}
+
+// Address resolves the address of the contract emitting the log record.
+func (tl *TransactionLog) Address() common.Address {
+	return tl.Log.Address
+}
+
+// Topics resolves the list of topics of the log record.
+func (tl *TransactionLog) Topics() []common.Hash {
+	return tl.Log.Topics
+}
+
+// Data resolves the non-indexed data of the log record.
+func (tl *TransactionLog) Data() hexutil.Bytes {
+	return tl.Log.Data
+}
+
+// Index resolves the index of the log record in the block.
+func (tl *TransactionLog) Index() hexutil.Uint64 {
+	return hexutil.Uint64(tl.Log.Index)
+}
+
+// Decoded resolves the decoded event of the log record, if the event is known.
+func (tl *TransactionLog) Decoded() (*LogEvent, error) {
+	le, err := repository.R().LogEvent(&tl.Log)
+	if err != nil || le == nil {
+		return nil, err
+	}
+	return &LogEvent{LogEvent: *le}, nil
+}
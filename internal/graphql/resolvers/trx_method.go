@@ -0,0 +1,107 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"encoding/json"
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TransactionMethod represents resolvable contract method called by a transaction.
+type TransactionMethod struct {
+	types.TrxMethod
+}
+
+// trxCall represents the called method of a transaction along with the full call input.
+type trxCall struct {
+	method *types.TrxMethod
+	input  []byte
+}
+
+// Selector resolves the 4 bytes selector of the called method.
+func (tm *TransactionMethod) Selector() hexutil.Bytes {
+	return tm.TrxMethod.Selector[:]
+}
+
+// InputData resolves the data supplied to the target of the transaction.
+// Large input data are not stored with the transaction, they are loaded from the node.
+func (trx *Transaction) InputData() (hexutil.Bytes, error) {
+	return trx.callInput()
+}
+
+// Method resolves the contract method called by the transaction, if known.
+func (trx *Transaction) Method() (*TransactionMethod, error) {
+	call, err := trx.call()
+	if err != nil || call.method == nil {
+		return nil, err
+	}
+	return &TransactionMethod{TrxMethod: *call.method}, nil
+}
+
+// DecodedInput resolves the JSON encoded list of decoded arguments of the called method, if known.
+func (trx *Transaction) DecodedInput() (*string, error) {
+	call, err := trx.call()
+	if err != nil || call.method == nil {
+		return nil, err
+	}
+
+	args, err := types.DecodeCallInput(call.method.Abi, call.input)
+	if err != nil {
+		log.Debugf("can not decode input of %s; %s", trx.Hash.String(), err.Error())
+		return nil, nil
+	}
+
+	data, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	val := string(data)
+	return &val, nil
+}
+
+// call resolves the called method of the transaction only once for all the call related fields.
+func (trx *Transaction) call() (*trxCall, error) {
+	val, err, _ := trx.cg.Do("call", func() (interface{}, error) {
+		input, err := trx.callInput()
+		if err != nil {
+			return nil, err
+		}
+
+		m, err := repository.R().TransactionMethod(trx.To, input)
+		if err != nil {
+			log.Errorf("can not resolve method of %s; %s", trx.Hash.String(), err.Error())
+			return nil, err
+		}
+		return &trxCall{method: m, input: input}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return val.(*trxCall), nil
+}
+
+// callInput provides the full input data of the transaction, loading large input from the node.
+func (trx *Transaction) callInput() ([]byte, error) {
+	if !trx.LargeInput || len(trx.Transaction.InputData) > 0 {
+		return trx.Transaction.InputData, nil
+	}
+
+	val, err, _ := trx.cg.Do("input", func() (interface{}, error) {
+		tx, err := repository.R().LoadTransaction(&trx.Hash)
+		if err != nil {
+			log.Errorf("can not load input of %s; %s", trx.Hash.String(), err.Error())
+			return nil, err
+		}
+		if tx == nil {
+			return nil, fmt.Errorf("transaction %s not found", trx.Hash.String())
+		}
+		return []byte(tx.InputData), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return val.([]byte), nil
+}
@@ -2,132 +2,85 @@ package gqlschema
 
 // Auto generated GraphQL schema bundle
 const schema = `
-# FMintUserToken represents a pair of fMint protocol user
-# and a token used by the user for a specific operation
-# as reported by fMint users listings.
-type FMintUserToken {
-    # purpose represents the type of usage of the token by the user.
-    purpose: FMintUserTokenPurpose!
-
-    # userAddress represents the address of the user account.
-    userAddress: Address!
-
-    # account represents the full record of the fMint account
-    account: FMintAccount!
+# ERC20TransactionList is a list of ERC20 transaction edges provided by sequential access request.
+type ERC20TransactionList {
+    # Edges contains provided edges of the sequential list.
+    edges: [ERC20TransactionListEdge!]!
 
-    # tokenAddress represents the address of the associated token.
-    tokenAddress: Address!
+    # TotalCount is the maximum number of ERC20 transactions available for sequential access.
+    totalCount: BigInt!
 
-    # token represents the detail of the token associated.
-    token: ERC20Token!
+    # PageInfo is an information about the current page of ERC20 transaction edges.
+    pageInfo: ListPageInfo!
 }
 
-# FMintUserTokenPurpose represents the purpose of the fMint user token pair.
-enum FMintUserTokenPurpose {
-    FMINT_COLLATERAL
-    FMINT_DEBT
+# TransactionListEdge is a single edge in a sequential list of ERC20 transactions.
+type ERC20TransactionListEdge {
+    cursor: Cursor!
+    trx: ERC20Transaction!
 }
-# DailyTrxVolume represents a view of an aggregated flow
-# of transactions on the network on specific day.
-type DailyTrxVolume {
-    # day represents the day of the aggregation in format YYYY-MM-DD
-    # i.e. 2021-01-23 for January 23rd, 2021
-    day: String!
 
-    # volume represent the number of transactions originated / mined
-    # by the network on the day.
-    volume: Int!
+# BlockList is a list of block edges provided by sequential access request.
+type BlockList {
+    # Edges contains provided edges of the sequential list.
+    edges: [BlockListEdge!]!
 
-    # amount represents the total value of native tokens transferred
-    # by the network on the day. Please note this includes only direct
-    # token transfers.
-    amount: BigInt!
+    # TotalCount is the maximum number of blocks available for sequential access.
+    totalCount: BigInt!
 
-    # gas represents the total amount of gas consumed by transactions
-    # on the network on the day.
-    gas: BigInt!
+    # PageInfo is an information about the current page of block edges.
+    pageInfo: ListPageInfo!
 }
 
-# DefiToken represents a token available for DeFi operations.
-type DefiToken {
-    # address of the token is used as the token's unique identifier.
-    address: Address!
-
-    # name of the token.
-    name: String!
-
-    # symbol used as an abbreviation for the token.
-    symbol: String!
-
-    # logoUrl is the URL of the token logo image.
-    logoUrl: String!
-
-    # decimals is the number of decimals the token supports.
-    # The most common value is 18 to mimic the ETH to WEI relationship.
-    decimals: Int!
-
-    # isActive signals if the token can be used
-    # in the DeFi functions at all.
-    isActive: Boolean!
-
-    # canWrapFTM signals if the token can be used
-    # to wrap native FTM tokens for DeFi trading.
-    canWrapFTM: Boolean!
-
-    # canDeposit signals if the token can be used
-    # in deposit as a collateral asset.
-    canDeposit: Boolean!
+# BlockListEdge is a single edge in a sequential list of blocks.
+type BlockListEdge {
+    cursor: Cursor!
+    block: Block!
+}
 
-    # canMint signals if the token can be used
-    # in fMint protocol as the target token.
-    canMint: Boolean!
+# ERC721Transaction represents a transaction on an ERC721 NFT token.
+type ERC721Transaction {
+    # trxHash represents a hash of the transaction
+    # executing the ERC721 call.
+    trxHash: Bytes32!
 
-    # canBorrow signals if the token is available
-    # for FLend borrow operations.
-    canBorrow: Boolean!
+    # transaction represents the transaction
+    # executing the ERC721 call.
+    transaction: Transaction!
 
-    # canTrade signals if the token is available
-    # for FTrade direct trading operations.
-    canTrade: Boolean!
+    # trxIndex represents the index
+    # of the ERC721 call in the transaction logs.
+    trxIndex: Long!
 
-    # price represents the value of the token in ref. denomination.
-    # We use fUSD tokens as the synth reference value.
-    price: BigInt!
+    # tokenAddress represents the address
+    # of the ERC721 token contract.
+    tokenAddress: Address!
 
-    # priceDecimals is the number of decimals used on the price
-    # field to properly handle value calculations without loosing precision.
-    priceDecimals: Int!
+    # token represents the ERC721 contract detail involved.
+    token: ERC721Contract!
 
-    # availableBalance represents the total available balance of the token
-    # on the account regardless of the DeFi usage of the token.
-    # It's effectively the amount available held by the ERC20 token
-    # on the account behalf.
-    availableBalance(owner: Address!): BigInt!
+    # tokenId represents the NFT token - one ERC721 contract can handle multiple NFTs.
+    tokenId: BigInt!
 
-    # defiAllowance represents the amount of ERC20 tokens unlocked
-    # by the owner / token holder to be accessible for DeFi operations.
-    # If an operation requires access to certain ERC20 token, the DeFi
-    # contract must be allowed to make a transfer of required amount
-    # of tokens from the owner to the DeFi Liquidity Poll.
-    # If it's not given, the operation will fail.
-    allowance(owner: Address!): BigInt!
+    # trxType is the type of the transaction.
+    trxType: TokenTransactionType!
 
-    # totalSupply represents total amount of tokens across all accounts
-    totalSupply: BigInt!
+    # sender represents the address of the token owner
+    # sending the tokens, e.g. the sender.
+    sender: Address!
 
-    # totalDeposited represents total amount of deposited tokens collateral on fMint.
-    totalDeposit: BigInt!
+    # recipient represents the address of the token recipient.
+    recipient: Address!
 
-    # totalDebt represents total amount of borrowed/minted tokens on fMint.
-    totalDebt: BigInt!
-}
+    # amount represents the amount of tokens involved
+    # in the transaction; please make sure to interpret the amount
+    # with the correct number of decimals from the ERC721 token detail.
+    amount: BigInt!
 
-# DefiTokenBalanceType represents the type of DeFi token balance record.
-enum DefiTokenBalanceType {
-    COLLATERAL
-    DEBT
+    # timeStamp represents the Unix epoch time stamp
+    # of the ERC721 transaction processing.
+    timeStamp: Long!
 }
-
 # TokenTransactionType represents a type of ERC-20/ERC-721/ERC-1155 transaction.
 enum TokenTransactionType {
     TRANSFER
@@ -178,194 +131,191 @@ type ERC20Transaction {
     # of the ERC20 transaction processing.
     timeStamp: Long!
 }
-# RewardClaimList is a list of reward claims linked to delegations.
-type RewardClaimList {
+# ERC1155TransactionList is a list of ERC1155 transaction edges provided by sequential access request.
+type ERC1155TransactionList {
     # Edges contains provided edges of the sequential list.
-    edges: [RewardClaimListEdge!]!
+    edges: [ERC1155TransactionListEdge!]!
 
-    # TotalCount is the maximum number of reward claims
-    # available for sequential access.
-    totalCount: Long!
+    # TotalCount is the maximum number of ERC1155 transactions available for sequential access.
+    totalCount: BigInt!
 
-    # PageInfo is an information about the current page
-    # of reward claim edges.
+    # PageInfo is an information about the current page of ERC1155 transaction edges.
     pageInfo: ListPageInfo!
 }
 
-# RewardClaimListEdge is a single edge in a sequential list
-# of reward claims.
-type RewardClaimListEdge {
-    # Cursor defines a scroll key to this edge.
+# TransactionListEdge is a single edge in a sequential list of ERC1155 transactions.
+type ERC1155TransactionListEdge {
     cursor: Cursor!
-
-    # claim represents the reward claim detail provided by this list edge.
-    claim: RewardClaim!
+    trx: ERC1155Transaction!
 }
 
-# EpochList is a list of epoch edges provided by sequential access request.
-type EpochList {
-    # Edges contains provided edges of the sequential list.
-    edges: [EpochListEdge!]!
+# SfcConfig represents the configuration of the SFC contract
+# responsible for managing the staking economy of the network.
+type SfcConfig {
+    # minValidatorStake is the minimal amount of tokens required
+    # to register a validator account with the default self stake.
+    minValidatorStake: BigInt!
 
-    # TotalCount is the maximum number of epochs
-    # available for sequential access.
-    totalCount: Long!
+    # maxDelegatedRatio is the maximal ratio between a validator self stake
+    # and the sum of all the received stakes of the validator.
+    # The value is provided as a multiplier number with 18 decimals.
+    maxDelegatedRatio: BigInt!
 
-    # PageInfo is an information about the current page of epoch list edges.
-    pageInfo: ListPageInfo!
-}
+    # minLockupDuration is the lowest possible number of seconds
+    # a delegation can be locked for.
+    minLockupDuration: BigInt!
 
-# EpochListEdge is a single edge in a sequential list of epochs.
-type EpochListEdge {
-    #Cursor defines a scroll key to this edge.
-    cursor: Cursor!
+    # maxLockupDuration is the highest possible number of seconds
+    # a delegation can be locked for.
+    maxLockupDuration: BigInt!
 
-    # epoch represents the Epoch provided by this list edge.
-    epoch: Epoch!
-}
+    # withdrawalPeriodEpochs is the minimal number of epochs
+    # between an un-delegation and corresponding withdraw request.
+    # The delay is enforced on withdraw call.
+    withdrawalPeriodEpochs: BigInt!
 
-# Price represents price information of core Opera token
-type Price {
-    "Source unit symbol."
-    fromSymbol: String!
+    # withdrawalPeriodTime is the minimal number of seconds
+    # between an un-delegation and corresponding withdraw request.
+    # The delay is enforced on withdraw call.
+    withdrawalPeriodTime: BigInt!
+}
 
-    "Target unit symbol."
-    toSymbol: String!
+# LendingPool represents a lendingpool instance.
+type LendingPool {
 
-    "Price of the source symbol unit in target symbol unit."
-    price: Float!
+    # Returns all assets reserve addresses
+    reserveList: [Address!]!
 
-    "Price change in last 24h."
-    change24: Float!
+    # A list of all assets reserves with its data
+    reserveDataList: [ReserveData!]!
 
-    "Price change in percent in last 24h."
-    changePct24: Float!
+    # Asset reserve data just for one asset address
+	reserveData(address: Address!): ReserveData!
 
-    "Open 24h price."
-    open24: Float!
+    # User account data for specified user address
+    userAccountData(address: Address!): FLendUserData!
 
-    "Highest 24h price."
-    high24: Float!
+    # User account deposit event history data
+    userDepositHistory(address: Address, asset: Address): [FLendDeposit!]!
+}
 
-    "Lowest 24h price."
-    low24: Float!
+# ReserveData represents a lendingpool asset data.
+# Unit Ray is 1e27.
+type ReserveData {
 
-    "Volume exchanged in last 24h price."
-    volume24: Float!
+    # address of the asset
+    assetAddress: Address!
 
-    "Market cap of the source unit."
-    marketCap: Float!
+    # number in the reserveList() array
+    ID: Int!
 
-    "Timestamp of the last update of this price value."
-    lastUpdate: Long!
-}
+    # bitmask encoded asset reserve configuration data
+    configuration: BigInt!
 
-# ERC1155Contract represents a generic ERC1155 multi-token contract.
-type ERC1155Contract {
-    # address of the token is used as the token's unique identifier.
-    address: Address!
+    # liquidity index in ray
+    liquidityIndex: BigInt!
 
-    # uri provides URI of Metadata JSON Schema for given token.
-    uri(tokenId: BigInt!): String
+    # variable borrow index in ray
+    variableBorrowIndex: BigInt!
 
-    # balanceOf represents amount of tokens on the account.
-    balanceOf(owner: Address!, tokenId: BigInt!): BigInt!
+    # current supply / liquidity / deposit rate in ray
+    currentLiquidityRate: BigInt!
 
-    # balanceOf represents amount of tokens on the account.
-    balanceOfBatch(owners: [Address!]!, tokenIds: [BigInt!]!): [BigInt!]!
+    # current variable borrow rate in ray
+    currentVariableBorrowRate: BigInt!
 
-    # isApprovedForAll queries the approval status of an operator for a given owner.
-    isApprovedForAll(owner: Address!, operator: Address!): Boolean
-}
+    # current stable borrow rate in ray
+    currentStableBorrowRate: BigInt!
 
-# TransactionList is a list of transaction edges provided by sequential access request.
-type TransactionList {
-    # Edges contains provided edges of the sequential list.
-    edges: [TransactionListEdge!]!
+    # timestamp of when reserve data was last updated
+    lastUpdateTimestamp: BigInt!
 
-    # TotalCount is the maximum number of transactions available for sequential access.
-    totalCount: BigInt!
+    # address of associated aToken (tokenised deposit)
+    aTokenAddress: Address!
 
-    # PageInfo is an information about the current page of transaction edges.
-    pageInfo: ListPageInfo!
-}
+    # address of associated stable debt token
+	stableDebtTokenAddress: Address!
 
-# TransactionListEdge is a single edge in a sequential list of transactions.
-type TransactionListEdge {
-    cursor: Cursor!
-    transaction: Transaction!
+    # address of associated variable debt token
+	variableDebtTokenAddress: Address!
+
+    # address of interest rate strategy
+    interestRateStrategyAddress: Address!
 }
 
 
-# BlockList is a list of block edges provided by sequential access request.
-type BlockList {
-    # Edges contains provided edges of the sequential list.
-    edges: [BlockListEdge!]!
+# FLendUserData represents a lendingpool user data.
+type FLendUserData {
 
-    # TotalCount is the maximum number of blocks available for sequential access.
-    totalCount: BigInt!
+    # total collateral in FUSD of the user
+	totalCollateralFUSD: BigInt!
 
-    # PageInfo is an information about the current page of block edges.
-    pageInfo: ListPageInfo!
-}
+    # total debt in FUSD of the user
+	totalDebtFUSD: BigInt!
 
-# BlockListEdge is a single edge in a sequential list of blocks.
-type BlockListEdge {
-    cursor: Cursor!
-    block: Block!
-}
+    # borrowing power left of the user in FUSD
+	availableBorrowsFUSD: BigInt!
 
-# StakerInfo represents extended staker information from smart contract.
-type StakerInfo {
-    "Name represents the name of the staker."
-    name: String
+    # liquidation threshold of the user
+	currentLiquidationThreshold: BigInt!
 
-    "LogoUrl represents staker logo URL."
-    logoUrl: String
+    # Loan To Value of the user
+	ltv: BigInt!
 
-    "Website represents a link to stakers website."
-    website: String
+    # current health factor of the user
+	healthFactor: BigInt!
 
-    "Contact represents a link to contact to the staker."
-    contact: String
+    # configuration data
+    configurationData: BigInt!
 }
-# GasPriceTick represents a collected gas price tick.
-type GasPriceTick {
-    # fromTime is the time of the tick measurement start
-    fromTime: Time!
 
-    # toTime is the time of the tick measurement end
-    toTime: Time!
+# FLendDeposit represents a lendingpool deposit event data.
+type FLendDeposit {
 
-    # openPrice is the opening gas price in the tick
-    openPrice: Long!
+    # address of the asset
+	assetAddress: Address!
 
-    # closePrice is the closing gas price in the tick
-    closePrice: Long!
+	# address of the user
+	userAddress: Address!
 
-    # minPrice is the lowest reached price in the tick
-    minPrice: Long!
+    # address of the on behalf of
+	onBehalfOfAddress: Address!
 
-    # maxPrice is the highest reached price in the tick
-    maxPrice: Long!
+	# deposit amount
+	amount: BigInt!
 
-    # avgPrice is the average reached price in the tick
-    avgPrice: Long!
+	# referral code
+	referralCode: Int!
+
+    # time of deposit
+    timestamp: Long!
 }
 
-# ListPageInfo contains information about a sequential access list page.
-type ListPageInfo {
-    # First is the cursor of the first edge of the edges list. null for empty list.
-    first: Cursor
+# FLendBorrow represents a lending pool borrow event data.
+type FLendBorrow {
+    # address of the asset
+	assetAddress: Address!
 
-    # Last if the cursor of the last edge of the edges list. null for empty list.
-    last: Cursor
+	# address of the user
+	userAddress: Address!
 
-    # HasNext specifies if there is another edge after the last one.
-    hasNext: Boolean!
+    # address of the on behalf of
+	onBehalfOfAddress: Address!
 
-    # HasNext specifies if there is another edge before the first one.
-    hasPrevious: Boolean!
+	# deposit amount
+	amount: BigInt!
+
+    # interest rate mode
+    interestRateMode: Int!
+
+    # borrow rate
+    borrowRate: Int!
+
+	# referral code
+	referralCode: Int!
+
+    # time of deposit
+    timestamp: Long!
 }
 # Transaction is an Opera block chain transaction.
 type Transaction {
@@ -417,6 +367,20 @@ type Transaction {
     # is a contract address.
     inputData: Bytes!
 
+    # method represents the contract method called by the transaction.
+    # The method is resolved from the ABI of the verified recipient contract,
+    # or from the database of known function signatures. Null if the method is not known.
+    method: TransactionMethod
+
+    # decodedInput represents the JSON encoded list of arguments of the called method
+    # with their name, ABI type and value. Numbers are provided as decimal strings,
+    # addresses, hashes and byte arrays are hex encoded. Null if the method is not known.
+    decodedInput: String
+
+    # logs represents the list of log records emitted by the transaction.
+    # The list is empty for pending transactions.
+    logs: [TransactionLog!]!
+
     # BlockHash is the hash of the block this transaction was assigned to.
     # Null if the transaction is pending.
     blockHash: Bytes32
@@ -450,206 +414,141 @@ type Transaction {
     # erc1155Transactions provides list of ERC-1155 NFT transactions executed in the scope
     # of this blockchain transaction call.
     erc1155Transactions: [ERC1155Transaction!]!
-}
 
-# NetworkNodeGroupLevel represents the detail of network node count aggregation.
-enum NetworkNodeGroupLevel {
-    CONTINENT
-    COUNTRY
-    STATE
-}
+    # internalTransactions provides list of internal transactions made by contracts
+    # during execution of this transaction. The list is available only if the API server
+    # is configured to trace transactions.
+    internalTransactions: [InternalTransaction!]!
 
-# NetworkNodeGroup represents an aggregated group of Opera network nodes.
-type NetworkNodeGroup {
-    # topRegion represents the name of the top level location of the aggregation group.
-    topRegion: String!
+    # confirmations is the number of blocks observed on top of the block
+    # of this transaction. Null if the transaction is pending.
+    confirmations: Long
 
-    # region represents the name of the location of the aggregation group
-    # based on selected detail level.
-    region: String!
+    # finalized signals the block of this transaction has the configured number
+    # of confirmations and the transaction is considered final.
+    finalized: Boolean!
+}
 
-    # count represents the number of nodes in the aggregation group.
-    count: Int!
+# TransactionMethod represents a contract method called by a transaction.
+type TransactionMethod {
+    # name represents the name of the method.
+    name: String!
 
-    # latitude represents an average geographic coordinate
-    # that specifies the north–south position of the group on the Earth's surface.
-    latitude: Float!
+    # signature represents the canonical signature of the method,
+    # i.e. transfer(address,uint256).
+    signature: String!
 
-    # longitude represents an average geographic coordinate
-    # that specifies the east–west position of the group on the Earth's surface.
-    longitude: Float!
+    # selector represents the 4 bytes selector of the method.
+    selector: Bytes!
 
-    # pct represents the percentage share of the aggregation group
-    # compared to the number of all known active nodes. The number is provided
-    # as fixed point integer with 1 decimal precision (i.e. 258 = 25.8%, 1000 = 100%)
-    pct: Int!
+    # verified signals the method was resolved from the ABI of the verified contract
+    # and not from the database of known function signatures. Different functions
+    # may share the same selector, so methods of unverified contracts are a best guess.
+    verified: Boolean!
 }
 
-# NetworkNodeGroupList represents a list of network node groups with a specified detail level.
-type NetworkNodeGroupList {
-    # level represents the level of detail of the aggregation group list.
-    level: NetworkNodeGroupLevel!
-
-    # totalCount represents the total number of nodes in the list.
-    totalCount: Int!
-
-    # groups represents an array of groups in the list.
-    groups: [NetworkNodeGroup!]!
-}
+# TransactionLog represents a log record emitted by a transaction.
+type TransactionLog {
+    # address represents the address of the contract emitting the log record.
+    address: Address!
 
-# Block is an Opera block chain block.
-type Block {
-    # Number is the number of this block, starting at 0 for the genesis block.
-    number: Long!
+    # topics represents the list of topics of the log record.
+    # The first topic is the hash of the event signature for non-anonymous events.
+    topics: [Bytes32!]!
 
-    # Hash is the unique block hash of this block.
-    hash: Bytes32!
+    # data represents the non-indexed data of the log record.
+    data: Bytes!
 
-    # Parent is the parent block of this block.
-    parent: Block
+    # index represents the index of the log record in the block.
+    index: Long!
 
-    # TransactionCount is the number of transactions in this block.
-    transactionCount: Int
+    # decoded represents the decoded event of the log record. The event is decoded
+    # by the ABI of the verified emitting contract, or by the table of well known
+    # event signatures. Null if the event is not known.
+    decoded: LogEvent
+}
 
-    # Timestamp is the unix timestamp at which this block was mined.
-    timestamp: Long!
+# LogEvent represents a decoded event of a transaction log record.
+type LogEvent {
+    # event represents the name of the event.
+    event: String!
 
-    # GasLimit represents the maximum gas allowed in this block.
-    gasLimit: Long!
+    # signature represents the canonical signature of the event,
+    # i.e. Transfer(address,address,uint256).
+    signature: String!
 
-    # GasUsed represents the actual total used gas by all transactions in this block.
-    gasUsed: Long!
+    # topic represents the hash of the event signature.
+    topic: Bytes32!
 
-    # txHashList is the list of unique hash values of transaction
-    # assigned to the block.
-    txHashList: [Bytes32!]!
+    # verified signals the event was decoded by the ABI of the verified emitting contract
+    # and not by the table of well known event signatures.
+    verified: Boolean!
 
-    # txList is a list of transactions assigned to the block.
-    txList: [Transaction!]!
+    # args represents the list of decoded event arguments in the order of the event signature.
+    args: [ContractEventArg!]!
 }
 
-# ERC721Contract represents a generic ERC721 non-fungible tokens (NFT) contract.
-type ERC721Contract {
-    # address of the token is used as the token's unique identifier.
-    address: Address!
+# TransactionFilter represents a filter of listed transactions.
+# Only the provided conditions are applied; ranges include their borders.
+input TransactionFilter {
+    # from is the address of the sender.
+    from: Address
 
-    # name of the token.
-    name: String!
+    # to is the address of the recipient.
+    to: Address
 
-    # symbol used as an abbreviation for the token.
-    symbol: String!
+    # party is the address of either the sender, or the recipient.
+    party: Address
 
-    # totalSupply represents total amount of tokens across all accounts
-    totalSupply: BigInt
+    # contractCreated selects transactions deploying a contract if true,
+    # or transactions not deploying any contract if false.
+    contractCreated: Boolean
 
-    # balanceOf represents amount of tokens on the account.
-    balanceOf(owner: Address!): BigInt!
+    # minValue and maxValue limit the transferred value in WEI.
+    minValue: BigInt
+    maxValue: BigInt
 
-    # tokenURI provides URI of Metadata JSON Schema of the token.
-    tokenURI(tokenId: BigInt!): String
+    # minTime and maxTime limit the time stamp of the transaction.
+    minTime: Time
+    maxTime: Time
 
-    # ownerOf provides the owner of NFT identified by tokenId
-    ownerOf(tokenId: BigInt!): Address
+    # minBlock and maxBlock limit the number of the block of the transaction.
+    minBlock: Long
+    maxBlock: Long
 
-    # getApproved provides the operator approved by owner
-    getApproved(tokenId: BigInt!): Address
+    # status is the status of the transaction; 1 for success, 0 for failure.
+    status: Long
 
-    # isApprovedForAll queries the approval status of an operator for a given owner.
-    isApprovedForAll(owner: Address!, operator: Address!): Boolean
+    # selector is the 4 bytes selector of the called contract method.
+    # Calls with large input data stored before the selector was introduced are not matched.
+    selector: Bytes
+
+    # minGasPrice and maxGasPrice limit the gas price in WEI.
+    minGasPrice: BigInt
+    maxGasPrice: BigInt
 }
 
-# SfcConfig represents the configuration of the SFC contract
-# responsible for managing the staking economy of the network.
-type SfcConfig {
-    # minValidatorStake is the minimal amount of tokens required
-    # to register a validator account with the default self stake.
-    minValidatorStake: BigInt!
-
-    # maxDelegatedRatio is the maximal ratio between a validator self stake
-    # and the sum of all the received stakes of the validator.
-    # The value is provided as a multiplier number with 18 decimals.
-    maxDelegatedRatio: BigInt!
-
-    # minLockupDuration is the lowest possible number of seconds
-    # a delegation can be locked for.
-    minLockupDuration: BigInt!
-
-    # maxLockupDuration is the highest possible number of seconds
-    # a delegation can be locked for.
-    maxLockupDuration: BigInt!
-
-    # withdrawalPeriodEpochs is the minimal number of epochs
-    # between an un-delegation and corresponding withdraw request.
-    # The delay is enforced on withdraw call.
-    withdrawalPeriodEpochs: BigInt!
-
-    # withdrawalPeriodTime is the minimal number of seconds
-    # between an un-delegation and corresponding withdraw request.
-    # The delay is enforced on withdraw call.
-    withdrawalPeriodTime: BigInt!
-}
-
-# ERC20Token represents a generic ERC20 token.
-type ERC20Token {
-    # address of the token is used as the token's unique identifier.
+# PendingRewards represents a detail of pending rewards for staking and delegations
+type PendingRewards {
+    # address of the delegation the reward belongs to.
     address: Address!
 
-    # name of the token.
-    name: String!
-
-    # symbol used as an abbreviation for the token.
-    symbol: String!
-
-    # decimals is the number of decimals the token supports.
-    # The most common value is 18 to mimic the ETH to WEI relationship.
-    decimals: Int!
-
-    # totalSupply represents total amount of tokens across all accounts
-    totalSupply: BigInt!
-
-    # logoURL represents a URL address of a logo of the token. It's always
-    # provided, but unknown tokens have this set to a generic logo file.
-    logoURL: String!
-
-    # balanceOf represents the total available balance of the token
-    # on the account regardless of the DeFi usage of the token.
-    # It's effectively the amount available held by the ERC20 token
-    # on the account behalf.
-    balanceOf(owner: Address!): BigInt!
-
-    # allowance represents the amount of ERC20 tokens unlocked
-    # by the owner / token holder to be accessible for the given spender.
-    allowance(owner: Address!, spender: Address!): BigInt!
-
-    # totalDeposited represents total amount of deposited tokens collateral on fMint.
-    totalDeposit: BigInt!
-
-    # totalDebt represents total amount of borrowed/minted tokens on fMint.
-    totalDebt: BigInt!
-}
-
-# DelegationList is a list of delegations edges provided by sequential access request.
-type DelegationList {
-    "Edges contains provided edges of the sequential list."
-    edges: [DelegationListEdge!]!
+    # Staker the pending reward relates to.
+    staker: BigInt!
 
-    """
-    TotalCount is the maximum number of delegations
-    available for sequential access.
-    """
-    totalCount: Long!
+    # Pending rewards amount.
+    amount: BigInt!
 
-    "PageInfo is an information about the current page of delegation edges."
-    pageInfo: ListPageInfo!
-}
+    # The first unpaid epoch. Is not used for SFCv3.
+    fromEpoch: Long!
 
-# DelegationListEdge is a single edge in a sequential list of delegations.
-type DelegationListEdge {
-    "Cursor defines a scroll key to this edge."
-    cursor: Cursor!
+    # The last unpaid epoch. Is not used for SFCv3.
+    toEpoch: Long!
 
-    "Delegator represents the delegator provided by this list edge."
-    delegation: Delegation!
+    # isOverRange signals that the rewards calculation
+    # can not be done due to too many unclaimed epochs.
+    # Is not used for SFCv3.
+    isOverRange: Boolean!
 }
 
 # Delegation represents a delegation on Opera block chain.
@@ -688,7 +587,8 @@ type Delegation {
 
     # rewardClaims provides a list of reward claims
     # of the delegation as a scrollable list of edges with details of claims.
-    rewardClaims(cursor: Cursor, count: Int = 25): RewardClaimList!
+    # The newest claims come first unless a different sort is requested.
+    rewardClaims(cursor: Cursor, count: Int = 25, sort: AmountListSort): RewardClaimList!
 
     # isFluidStakingActive indicates if the delegation is upgraded to fluid staking.
     isFluidStakingActive: Boolean!
@@ -730,221 +630,107 @@ type Delegation {
     tokenizerAllowedToWithdraw: Boolean!
 }
 
-# PendingRewards represents a detail of pending rewards for staking and delegations
-type PendingRewards {
-    # address of the delegation the reward belongs to.
-    address: Address!
-
-    # Staker the pending reward relates to.
-    staker: BigInt!
-
-    # Pending rewards amount.
-    amount: BigInt!
-
-    # The first unpaid epoch. Is not used for SFCv3.
-    fromEpoch: Long!
+# EpochList is a list of epoch edges provided by sequential access request.
+type EpochList {
+    # Edges contains provided edges of the sequential list.
+    edges: [EpochListEdge!]!
 
-    # The last unpaid epoch. Is not used for SFCv3.
-    toEpoch: Long!
+    # TotalCount is the maximum number of epochs
+    # available for sequential access.
+    totalCount: Long!
 
-    # isOverRange signals that the rewards calculation
-    # can not be done due to too many unclaimed epochs.
-    # Is not used for SFCv3.
-    isOverRange: Boolean!
+    # PageInfo is an information about the current page of epoch list edges.
+    pageInfo: ListPageInfo!
 }
 
-# Represents epoch information.
-type Epoch {
-    # Identifier of the epoch.
-    id: Long!
+# EpochListEdge is a single edge in a sequential list of epochs.
+type EpochListEdge {
+    #Cursor defines a scroll key to this edge.
+    cursor: Cursor!
 
-    # Timestamp of the epoch end.
-    endTime: Long!
+    # epoch represents the Epoch provided by this list edge.
+    epoch: Epoch!
+}
 
-    # Epoch duration in seconds.
-    duration: Long!
+# Bytes32 is a 32 byte binary string, represented by 0x prefixed hexadecimal hash.
+scalar Bytes32
 
-    # Fee at the epoch.
-    epochFee: BigInt!
+# Address is a 20 byte Opera address, represented as 0x prefixed hexadecimal number.
+scalar Address
 
-    # Total base reward weight on epoch.
-    totalBaseRewardWeight: BigInt!
+# BigInt is a large integer value. Input is accepted as either a JSON number,
+# or a hexadecimal string alternatively prefixed with 0x. Output is 0x prefixed hexadecimal.
+scalar BigInt
 
-    # Total transaction reward weight on epoch.
-    totalTxRewardWeight: BigInt!
+# Long is a 64 bit unsigned integer value.
+scalar Long
 
-    # Base reward per second of epoch.
-    baseRewardPerSecond: BigInt!
+# Bytes is an arbitrary length binary string, represented as 0x-prefixed hexadecimal.
+# An empty byte string is represented as '0x'.
+scalar Bytes
 
-    # Total amount staked. This includes all the staked
-    # amount including validators' self stake.
-    stakeTotalAmount: BigInt!
+# Cursor is a string representing position in a sequential list of edges.
+scalar Cursor
 
-    # Total supply amount.
-    totalSupply: BigInt!
-}
+# Time represents date and time including time zone information in RFC3339 format.
+scalar Time
 
-# ERC721TransactionList is a list of ERC721 transaction edges provided by sequential access request.
-type ERC721TransactionList {
+# TransactionList is a list of transaction edges provided by sequential access request.
+type TransactionList {
     # Edges contains provided edges of the sequential list.
-    edges: [ERC721TransactionListEdge!]!
+    edges: [TransactionListEdge!]!
 
-    # TotalCount is the maximum number of ERC721 transactions available for sequential access.
+    # TotalCount is the maximum number of transactions available for sequential access.
     totalCount: BigInt!
 
-    # PageInfo is an information about the current page of ERC721 transaction edges.
+    # PageInfo is an information about the current page of transaction edges.
     pageInfo: ListPageInfo!
 }
 
-# TransactionListEdge is a single edge in a sequential list of ERC721 transactions.
-type ERC721TransactionListEdge {
+# TransactionListEdge is a single edge in a sequential list of transactions.
+type TransactionListEdge {
     cursor: Cursor!
-    trx: ERC721Transaction!
+    transaction: Transaction!
 }
 
-# Contract defines block-chain smart contract information container
-type Contract {
-    "Address represents the contract address."
-    address: Address!
-
-    "DeployedBy represents the smart contract deployment transaction reference."
-    deployedBy: Transaction!
-
-    "transactionHash represents the smart contract deployment transaction hash."
-    transactionHash: Bytes32!
 
-    "Smart contract name. Empty if not available."
-    name: String!
+# WithdrawRequest represents a request for partial stake withdraw.
+type WithdrawRequest {
+    # Cursor is the internal cursor ID of the withdraw request.
+    id: Cursor!
 
-    "Smart contract version identifier. Empty if not available."
-    version: String!
+    # Address of the authorized request.
+    address: Address!
 
-    """
-    License specifies an open source license the contract was published with.
-    Empty if not specified.
-    """
-    license: String!
+    # Account of the authorized request.
+    account: Account!
 
-    "Smart contract author contact. Empty if not available."
-    supportContact: String!
+    # StakerID represents the identifier of the validator
+    # the withdraw request points to.
+    stakerID: BigInt!
 
-    "Smart contract compiler identifier. Empty if not available."
-    compiler: String!
+    # Details of the staker involved in the withdraw request.
+    staker: Staker!
 
-    "Smart contract source code. Empty if not available."
-    sourceCode: String!
+    # Unique withdraw request identifier.
+    withdrawRequestID: BigInt!
 
-    "Smart contract ABI definition. Empty if not available."
-    abi: String!
+    # Amount of tokens to be withdrawn in WEI.
+    amount: BigInt!
 
-    """
-    Validated is the unix timestamp at which the source code was validated
-    against the deployed byte code. Null if not validated yet.
-    """
-    validated: Long
+    # CreatedTime represents the time stamp of the request creation.
+    createdTime: Long!
 
-    "Timestamp is the unix timestamp at which this smart contract was deployed."
-    timestamp: Long!
+    # WithdrawTime represents the time stamp of the request finalization.
+    # If the request is pending, the withdrawTime will be NULL.
+    withdrawTime: Long
 }
 
-# ContractValidationInput represents a set of data sent from client
-# to validate deployed contract with the provided source code.
-input ContractValidationInput {
-    "Address of the contract being validated."
-    address: Address!
-
-    "Optional smart contract name. Maximum allowed length is 64 characters."
-    name: String
-
-    "Optional smart contract version identifier. Maximum allowed length is 14 characters."
-    version: String
-
-    "Optional smart contract author contact. Maximum allowed length is 64 characters."
-    supportContact: String
-
-    """
-    License specifies an open source license the contract was published with.
-    Empty if not specified.
-    """
-    license: String
-
-    "Optimized specifies if the compiler was set to optimize the byte code."
-    optimized: Boolean = true
-
-    """
-    OptimizeRuns specifies number of optimization runs the compiler was set
-    to execute during the byte code optimizing.
-    """
-    optimizeRuns: Int = 200
-
-    "Smart contract source code."
-    sourceCode: String!
-}
-
-# ContractList is a list of smart contract edges provided by sequential access request.
-type ContractList {
-    # Edges contains provided edges of the sequential list.
-    edges: [ContractListEdge!]!
-
-    # TotalCount is the maximum number of contracts available for sequential access.
-    totalCount: BigInt!
-
-    # PageInfo is an information about the current page of contract edges.
-    pageInfo: ListPageInfo!
-}
-
-# TransactionListEdge is a single edge in a sequential list of transactions.
-type ContractListEdge {
-    cursor: Cursor!
-    contract: Contract!
-}
-
-# ERC20TransactionList is a list of ERC20 transaction edges provided by sequential access request.
-type ERC20TransactionList {
-    # Edges contains provided edges of the sequential list.
-    edges: [ERC20TransactionListEdge!]!
-
-    # TotalCount is the maximum number of ERC20 transactions available for sequential access.
-    totalCount: BigInt!
-
-    # PageInfo is an information about the current page of ERC20 transaction edges.
-    pageInfo: ListPageInfo!
-}
-
-# TransactionListEdge is a single edge in a sequential list of ERC20 transactions.
-type ERC20TransactionListEdge {
-    cursor: Cursor!
-    trx: ERC20Transaction!
-}
-
-# Bytes32 is a 32 byte binary string, represented by 0x prefixed hexadecimal hash.
-scalar Bytes32
-
-# Address is a 20 byte Opera address, represented as 0x prefixed hexadecimal number.
-scalar Address
-
-# BigInt is a large integer value. Input is accepted as either a JSON number,
-# or a hexadecimal string alternatively prefixed with 0x. Output is 0x prefixed hexadecimal.
-scalar BigInt
-
-# Long is a 64 bit unsigned integer value.
-scalar Long
-
-# Bytes is an arbitrary length binary string, represented as 0x-prefixed hexadecimal.
-# An empty byte string is represented as '0x'.
-scalar Bytes
-
-# Cursor is a string representing position in a sequential list of edges.
-scalar Cursor
-
-# Time represents date and time including time zone information in RFC3339 format.
-scalar Time
-
-# CurrentState represents the current active state
-# of the chain information condensed on one place.
-type CurrentState {
-    # epoch is the last sealed Epoch structure
-    sealedEpoch: Epoch!
+# CurrentState represents the current active state
+# of the chain information condensed on one place.
+type CurrentState {
+    # epoch is the last sealed Epoch structure
+    sealedEpoch: Epoch!
 
     # blocks represents number of blocks in the chain.
     blocks: BigInt!
@@ -970,125 +756,940 @@ type CurrentState {
 
     # sfcLockingEnabled indicates if the SFC locking feature is enabled.
     sfcLockingEnabled: Boolean!
-}
-# UniswapActionList is a list of uniswap action edges provided by sequential access request.
-type UniswapActionList {
-    # Edges contains provided edges of the sequential list.
-    edges: [UniswapActionListEdge!]!
-
-    # TotalCount is the maximum number of uniswap actions available for sequential access.
-    totalCount: BigInt!
-
-    # PageInfo is an information about the current page of uniswap action edges.
-    pageInfo: ListPageInfo!
-}
 
-# UniswapActionListEdge is a single edge in a sequential list of uniswap actions.
-type UniswapActionListEdge {
-    cursor: Cursor!
-    uniswapAction: UniswapAction!
+    # services represents the state of the blockchain data processing services
+    # of the API server. A service not making progress signals stale data.
+    services: [ServiceState!]!
 }
 
-# UniswapAction represents a Uniswap action - swap, mint, burn
-type UniswapAction {
-
-    # id of the action in the persistent db
-    id: Bytes32!
+# ServiceState represents the state of a blockchain data processing service.
+type ServiceState {
+    # name represents the name of the service.
+    name: String!
 
-    # UniswapPair represents the information about single
-    # Uniswap pair managed by the Uniswap Core.
-    uniswapPair: UniswapPair!
+    # state represents the state of the service,
+    # one of "stopped", "running", "idle", "failed", or "restarting".
+    state: String!
 
-    # pairAddress is address of the action's uniswap pair
-    pairAddress: Address!
+    # lastError represents the last error reported by the service, if any.
+    lastError: String
 
-    # transactionHash represents the hash for this acstion transaction
-    transactionHash: Bytes32!
+    # lastProgress represents the time of the last progress reported by the service
+    # in Unix Epoch units, e.g. number of seconds from the Unix Epoch start.
+    lastProgress: Long!
 
-    # sender is address of action owner account
-    sender: Address!
+    # since represents the time the service has been started
+    # in Unix Epoch units, e.g. number of seconds from the Unix Epoch start.
+    since: Long!
 
-    # type represents action type:
-    # 0 - swap
-    # 1 - mint
-    # 2 - burn
-    type: Int!
+    # restarts represents the number of restarts of the service.
+    restarts: Int!
+}
+# NetworkNodeGroupLevel represents the detail of network node count aggregation.
+enum NetworkNodeGroupLevel {
+    CONTINENT
+    COUNTRY
+    STATE
+}
 
-    # blockNr is number of the block for this action
-    blockNr: Long!
+# NetworkNodeGroup represents an aggregated group of Opera network nodes.
+type NetworkNodeGroup {
+    # topRegion represents the name of the top level location of the aggregation group.
+    topRegion: String!
 
-    # Time represents UTC ISO time tag for this reserve value
-    time: Long!
+    # region represents the name of the location of the aggregation group
+    # based on selected detail level.
+    region: String!
 
-    # amount0in is amount of incoming tokens for Token0 in this action
-    amount0in: BigInt!
+    # count represents the number of nodes in the aggregation group.
+    count: Int!
 
-    # amount0out is amount of outgoing tokens for Token0 in this action
-    amount0out: BigInt!
+    # latitude represents an average geographic coordinate
+    # that specifies the north–south position of the group on the Earth's surface.
+    latitude: Float!
 
-    # amount1in is amount of In tokens for Token1 in this action
-    amount1in: BigInt!
+    # longitude represents an average geographic coordinate
+    # that specifies the east–west position of the group on the Earth's surface.
+    longitude: Float!
 
-    # amount1out is amount of outgoing tokens for Token1 in this action
-    amount1out: BigInt!
+    # pct represents the percentage share of the aggregation group
+    # compared to the number of all known active nodes. The number is provided
+    # as fixed point integer with 1 decimal precision (i.e. 258 = 25.8%, 1000 = 100%)
+    pct: Int!
 }
 
-# Represents staker information.
-type Staker {
-    # ID number the staker.
-    id: BigInt!
-
-    # Staker address.
-    stakerAddress: Address!
+# NetworkNodeGroupList represents a list of network node groups with a specified detail level.
+type NetworkNodeGroupList {
+    # level represents the level of detail of the aggregation group list.
+    level: NetworkNodeGroupLevel!
 
-    # Amount of total staked tokens in WEI.
-    totalStake: BigInt
+    # totalCount represents the total number of nodes in the list.
+    totalCount: Int!
 
-    # Amount of own staked tokens in WEI.
-    stake: BigInt!
+    # groups represents an array of groups in the list.
+    groups: [NetworkNodeGroup!]!
+}
 
-    # Amount of tokens delegated to the staker in WEI.
-    delegatedMe: BigInt!
+# DelegationList is a list of delegations edges provided by sequential access request.
+type DelegationList {
+    "Edges contains provided edges of the sequential list."
+    edges: [DelegationListEdge!]!
 
-    # Maximum total amount of tokens allowed to be delegated
-    # to the staker in WEI.
-    # This value depends on the amount of self staked tokens.
-    totalDelegatedLimit: BigInt!
+    """
+    TotalCount is the maximum number of delegations
+    available for sequential access.
+    """
+    totalCount: Long!
 
-    # Maximum amount of tokens allowed to be delegated to the staker
-    # on a new delegation in WEI.
-    # This value depends on the amount of self staked tokens.
-    delegatedLimit: BigInt!
+    "PageInfo is an information about the current page of delegation edges."
+    pageInfo: ListPageInfo!
+}
 
-    # Is the staker active.
-    isActive: Boolean!
+# DelegationListEdge is a single edge in a sequential list of delegations.
+type DelegationListEdge {
+    "Cursor defines a scroll key to this edge."
+    cursor: Cursor!
 
-    # Is TRUE for validators withdrawing their validation stake.
-    isWithdrawn: Boolean!
+    "Delegator represents the delegator provided by this list edge."
+    delegation: Delegation!
+}
 
-    # Is the staker considered to be cheater.
-    isCheater: Boolean!
+# ERC721Contract represents a generic ERC721 non-fungible tokens (NFT) contract.
+type ERC721Contract {
+    # address of the token is used as the token's unique identifier.
+    address: Address!
 
-    # Is the staker offline.
-    isOffline: Boolean!
+    # name of the token.
+    name: String!
 
-    # isStakeLocked signals if the staker locked the stake.
-    isStakeLocked: Boolean!
+    # symbol used as an abbreviation for the token.
+    symbol: String!
 
-    # Epoch in which the staker was created.
-    createdEpoch: Long!
+    # totalSupply represents total amount of tokens across all accounts
+    totalSupply: BigInt
 
-    # Timestamp of the staker creation.
-    createdTime: Long!
+    # balanceOf represents amount of tokens on the account.
+    balanceOf(owner: Address!): BigInt!
 
-    # lockedFromEpoch is the identifier of the epoch the stake lock was created.
-    lockedFromEpoch: Long!
+    # tokenURI provides URI of Metadata JSON Schema of the token.
+    tokenURI(tokenId: BigInt!): String
 
-    # lockedUntil is the timestamp up to which the stake is locked, zero if not locked.
-    lockedUntil: Long!
+    # ownerOf provides the owner of NFT identified by tokenId
+    ownerOf(tokenId: BigInt!): Address
 
-    # Epoch in which the staker was deactivated.
-    deactivatedEpoch: Long!
+    # getApproved provides the operator approved by owner
+    getApproved(tokenId: BigInt!): Address
+
+    # isApprovedForAll queries the approval status of an operator for a given owner.
+    isApprovedForAll(owner: Address!, operator: Address!): Boolean
+}
+
+# FMintUserToken represents a pair of fMint protocol user
+# and a token used by the user for a specific operation
+# as reported by fMint users listings.
+type FMintUserToken {
+    # purpose represents the type of usage of the token by the user.
+    purpose: FMintUserTokenPurpose!
+
+    # userAddress represents the address of the user account.
+    userAddress: Address!
+
+    # account represents the full record of the fMint account
+    account: FMintAccount!
+
+    # tokenAddress represents the address of the associated token.
+    tokenAddress: Address!
+
+    # token represents the detail of the token associated.
+    token: ERC20Token!
+}
+
+# FMintUserTokenPurpose represents the purpose of the fMint user token pair.
+enum FMintUserTokenPurpose {
+    FMINT_COLLATERAL
+    FMINT_DEBT
+}
+# FeeFlowDaily represents daily aggregated flow of the transaction fee distribution in native FTM.
+type FeeFlowDaily {
+    # date is the signature date of the data point, the time part is set to 00:00:00Z.
+    date: Time!
+
+    # blocksCount represents the number of blocks included in the data point.
+    blocksCount: Int!
+
+    # fee is the amount of FTM collected from transaction fees;
+    # represented as fixed point decimal of FTM with 9 digits.
+    fee: Long!
+
+    # feeFTM is the amount of FTM collected from transaction fees;
+    # represented as floating point value in FTM units.
+    feeFTM: Float!
+
+    # burned is the amount of FTM burned;
+    # represented as fixed point decimal with 9 digits.
+    burned: Long!
+
+    # burnedFTM is the amount of FTM burned;
+    # represented as floating point value in FTM units.
+    burnedFTM: Float!
+
+    # treasury is the amount of FTM sent to treasury;
+    # represented as fixed point decimal with 9 digits.
+    treasury: Long!
+
+    # treasuryFTM is the amount of FTM sent to treasury;
+    # represented as floating point value in FTM units.
+    treasuryFTM: Float!
+
+    # rewards is the amount of FTM sent to rewards distribution;
+    # represented as fixed point decimal with 9 digits.
+    # -----------------------------------------------------------------------------------------------------------
+    # Please note this is the max amount of rewards available for distribution. The actual amount is scaled
+    # down based on locking period of individual stakers and the real amount distributed by the SFC contract
+    # will be lower in most cases. The remaining reward tokens after the scaling are effectively also burned
+    # and removed from the total supply, but this process is not reflected in this aggregated approximation.
+    # Please see the current Fantom SFC contract implementation for the rewards distribution details.
+    rewards: Long!
+
+    # rewardsFTM is the amount of FTM sent to rewards distribution;
+    # represented as floating point value in FTM units.
+    rewardsFTM: Float!
+}
+# ListSort represents the order of a chronological list.
+enum ListSort {
+    # NEWEST starts the list with the most recent items; this is the default order.
+    NEWEST
+
+    # OLDEST starts the list with the earliest items.
+    OLDEST
+}
+
+# AmountListSort represents the order of a list of items carrying an amount.
+# Items of the same amount follow the direction of the amount; the largest amounts
+# start with the most recent items and the smallest amounts with the earliest items.
+enum AmountListSort {
+    # NEWEST starts the list with the most recent items; this is the default order.
+    NEWEST
+
+    # OLDEST starts the list with the earliest items.
+    OLDEST
+
+    # LARGEST starts the list with the items of the largest amount.
+    LARGEST
+
+    # SMALLEST starts the list with the items of the smallest amount.
+    SMALLEST
+}
+
+# GasPriceTick represents a collected gas price tick.
+type GasPriceTick {
+    # fromTime is the time of the tick measurement start
+    fromTime: Time!
+
+    # toTime is the time of the tick measurement end
+    toTime: Time!
+
+    # openPrice is the opening gas price in the tick
+    openPrice: Long!
+
+    # closePrice is the closing gas price in the tick
+    closePrice: Long!
+
+    # minPrice is the lowest reached price in the tick
+    minPrice: Long!
+
+    # maxPrice is the highest reached price in the tick
+    maxPrice: Long!
+
+    # avgPrice is the average reached price in the tick
+    avgPrice: Long!
+}
+
+# TokenTransaction represents a generic token transaction
+# of a supported type of token.
+type TokenTransaction {
+    # Hash is the hash of the executed transaction call.
+    hash: Bytes32!
+
+    # trxIndex is the index of the transaction call in a block.
+    trxIndex: Long!
+
+    # blockNumber represents the number of the block
+    # the transaction was executed in.
+    blockNumber: Long!
+
+    # tokenAddress represents the address of the token involved.
+    tokenAddress: Address!
+
+    # tokenName represents the name of the token contract.
+    # Is empty, if not provided for the given token.
+    tokenName: String!
+
+    # tokenSymbol represents the symbol of the token contract.
+    # Is empty, if not provided for the given token.
+    tokenSymbol: String!
+
+    # tokenType represents the type of the token (i.e. ERC20/ERC721/ERC1155).
+    tokenType: String!
+
+    # tokenDecimals is the number of decimals the token supports.
+    # The most common value is 18 to mimic the ETH to WEI relationship.
+    tokenDecimals: Int!
+
+    # type represents the type of the transaction executed (i.e. Transfer/Mint/Approval).
+    type: String!
+
+    # sender of the transaction.
+    sender: Address!
+
+    # recipient of the transaction.
+    recipient: Address!
+
+    # amount of tokens involved in the transaction.
+    amount: BigInt!
+
+    # multi-token contracts (ERC-721/ERC-1155) token ID involved in the transaction.
+    tokenId: BigInt!
+
+    # time stamp of the block processing.
+    timeStamp: Long!
+}
+
+# Price represents price information of core Opera token
+type Price {
+    "Source unit symbol."
+    fromSymbol: String!
+
+    "Target unit symbol."
+    toSymbol: String!
+
+    "Price of the source symbol unit in target symbol unit."
+    price: Float!
+
+    "Price change in last 24h."
+    change24: Float!
+
+    "Price change in percent in last 24h."
+    changePct24: Float!
+
+    "Open 24h price."
+    open24: Float!
+
+    "Highest 24h price."
+    high24: Float!
+
+    "Lowest 24h price."
+    low24: Float!
+
+    "Volume exchanged in last 24h price."
+    volume24: Float!
+
+    "Market cap of the source unit."
+    marketCap: Float!
+
+    "Timestamp of the last update of this price value."
+    lastUpdate: Long!
+}
+
+# FtmBlockBurn represents a native FTM tokens burn record per created block.
+type FtmBlockBurn {
+    # blockNumber represents the number of the block.
+    blockNumber: Long!
+
+    # Timestamp is the unix timestamp at which this block was created.
+    timestamp: Long!
+
+    # amount represents the amount of FTM tokens burned in WEI units (18 digits fixed INT encoded as HEX number).
+    amount: BigInt!
+
+    # ftmValue represents FTM value of the burned FTM tokens.
+    ftmValue: Float!
+}
+# EstimatedRewards represents a calculated rewards estimation for an account or amount staked
+type EstimatedRewards {
+    # Amount of FTM tokens expected to be staked for the calculation.
+    staked: Long!
+
+    # dailyReward represents amount of FTM tokens estimated
+    # to be rewarded for staked amount in average per day.
+    dailyReward: BigInt!
+
+    # weeklyReward represents amount of FTM tokens estimated
+    # to be rewarded for staked amount in average per week.
+    weeklyReward: BigInt!
+
+    # monthlyReward represents amount of FTM tokens estimated
+    # to be rewarded for staked amount in average per month.
+    monthlyReward: BigInt!
+
+    # yearlyReward represents amount of FTM tokens estimated
+    # to be rewarded for staked amount in average per year.
+    yearlyReward: BigInt!
+
+    # currentRewardYearRate represents average reward rate
+    # for any staked amount in average per year.
+    # The value is calculated as linear gross proceeds for staked amount
+    # of tokens yearly.
+    currentRewardRateYearly: Int!
+
+    # Total amount of staked FTM tokens used for the calculation in WEI units.
+    # The estimation uses total staked amount, not the effective amount provided
+    # by the last epoch. The effective amount does not include current
+    # un-delegations and also skips offline self-staking and flagged staking.
+    totalStaked: BigInt!
+
+    # Information about the last sealed epoch of the Opera blockchain.
+    # The epoch provides useful information about total FTM supply,
+    # total amount staked, rewards rate and weight, fee, etc.
+    lastEpoch: Epoch!
+}
+
+# DailyTrxVolume represents a view of an aggregated flow
+# of transactions on the network on specific day.
+type DailyTrxVolume {
+    # day represents the day of the aggregation in format YYYY-MM-DD
+    # i.e. 2021-01-23 for January 23rd, 2021
+    day: String!
+
+    # volume represent the number of transactions originated / mined
+    # by the network on the day.
+    volume: Int!
+
+    # amount represents the total value of native tokens transferred
+    # by the network on the day. Please note this includes only direct
+    # token transfers.
+    amount: BigInt!
+
+    # gas represents the total amount of gas consumed by transactions
+    # on the network on the day.
+    gas: BigInt!
+}
+
+# GovernanceContract represents basic information
+# about a Governance contract deployed on the block chain.
+type GovernanceContract {
+    # name represents the name of the contract
+    name: String!
+
+    # address represents the address of the Governance contract
+    address: Address!
+
+    # totalProposals represents the total number of proposals
+    # managed by the Governance contract.
+    totalProposals: BigInt!
+
+    # proposals represents list of proposals on the contract.
+    proposals(cursor:Cursor, count:Int!, activeOnly: Boolean = false):GovernanceProposalList!
+
+    # proposal provides specific Governance Proposal detail identified
+    # by its ID inside the Governance contract.
+    proposal(id: BigInt!):GovernanceProposal
+
+    # delegationsBy represents list of delegations for the given address.
+    # If the address does not delegate, the list is empty.
+    # Delegations are handled by the governed contract, so this list may
+    # be always empty for certain Governance instances. If the list is empty
+    # the source address may still be eligible for voting by itself.
+    delegationsBy(from: Address!): [Address!]!
+
+    # canVote checks if the given address can submit votes to Proposals
+    # of this Governance conract. The ability to vote is bound
+    # to the governed contract logic and can be unavailable
+    # to some network participants on certain situation.
+    canVote(from: Address!): Boolean!
+
+    # proposalFee represents the fee required by the Governance
+    # to accept proposals. The fee is never refunded,
+    # even if a Proposal is canceled.
+    proposalFee: BigInt!
+
+    # totalVotingPower represents the total voting power available
+    # on the Governance contract in the form of votes
+    # weight.
+    totalVotingPower: BigInt!
+}
+
+# GovernanceProposalList is a list of governance proposal edges
+# provided by sequential access request.
+type GovernanceProposalList {
+    # Edges contains provided edges of the sequential list.
+    edges: [GovernanceProposalListEdge!]!
+
+    # TotalCount is the maximum number of governance proposals
+    # available for sequential access.
+    totalCount: BigInt!
+
+    # PageInfo is an information about the current page of governance
+    # proposal edges.
+    pageInfo: ListPageInfo!
+}
+
+# TransactionListEdge is a single edge in a sequential list
+# of governance proposals.
+type GovernanceProposalListEdge {
+    cursor: Cursor!
+    proposal: GovernanceProposal!
+}
+
+# GovernanceProposal represents the details of a single proposal
+# in the governance contract.
+type GovernanceProposal {
+    # governanceId represents the identifier of the Governance
+    # contract this Proposal belongs to.
+    governanceId: Address!
+
+    # governance represents the Governance contract reference.
+    # Please make sure not to engage in a circular reference too deep.
+    governance: GovernanceContract!
+
+    # id identifier of the proposal in the governance contract
+    # the proposal is managed by.
+    id: BigInt!
+
+    # name represents a name of the Proposal.
+    name: String!
+
+    # description represents a textual description of the Proposal.
+    description: String!
+
+    # state represents the state of the Proposal.
+    state: ProposalState!
+
+    # contract represents the contract of the Proposal. Each Proposal
+    # is represented by a contract responsible for maintaining the Proposal
+    # parameters, options and finalization actions.
+    contract: Address!
+
+    # owner represents the owning wallet of the proposal
+    owner: Address!
+
+    # proposalType represents the type of the Proposal that corresponds
+    # with the Proposal Template.
+    proposalType: Long!
+
+    # isExecutable identifies if the proposal will be finalized
+    # by executing a finalizing code.
+    isExecutable: Boolean!
+
+    # minVotes corresponds with the minimal weight of votes
+    # required by the Proposal to be settled in any way
+    # other than REJECTED.
+    minVotes: BigInt!
+
+    # minAgreement represents the minimal agreement weight
+    # required to be reached on any of the Proposal options
+    # so the Proposal could be settled in any way
+    # other than REJECTED.
+    minAgreement: BigInt!
+
+    # totalWeight represents the total voting weight
+    # of all voters allowed on the proposal. This is effectively
+    # the maximum weight an option can gain if all the voters
+    # would favor it with the top value of the scale.
+    totalWeight: BigInt!
+
+    # votedWeightRatio represents the percentage of the total voting weight
+    # already counted towards the proposal options. The ratio increases
+    # as more voters place their votes.
+    # The value is normalised to 1 digit precision, to get a percentage
+    # you need to divide the value by 10.
+    # The value is zero if no vote was placed. The value is 1000
+    # if all the voters placed their votes either directly,
+    # or through a vote delegation mechanism.
+    # Please note the value is an estimation. The voting status
+    # does not closely reflect changes in the total voting power,
+    # especially after the voting is closed.
+    votedWeightRatio: Int!
+
+    # opinionScales is the scale of opinions on available options.
+    # A voter provides a single opinion picked from the scale
+    # for each option during the voting for a proposal.
+    # I.e.: Scales {0, 2, 3, 4, 5} represent opinions of
+    # {strongly disagree, disagree, neutral, agree and strongly agree}.
+    opinionScales: [Long!]!
+
+    # options is a list of options available on the Proposal.
+    # A voter must provide their opinion expressed by a chosen scale
+    # for each option on the list. It's generally better to scatter
+    # opinions across options instead of having a binary view.
+    options: [String!]!
+
+    # votingStarts is the time stamp of the voting getting opened
+    # to receive votes.
+    votingStarts: Long!
+
+    # votingMayEnd is the time stamp when the voting could be closed
+    # if enough votes are collected to settle the Proposal (winner option is selectable).
+    votingMayEnd: Long!
+
+    # votingMustEnd is the time stamp when the voting must be closed.
+    # If enough votes to settle the Proposal were not collected up until this time
+    # the Proposal is rejected and will not be settled in any way (no winner option is selectable).
+    votingMustEnd: Long!
+
+    # optionStates is the list of states of all the options in the Proposal.
+    # Warning: This is an expensive call, use with caution.
+    optionStates: [OptionState!]!
+
+    # optionState represents a state of the selected option of the Proposal.
+    optionState(optionId:BigInt!):OptionState
+
+    # vote pulls the vote for the given <from> address linked with the <delegatedTo> delegation
+    # recipient. If the <from> address is not delegator in the context of the governance
+    # subject contract, the <delegatedTo> may be left empty, or set to the same address
+    # as the <from> address.
+    vote(from: Address!, delegatedTo: Address): GovernanceVote
+}
+
+# ProposalState represents the state of the whole proposal.
+type ProposalState {
+    # isResolved signals if the Proposal is already resolved.
+    isResolved: Boolean!
+
+    # winnerId is the identifier of the winning option.
+    winnerId: BigInt
+
+    # votes is the number of votes received on the Proposal.
+    votes: BigInt!
+
+    # status represents the status of the Proposal.
+    # 0 = Initial, 1 = Resolved, 2 = Failed, 4 = Canceled, 8 = Execution Expired
+    status: BigInt!
+}
+
+# OptionState represents a state in options of a Proposal.
+type OptionState {
+    # optionId is the identifier of the option,
+    # effectively option index in the options array
+    optionId: BigInt!
+
+    # votes is the weight of all votes received across all votes;
+    # the projection of the votes to this state uses it to calculate
+    # actual agreement.
+    votes: BigInt!
+
+    # agreement represents the rated weight of all the votes towards this option
+    # based on the opinion scale of the proposal and selected opinion scale level of
+    # each vote.
+    # this effectively reflects the absolute weight of affection of all voters
+    # towards this option.
+    agreement: BigInt!
+
+    # agreementRatio represents the relative ratio of the option agreement
+    # to the total weight of all votes in 18 digits.
+    agreementRatio: BigInt!
+}
+
+# GovernanceVote is the vote in the context of the given Governance Proposal.
+type GovernanceVote {
+    # governanceId is the identifier of the Governance contract.
+    governanceId: Address!
+
+    # proposalId is the identifier of the proposal of the contract.
+    proposalId: BigInt!
+
+    # from is the address of the voting party
+    from: Address!
+
+    # delegatedTo is the address of the delegation the vote refers to.
+    delegatedTo: Address
+
+    # weight represents the weight of the vote
+    weight: BigInt!
+
+    # choices represents the list of opinions on the Proposal options the vote
+    # presented.
+    choices: [Long!]!
+}
+# ERC1155Contract represents a generic ERC1155 multi-token contract.
+type ERC1155Contract {
+    # address of the token is used as the token's unique identifier.
+    address: Address!
+
+    # uri provides URI of Metadata JSON Schema for given token.
+    uri(tokenId: BigInt!): String
+
+    # balanceOf represents amount of tokens on the account.
+    balanceOf(owner: Address!, tokenId: BigInt!): BigInt!
+
+    # balanceOf represents amount of tokens on the account.
+    balanceOfBatch(owners: [Address!]!, tokenIds: [BigInt!]!): [BigInt!]!
+
+    # isApprovedForAll queries the approval status of an operator for a given owner.
+    isApprovedForAll(owner: Address!, operator: Address!): Boolean
+}
+
+# StakerInfo represents extended staker information from smart contract.
+type StakerInfo {
+    "Name represents the name of the staker."
+    name: String
+
+    "LogoUrl represents staker logo URL."
+    logoUrl: String
+
+    "Website represents a link to stakers website."
+    website: String
+
+    "Contact represents a link to contact to the staker."
+    contact: String
+}
+# UniswapPair represents the information about single
+# Uniswap pair managed by the Uniswap Core.
+type UniswapPair {
+    # pairAddress represents the Address of the Pair
+    # and also the address of the ERC20 token
+    # managing the share of each liquidity participant.
+    pairAddress: Address!
+
+    # tokens represent the list of tokens in the pair.
+    # The array always contain two tokens, their order
+    # is irrelevant from the Uniswap perspective, but
+    # we always return them in the same order.
+    tokens: [ERC20Token!]!
+
+    # reserves of the tokens of the pair.
+    # The reserve index inside the array corresponds
+    # with the token position.
+    reserves: [BigInt!]!
+
+    # The timestamp of the block
+    # in which this reserves state was reached.
+    reservesTimeStamp: Long!
+
+    # cumulative prices of the tokens of the pair.
+    # The price index inside the array corresponds
+    # with the token position.
+    cumulativePrices: [BigInt!]!
+
+    # lastKValue represents the last coefficient
+    # of reserves multiplied. It's the value Uniswap protocol
+    # uses to control reserves growth on both sides of the pool.
+    lastKValue: BigInt!
+
+    # totalSupply represents the total amount
+    # of the pair tokens in circulation and represents
+    # the total share pool of all the participants.
+    totalSupply: BigInt!
+
+    # share represents the share of the given user/participant on the pair.
+    # To get the share percentage, divide this value by the total supply
+    # of the pair.
+    shareOf(user: Address!): BigInt!
+}
+
+
+# DefiUniswapVolume represents a calculated volume for swap pairs in history
+type DefiUniswapVolume {
+
+    # UniswapPair represents the information about single
+    # Uniswap pair managed by the Uniswap Core.
+    uniswapPair: UniswapPair!
+
+    # pairAddress represents the Address of the Pair
+    pairAddress: Address!
+
+    # dailyVolume returns swap volume for last 24 hours
+    dailyVolume: BigInt!
+
+    # weeklyVolume returns swap volume for last 7 days
+    weeklyVolume: BigInt!
+
+    # monthlyVolume returns swap volume for last month
+    monthlyVolume: BigInt!
+
+    # YearlyVolume returns swap volume for last year
+    yearlyVolume: BigInt!
+
+    # IsInFUSD indicates if TokenA from the pair has a price value to be able
+    # to calculate value in fUSD
+    isInFUSD: Boolean!
+
+}
+
+# DefiSwaps represents swap volume for given pair and time interval
+type DefiTimeVolume {
+
+    # pairAddress represents the Address of the Pair
+    pairAddress: Address!
+
+    # time indicates a period for this volume
+    time: String!
+
+    # value represents amount of the volume
+    value: BigInt!
+}
+
+# DefiTimePrice represents a calculated price for swap pairs in history
+type DefiTimePrice {
+
+	# pairAddress represents the Address of the Pair
+    pairAddress: Address!
+
+    # time indicates a period for this price
+    time: String!
+
+    # opening price for this time period
+    open: Float!
+
+    # closing price for this time period
+	close: Float!
+
+    # lowest price for this time period
+	low: Float!
+
+    # highest price for this time period
+	high: Float!
+
+    # average price for this time period
+    average: Float!
+}
+
+# DefiTimeReserve represents a Uniswap pair reserve in history
+type DefiTimeReserve {
+
+    # UniswapPair represents the information about single
+    # Uniswap pair managed by the Uniswap Core.
+    uniswapPair: UniswapPair!
+
+    # Time represents UTC ISO time tag for this reserve values
+    time: String!
+
+    # ReserveClose is close reserve for this time period
+	# for both tokens. Index inside the array corresponds
+    # with the token position.
+    reserveClose: [BigInt!]!
+}
+# FMintAccount represents an informastion about account details
+# in DeFi/fMint protocol.
+type FMintAccount {
+    # address of the DeFi account.
+    address: Address!
+
+    # collateralList represents a list of all collateral tokens
+    # linked with the account.
+    collateralList: [Address!]!
+
+    # collaterals represents a list of all collateral assets.
+    collateral: [FMintTokenBalance!]!
+
+    # collateralValue represents the current collateral value
+    # in ref. denomination (fUSD).
+    collateralValue: BigInt!
+
+    # debtList represents a list of all debt tokens linked with the account.
+    debtList: [Address!]!
+
+    # debts represents the list of all the current borrowed tokens.
+    debt: [FMintTokenBalance!]!
+
+    # debtValue represents the current debt value
+    # in ref. denomination (fUSD).
+    debtValue: BigInt!
+
+    # rewardsEarned represents accumulated rewards
+    # earned on the DeFi / fMint account for the excessive
+    # collateral value. Please note that the rewards could still
+    # be burned, if the account is not eligible to claim the reward.
+    rewardsEarned: BigInt!
+
+    # rewardsStashed represents accumulated rewards
+    # earned on the DeFi / fMint account for the excessive
+    # collateral value and stored into the stash for future
+    # claim.
+    rewardsStashed: BigInt!
+
+    # canClaimRewards informs if the fMint account collateral
+    # to debt is high enough to allow earned rewards claiming.
+    canClaimRewards: Boolean!
+
+    # canReceiveRewards informs if the fMint account collateral
+    # to debt is high enough to receive earned rewards. If the ratio
+    # is below configured one, earned rewards are burned.
+    canReceiveRewards: Boolean!
+
+    # canPushNewRewards indicates if new rewards are unlocked
+    # inside the reward distribution and can be pushed into
+    # the system to distribute them among eligible accounts.
+    canPushNewRewards: Boolean!
+}
+
+# FMintTokenBalance represents a balance of a specific DeFi token
+# on an fMint protocol account.
+# The balance is used for both collateral deposits and minting debt.
+type FMintTokenBalance {
+    # type represents the type of the balance record.
+    type: DefiTokenBalanceType!
+
+    # tokenAddress represents unique identifier of the token.
+    tokenAddress: Address!
+
+    # token represents the detail of the token
+    token: DefiToken!
+
+    # current balance of the token on the account.
+    balance: BigInt!
+
+    # value of the current balance of the token on the account
+    # in ref. denomination (fUSD).
+    value: BigInt!
+}
+
+# Represents staker information.
+type Staker {
+    # ID number the staker.
+    id: BigInt!
+
+    # Staker address.
+    stakerAddress: Address!
+
+    # Amount of total staked tokens in WEI.
+    totalStake: BigInt
+
+    # Amount of own staked tokens in WEI.
+    stake: BigInt!
+
+    # Amount of tokens delegated to the staker in WEI.
+    delegatedMe: BigInt!
+
+    # Maximum total amount of tokens allowed to be delegated
+    # to the staker in WEI.
+    # This value depends on the amount of self staked tokens.
+    totalDelegatedLimit: BigInt!
+
+    # Maximum amount of tokens allowed to be delegated to the staker
+    # on a new delegation in WEI.
+    # This value depends on the amount of self staked tokens.
+    delegatedLimit: BigInt!
+
+    # Is the staker active.
+    isActive: Boolean!
+
+    # Is TRUE for validators withdrawing their validation stake.
+    isWithdrawn: Boolean!
+
+    # Is the staker considered to be cheater.
+    isCheater: Boolean!
+
+    # Is the staker offline.
+    isOffline: Boolean!
+
+    # isStakeLocked signals if the staker locked the stake.
+    isStakeLocked: Boolean!
+
+    # Epoch in which the staker was created.
+    createdEpoch: Long!
+
+    # Timestamp of the staker creation.
+    createdTime: Long!
+
+    # lockedFromEpoch is the identifier of the epoch the stake lock was created.
+    lockedFromEpoch: Long!
+
+    # lockedUntil is the timestamp up to which the stake is locked, zero if not locked.
+    lockedUntil: Long!
+
+    # Epoch in which the staker was deactivated.
+    deactivatedEpoch: Long!
 
     # Timestamp of the staker deactivation.
     deactivatedTime: Long!
@@ -1101,8 +1702,8 @@ type Staker {
 
     # List of delegations of this staker. Cursor is used to obtain specific slice
     # of the staker delegations. The most recent delegations
-    # are provided if cursor is omitted.
-    delegations(cursor: Cursor, count: Int = 25):DelegationList!
+    # are provided if cursor is omitted, unless a different sort is requested.
+    delegations(cursor: Cursor, count: Int = 25, sort: AmountListSort):DelegationList!
 
     # Status is a binary encoded status of the staker.
     # Ok = 0, bin 1 = Fork Detected, bin 256 = Validator Offline
@@ -1120,97 +1721,209 @@ enum StakerFlagFilter {
     IS_CHEATER
 }
 
-# ERC1155TransactionList is a list of ERC1155 transaction edges provided by sequential access request.
-type ERC1155TransactionList {
-    # Edges contains provided edges of the sequential list.
-    edges: [ERC1155TransactionListEdge!]!
+# Represents epoch information.
+type Epoch {
+    # Identifier of the epoch.
+    id: Long!
 
-    # TotalCount is the maximum number of ERC1155 transactions available for sequential access.
-    totalCount: BigInt!
+    # Timestamp of the epoch end.
+    endTime: Long!
 
-    # PageInfo is an information about the current page of ERC1155 transaction edges.
-    pageInfo: ListPageInfo!
+    # Epoch duration in seconds.
+    duration: Long!
+
+    # Fee at the epoch.
+    epochFee: BigInt!
+
+    # Total base reward weight on epoch.
+    totalBaseRewardWeight: BigInt!
+
+    # Total transaction reward weight on epoch.
+    totalTxRewardWeight: BigInt!
+
+    # Base reward per second of epoch.
+    baseRewardPerSecond: BigInt!
+
+    # Total amount staked. This includes all the staked
+    # amount including validators' self stake.
+    stakeTotalAmount: BigInt!
+
+    # Total supply amount.
+    totalSupply: BigInt!
 }
 
-# TransactionListEdge is a single edge in a sequential list of ERC1155 transactions.
-type ERC1155TransactionListEdge {
-    cursor: Cursor!
-    trx: ERC1155Transaction!
+# RewardClaim represents
+type RewardClaim {
+    # address represents the address of the delegator
+    address: Address!
+
+    # toStakerId represents the ID of the validator the delegation
+    # is placed on
+    toStakerId: BigInt!
+
+    # claimed represents the time stamp of the reward claim
+    # in Unix Epoch units, e.g. number of seconds from the Unix Epoch start.
+    claimed: Long!
+
+    # amount represents the amount of tokens rewarded on the claim.
+    amount: BigInt!
+
+    # isRestaked signals if the claim was added to the delegation
+    # effectively increasing the staked amount and raising the delegation value.
+    isRestaked: Boolean!
+
+    # trxHash is the hash pf the transaction calling for the rewards
+    # to be processed and granted.
+    trxHash: Bytes32!
 }
+# ListPageInfo contains information about a sequential access list page.
+type ListPageInfo {
+    # First is the cursor of the first edge of the edges list. null for empty list.
+    first: Cursor
 
-# FMintAccount represents an informastion about account details
-# in DeFi/fMint protocol.
-type FMintAccount {
-    # address of the DeFi account.
+    # Last if the cursor of the last edge of the edges list. null for empty list.
+    last: Cursor
+
+    # HasNext specifies if there is another edge after the last one.
+    hasNext: Boolean!
+
+    # HasNext specifies if there is another edge before the first one.
+    hasPrevious: Boolean!
+}
+# DefiToken represents a token available for DeFi operations.
+type DefiToken {
+    # address of the token is used as the token's unique identifier.
     address: Address!
 
-    # collateralList represents a list of all collateral tokens
-    # linked with the account.
-    collateralList: [Address!]!
+    # name of the token.
+    name: String!
 
-    # collaterals represents a list of all collateral assets.
-    collateral: [FMintTokenBalance!]!
+    # symbol used as an abbreviation for the token.
+    symbol: String!
+
+    # logoUrl is the URL of the token logo image.
+    logoUrl: String!
+
+    # decimals is the number of decimals the token supports.
+    # The most common value is 18 to mimic the ETH to WEI relationship.
+    decimals: Int!
+
+    # isActive signals if the token can be used
+    # in the DeFi functions at all.
+    isActive: Boolean!
+
+    # canWrapFTM signals if the token can be used
+    # to wrap native FTM tokens for DeFi trading.
+    canWrapFTM: Boolean!
+
+    # canDeposit signals if the token can be used
+    # in deposit as a collateral asset.
+    canDeposit: Boolean!
+
+    # canMint signals if the token can be used
+    # in fMint protocol as the target token.
+    canMint: Boolean!
+
+    # canBorrow signals if the token is available
+    # for FLend borrow operations.
+    canBorrow: Boolean!
+
+    # canTrade signals if the token is available
+    # for FTrade direct trading operations.
+    canTrade: Boolean!
+
+    # price represents the value of the token in ref. denomination.
+    # We use fUSD tokens as the synth reference value.
+    price: BigInt!
+
+    # priceDecimals is the number of decimals used on the price
+    # field to properly handle value calculations without loosing precision.
+    priceDecimals: Int!
+
+    # availableBalance represents the total available balance of the token
+    # on the account regardless of the DeFi usage of the token.
+    # It's effectively the amount available held by the ERC20 token
+    # on the account behalf.
+    availableBalance(owner: Address!): BigInt!
+
+    # defiAllowance represents the amount of ERC20 tokens unlocked
+    # by the owner / token holder to be accessible for DeFi operations.
+    # If an operation requires access to certain ERC20 token, the DeFi
+    # contract must be allowed to make a transfer of required amount
+    # of tokens from the owner to the DeFi Liquidity Poll.
+    # If it's not given, the operation will fail.
+    allowance(owner: Address!): BigInt!
+
+    # totalSupply represents total amount of tokens across all accounts
+    totalSupply: BigInt!
+
+    # totalDeposited represents total amount of deposited tokens collateral on fMint.
+    totalDeposit: BigInt!
+
+    # totalDebt represents total amount of borrowed/minted tokens on fMint.
+    totalDebt: BigInt!
+}
+
+# DefiTokenBalanceType represents the type of DeFi token balance record.
+enum DefiTokenBalanceType {
+    COLLATERAL
+    DEBT
+}
 
-    # collateralValue represents the current collateral value
-    # in ref. denomination (fUSD).
-    collateralValue: BigInt!
+# ContractList is a list of smart contract edges provided by sequential access request.
+type ContractList {
+    # Edges contains provided edges of the sequential list.
+    edges: [ContractListEdge!]!
 
-    # debtList represents a list of all debt tokens linked with the account.
-    debtList: [Address!]!
+    # TotalCount is the maximum number of contracts available for sequential access.
+    totalCount: BigInt!
 
-    # debts represents the list of all the current borrowed tokens.
-    debt: [FMintTokenBalance!]!
+    # PageInfo is an information about the current page of contract edges.
+    pageInfo: ListPageInfo!
+}
 
-    # debtValue represents the current debt value
-    # in ref. denomination (fUSD).
-    debtValue: BigInt!
+# TransactionListEdge is a single edge in a sequential list of transactions.
+type ContractListEdge {
+    cursor: Cursor!
+    contract: Contract!
+}
 
-    # rewardsEarned represents accumulated rewards
-    # earned on the DeFi / fMint account for the excessive
-    # collateral value. Please note that the rewards could still
-    # be burned, if the account is not eligible to claim the reward.
-    rewardsEarned: BigInt!
+# Block is an Opera block chain block.
+type Block {
+    # Number is the number of this block, starting at 0 for the genesis block.
+    number: Long!
 
-    # rewardsStashed represents accumulated rewards
-    # earned on the DeFi / fMint account for the excessive
-    # collateral value and stored into the stash for future
-    # claim.
-    rewardsStashed: BigInt!
+    # Hash is the unique block hash of this block.
+    hash: Bytes32!
 
-    # canClaimRewards informs if the fMint account collateral
-    # to debt is high enough to allow earned rewards claiming.
-    canClaimRewards: Boolean!
+    # Parent is the parent block of this block.
+    parent: Block
 
-    # canReceiveRewards informs if the fMint account collateral
-    # to debt is high enough to receive earned rewards. If the ratio
-    # is below configured one, earned rewards are burned.
-    canReceiveRewards: Boolean!
+    # TransactionCount is the number of transactions in this block.
+    transactionCount: Int
 
-    # canPushNewRewards indicates if new rewards are unlocked
-    # inside the reward distribution and can be pushed into
-    # the system to distribute them among eligible accounts.
-    canPushNewRewards: Boolean!
-}
+    # Timestamp is the unix timestamp at which this block was mined.
+    timestamp: Long!
 
-# FMintTokenBalance represents a balance of a specific DeFi token
-# on an fMint protocol account.
-# The balance is used for both collateral deposits and minting debt.
-type FMintTokenBalance {
-    # type represents the type of the balance record.
-    type: DefiTokenBalanceType!
+    # GasLimit represents the maximum gas allowed in this block.
+    gasLimit: Long!
 
-    # tokenAddress represents unique identifier of the token.
-    tokenAddress: Address!
+    # GasUsed represents the actual total used gas by all transactions in this block.
+    gasUsed: Long!
 
-    # token represents the detail of the token
-    token: DefiToken!
+    # txHashList is the list of unique hash values of transaction
+    # assigned to the block.
+    txHashList: [Bytes32!]!
 
-    # current balance of the token on the account.
-    balance: BigInt!
+    # txList is a list of transactions assigned to the block.
+    txList: [Transaction!]!
 
-    # value of the current balance of the token on the account
-    # in ref. denomination (fUSD).
-    value: BigInt!
+    # confirmations is the number of blocks observed on top of this block.
+    confirmations: Long!
+
+    # finalized signals the block has the configured number of confirmations
+    # and its data are considered final.
+    finalized: Boolean!
 }
 
 # DefiSettings represents the set of current settings and limits
@@ -1244,409 +1957,292 @@ type DefiSettings {
     priceOracleAggregate: Address!
 
     # StakeTokenizerContract is the address of the Stake Tokenizer contract.
-    StakeTokenizerContract: Address!
-
-    # StakeTokenizedERC20Token is the address of the Tokenized Stake ERC20 contract.
-    StakeTokenizedERC20Token: Address!
-
-    # fMintAddress is the address of the fMint contract.
-    fMintContract: Address!
-
-	# fMintAddressProvider represents the address of the fMint address provider.
-	fMintAddressProvider: Address!
-
-    # tokenRegistryContract is the address of the fMint token registry.
-    fMintTokenRegistry: Address!
-
-    # fMintRewardDistribution is the address of the DeFi fMint
-    # reward distribution contract.
-    fMintRewardDistribution: Address!
-
-    # fMintCollateralPool is the address of the fMint collateral pool.
-    fMintCollateralPool: Address!
-
-    # fMintDebtPool is the address of the fMint debt pool.
-    fMintDebtPool: Address!
-
-    # uniswapCoreFactory is the address of the Uniswap Core Factory contract.
-    uniswapCoreFactory: Address!
-
-    # uniswapRouter is the address of the Uniswap Router contract.
-    uniswapRouter: Address!
-}
-
-# FtmBlockBurn represents a native FTM tokens burn record per created block.
-type FtmBlockBurn {
-    # blockNumber represents the number of the block.
-    blockNumber: Long!
-
-    # Timestamp is the unix timestamp at which this block was created.
-    timestamp: Long!
-
-    # amount represents the amount of FTM tokens burned in WEI units (18 digits fixed INT encoded as HEX number).
-    amount: BigInt!
-
-    # ftmValue represents FTM value of the burned FTM tokens.
-    ftmValue: Float!
-}
-# EstimatedRewards represents a calculated rewards estimation for an account or amount staked
-type EstimatedRewards {
-    # Amount of FTM tokens expected to be staked for the calculation.
-    staked: Long!
-
-    # dailyReward represents amount of FTM tokens estimated
-    # to be rewarded for staked amount in average per day.
-    dailyReward: BigInt!
-
-    # weeklyReward represents amount of FTM tokens estimated
-    # to be rewarded for staked amount in average per week.
-    weeklyReward: BigInt!
-
-    # monthlyReward represents amount of FTM tokens estimated
-    # to be rewarded for staked amount in average per month.
-    monthlyReward: BigInt!
-
-    # yearlyReward represents amount of FTM tokens estimated
-    # to be rewarded for staked amount in average per year.
-    yearlyReward: BigInt!
-
-    # currentRewardYearRate represents average reward rate
-    # for any staked amount in average per year.
-    # The value is calculated as linear gross proceeds for staked amount
-    # of tokens yearly.
-    currentRewardRateYearly: Int!
-
-    # Total amount of staked FTM tokens used for the calculation in WEI units.
-    # The estimation uses total staked amount, not the effective amount provided
-    # by the last epoch. The effective amount does not include current
-    # un-delegations and also skips offline self-staking and flagged staking.
-    totalStaked: BigInt!
-
-    # Information about the last sealed epoch of the Opera blockchain.
-    # The epoch provides useful information about total FTM supply,
-    # total amount staked, rewards rate and weight, fee, etc.
-    lastEpoch: Epoch!
-}
-
-# WithdrawRequest represents a request for partial stake withdraw.
-type WithdrawRequest {
-    # Cursor is the internal cursor ID of the withdraw request.
-    id: Cursor!
-
-    # Address of the authorized request.
-    address: Address!
-
-    # Account of the authorized request.
-    account: Account!
-
-    # StakerID represents the identifier of the validator
-    # the withdraw request points to.
-    stakerID: BigInt!
-
-    # Details of the staker involved in the withdraw request.
-    staker: Staker!
-
-    # Unique withdraw request identifier.
-    withdrawRequestID: BigInt!
-
-    # Amount of tokens to be withdrawn in WEI.
-    amount: BigInt!
-
-    # CreatedTime represents the time stamp of the request creation.
-    createdTime: Long!
-
-    # WithdrawTime represents the time stamp of the request finalization.
-    # If the request is pending, the withdrawTime will be NULL.
-    withdrawTime: Long
-}
-
-# UniswapPair represents the information about single
-# Uniswap pair managed by the Uniswap Core.
-type UniswapPair {
-    # pairAddress represents the Address of the Pair
-    # and also the address of the ERC20 token
-    # managing the share of each liquidity participant.
-    pairAddress: Address!
-
-    # tokens represent the list of tokens in the pair.
-    # The array always contain two tokens, their order
-    # is irrelevant from the Uniswap perspective, but
-    # we always return them in the same order.
-    tokens: [ERC20Token!]!
-
-    # reserves of the tokens of the pair.
-    # The reserve index inside the array corresponds
-    # with the token position.
-    reserves: [BigInt!]!
-
-    # The timestamp of the block
-    # in which this reserves state was reached.
-    reservesTimeStamp: Long!
-
-    # cumulative prices of the tokens of the pair.
-    # The price index inside the array corresponds
-    # with the token position.
-    cumulativePrices: [BigInt!]!
-
-    # lastKValue represents the last coefficient
-    # of reserves multiplied. It's the value Uniswap protocol
-    # uses to control reserves growth on both sides of the pool.
-    lastKValue: BigInt!
-
-    # totalSupply represents the total amount
-    # of the pair tokens in circulation and represents
-    # the total share pool of all the participants.
-    totalSupply: BigInt!
-
-    # share represents the share of the given user/participant on the pair.
-    # To get the share percentage, divide this value by the total supply
-    # of the pair.
-    shareOf(user: Address!): BigInt!
-}
-
-
-# DefiUniswapVolume represents a calculated volume for swap pairs in history
-type DefiUniswapVolume {
-
-    # UniswapPair represents the information about single
-    # Uniswap pair managed by the Uniswap Core.
-    uniswapPair: UniswapPair!
-
-    # pairAddress represents the Address of the Pair
-    pairAddress: Address!
-
-    # dailyVolume returns swap volume for last 24 hours
-    dailyVolume: BigInt!
+    StakeTokenizerContract: Address!
 
-    # weeklyVolume returns swap volume for last 7 days
-    weeklyVolume: BigInt!
+    # StakeTokenizedERC20Token is the address of the Tokenized Stake ERC20 contract.
+    StakeTokenizedERC20Token: Address!
 
-    # monthlyVolume returns swap volume for last month
-    monthlyVolume: BigInt!
+    # fMintAddress is the address of the fMint contract.
+    fMintContract: Address!
 
-    # YearlyVolume returns swap volume for last year
-    yearlyVolume: BigInt!
+	# fMintAddressProvider represents the address of the fMint address provider.
+	fMintAddressProvider: Address!
 
-    # IsInFUSD indicates if TokenA from the pair has a price value to be able
-    # to calculate value in fUSD
-    isInFUSD: Boolean!
+    # tokenRegistryContract is the address of the fMint token registry.
+    fMintTokenRegistry: Address!
 
-}
+    # fMintRewardDistribution is the address of the DeFi fMint
+    # reward distribution contract.
+    fMintRewardDistribution: Address!
 
-# DefiSwaps represents swap volume for given pair and time interval
-type DefiTimeVolume {
+    # fMintCollateralPool is the address of the fMint collateral pool.
+    fMintCollateralPool: Address!
 
-    # pairAddress represents the Address of the Pair
-    pairAddress: Address!
+    # fMintDebtPool is the address of the fMint debt pool.
+    fMintDebtPool: Address!
 
-    # time indicates a period for this volume
-    time: String!
+    # uniswapCoreFactory is the address of the Uniswap Core Factory contract.
+    uniswapCoreFactory: Address!
 
-    # value represents amount of the volume
-    value: BigInt!
+    # uniswapRouter is the address of the Uniswap Router contract.
+    uniswapRouter: Address!
 }
 
-# DefiTimePrice represents a calculated price for swap pairs in history
-type DefiTimePrice {
+# ContractEventList is a list of decoded contract events.
+type ContractEventList {
+    # Edges contains provided edges of the sequential list.
+    edges: [ContractEventListEdge!]!
 
-	# pairAddress represents the Address of the Pair
-    pairAddress: Address!
+    # TotalCount is the maximum number of contract events
+    # available for sequential access.
+    totalCount: Long!
 
-    # time indicates a period for this price
-    time: String!
+    # PageInfo is an information about the current page
+    # of contract event edges.
+    pageInfo: ListPageInfo!
+}
 
-    # opening price for this time period
-    open: Float!
+# ContractEventListEdge is a single edge in a sequential list
+# of contract events.
+type ContractEventListEdge {
+    # Cursor defines a scroll key to this edge.
+    cursor: Cursor!
 
-    # closing price for this time period
-	close: Float!
+    # event represents the contract event detail provided by this list edge.
+    event: ContractEvent!
+}
 
-    # lowest price for this time period
-	low: Float!
+# ERC721TransactionList is a list of ERC721 transaction edges provided by sequential access request.
+type ERC721TransactionList {
+    # Edges contains provided edges of the sequential list.
+    edges: [ERC721TransactionListEdge!]!
 
-    # highest price for this time period
-	high: Float!
+    # TotalCount is the maximum number of ERC721 transactions available for sequential access.
+    totalCount: BigInt!
 
-    # average price for this time period
-    average: Float!
+    # PageInfo is an information about the current page of ERC721 transaction edges.
+    pageInfo: ListPageInfo!
 }
 
-# DefiTimeReserve represents a Uniswap pair reserve in history
-type DefiTimeReserve {
-
-    # UniswapPair represents the information about single
-    # Uniswap pair managed by the Uniswap Core.
-    uniswapPair: UniswapPair!
+# TransactionListEdge is a single edge in a sequential list of ERC721 transactions.
+type ERC721TransactionListEdge {
+    cursor: Cursor!
+    trx: ERC721Transaction!
+}
 
-    # Time represents UTC ISO time tag for this reserve values
-    time: String!
+# Contract defines block-chain smart contract information container
+type Contract {
+    "Address represents the contract address."
+    address: Address!
 
-    # ReserveClose is close reserve for this time period
-	# for both tokens. Index inside the array corresponds
-    # with the token position.
-    reserveClose: [BigInt!]!
-}
-# LendingPool represents a lendingpool instance.
-type LendingPool {
+    "DeployedBy represents the smart contract deployment transaction reference."
+    deployedBy: Transaction!
 
-    # Returns all assets reserve addresses
-    reserveList: [Address!]!
+    "transactionHash represents the smart contract deployment transaction hash."
+    transactionHash: Bytes32!
 
-    # A list of all assets reserves with its data
-    reserveDataList: [ReserveData!]!
+    "Smart contract name. Empty if not available."
+    name: String!
 
-    # Asset reserve data just for one asset address
-	reserveData(address: Address!): ReserveData!
+    "Smart contract version identifier. Empty if not available."
+    version: String!
 
-    # User account data for specified user address
-    userAccountData(address: Address!): FLendUserData!
+    """
+    License specifies an open source license the contract was published with.
+    Empty if not specified.
+    """
+    license: String!
 
-    # User account deposit event history data
-    userDepositHistory(address: Address, asset: Address): [FLendDeposit!]!
-}
+    "Smart contract author contact. Empty if not available."
+    supportContact: String!
 
-# ReserveData represents a lendingpool asset data.
-# Unit Ray is 1e27.
-type ReserveData {
+    "Smart contract compiler identifier. Empty if not available."
+    compiler: String!
 
-    # address of the asset
-    assetAddress: Address!
+    "Smart contract source code. Empty if not available."
+    sourceCode: String!
 
-    # number in the reserveList() array
-    ID: Int!
+    "Smart contract ABI definition. Empty if not available."
+    abi: String!
 
-    # bitmask encoded asset reserve configuration data
-    configuration: BigInt!
+    """
+    Validated is the unix timestamp at which the source code was validated
+    against the deployed byte code. Null if not validated yet.
+    """
+    validated: Long
 
-    # liquidity index in ray
-    liquidityIndex: BigInt!
+    "Timestamp is the unix timestamp at which this smart contract was deployed."
+    timestamp: Long!
+}
 
-    # variable borrow index in ray
-    variableBorrowIndex: BigInt!
+# ContractValidationInput represents a set of data sent from client
+# to validate deployed contract with the provided source code.
+input ContractValidationInput {
+    "Address of the contract being validated."
+    address: Address!
 
-    # current supply / liquidity / deposit rate in ray
-    currentLiquidityRate: BigInt!
+    "Optional smart contract name. Maximum allowed length is 64 characters."
+    name: String
 
-    # current variable borrow rate in ray
-    currentVariableBorrowRate: BigInt!
+    "Optional smart contract version identifier. Maximum allowed length is 14 characters."
+    version: String
 
-    # current stable borrow rate in ray
-    currentStableBorrowRate: BigInt!
+    "Optional smart contract author contact. Maximum allowed length is 64 characters."
+    supportContact: String
 
-    # timestamp of when reserve data was last updated
-    lastUpdateTimestamp: BigInt!
+    """
+    License specifies an open source license the contract was published with.
+    Empty if not specified.
+    """
+    license: String
 
-    # address of associated aToken (tokenised deposit)
-    aTokenAddress: Address!
+    "Optimized specifies if the compiler was set to optimize the byte code."
+    optimized: Boolean = true
 
-    # address of associated stable debt token
-	stableDebtTokenAddress: Address!
+    """
+    OptimizeRuns specifies number of optimization runs the compiler was set
+    to execute during the byte code optimizing.
+    """
+    optimizeRuns: Int = 200
 
-    # address of associated variable debt token
-	variableDebtTokenAddress: Address!
+    """
+    Optional version of the Solidity compiler used to compile the contract,
+    i.e. "v0.7.5+commit.eb77ed08". The default compiler of the API server
+    is used if not specified.
+    """
+    compiler: String
 
-    # address of interest rate strategy
-    interestRateStrategyAddress: Address!
+    "Smart contract source code."
+    sourceCode: String!
 }
 
+# ContractEvent represents a decoded event emitted by a contract
+# indexed by the custom contract events indexer.
+type ContractEvent {
+    # address represents the address of the contract emitting the event.
+    address: Address!
+
+    # event represents the name of the event.
+    event: String!
 
-# FLendUserData represents a lendingpool user data.
-type FLendUserData {
+    # signature represents the canonical signature of the event,
+    # i.e. Transfer(address,address,uint256).
+    signature: String!
 
-    # total collateral in FUSD of the user
-	totalCollateralFUSD: BigInt!
+    # topic represents the hash of the event signature.
+    topic: Bytes32!
 
-    # total debt in FUSD of the user
-	totalDebtFUSD: BigInt!
+    # blockNumber represents the number of the block the event was emitted in.
+    blockNumber: Long!
 
-    # borrowing power left of the user in FUSD
-	availableBorrowsFUSD: BigInt!
+    # trxHash represents the hash of the transaction emitting the event.
+    trxHash: Bytes32!
 
-    # liquidation threshold of the user
-	currentLiquidationThreshold: BigInt!
+    # transaction represents the transaction emitting the event.
+    transaction: Transaction!
 
-    # Loan To Value of the user
-	ltv: BigInt!
+    # logIndex represents the index of the event log in the block.
+    logIndex: Long!
 
-    # current health factor of the user
-	healthFactor: BigInt!
+    # timeStamp represents the time stamp of the block the event was emitted in
+    # in Unix Epoch units, e.g. number of seconds from the Unix Epoch start.
+    timeStamp: Long!
 
-    # configuration data
-    configurationData: BigInt!
+    # args represents the list of decoded event arguments in the order of the event signature.
+    args: [ContractEventArg!]!
 }
 
-# FLendDeposit represents a lendingpool deposit event data.
-type FLendDeposit {
-
-    # address of the asset
-	assetAddress: Address!
+# ContractEventArg represents a single decoded argument of a contract event.
+type ContractEventArg {
+    # name represents the name of the argument.
+    name: String!
 
-	# address of the user
-	userAddress: Address!
+    # type represents the ABI type of the argument, i.e. uint256.
+    type: String!
 
-    # address of the on behalf of
-	onBehalfOfAddress: Address!
+    # value represents the decoded value of the argument. Numbers are decimal,
+    # addresses, hashes and byte arrays are hex encoded. Indexed dynamic values
+    # are represented by their hash.
+    value: String!
 
-	# deposit amount
-	amount: BigInt!
+    # indexed signals if the argument is an indexed topic of the event.
+    indexed: Boolean!
+}
 
-	# referral code
-	referralCode: Int!
+# ContractEventArgFilter represents a filter of contract events
+# by the value of an event argument.
+input ContractEventArgFilter {
+    # name represents the name of the argument.
+    name: String!
 
-    # time of deposit
-    timestamp: Long!
+    # value represents the expected value of the argument
+    # in the same form as the decoded value is provided.
+    value: String!
 }
 
-# FLendBorrow represents a lending pool borrow event data.
-type FLendBorrow {
-    # address of the asset
-	assetAddress: Address!
+# RewardClaimList is a list of reward claims linked to delegations.
+type RewardClaimList {
+    # Edges contains provided edges of the sequential list.
+    edges: [RewardClaimListEdge!]!
 
-	# address of the user
-	userAddress: Address!
+    # TotalCount is the maximum number of reward claims
+    # available for sequential access.
+    totalCount: Long!
+
+    # PageInfo is an information about the current page
+    # of reward claim edges.
+    pageInfo: ListPageInfo!
+}
+
+# RewardClaimListEdge is a single edge in a sequential list
+# of reward claims.
+type RewardClaimListEdge {
+    # Cursor defines a scroll key to this edge.
+    cursor: Cursor!
 
-    # address of the on behalf of
-	onBehalfOfAddress: Address!
+    # claim represents the reward claim detail provided by this list edge.
+    claim: RewardClaim!
+}
 
-	# deposit amount
-	amount: BigInt!
+# ERC20Token represents a generic ERC20 token.
+type ERC20Token {
+    # address of the token is used as the token's unique identifier.
+    address: Address!
 
-    # interest rate mode
-    interestRateMode: Int!
+    # name of the token.
+    name: String!
 
-    # borrow rate
-    borrowRate: Int!
+    # symbol used as an abbreviation for the token.
+    symbol: String!
 
-	# referral code
-	referralCode: Int!
+    # decimals is the number of decimals the token supports.
+    # The most common value is 18 to mimic the ETH to WEI relationship.
+    decimals: Int!
 
-    # time of deposit
-    timestamp: Long!
-}
-# RewardClaim represents
-type RewardClaim {
-    # address represents the address of the delegator
-    address: Address!
+    # totalSupply represents total amount of tokens across all accounts
+    totalSupply: BigInt!
 
-    # toStakerId represents the ID of the validator the delegation
-    # is placed on
-    toStakerId: BigInt!
+    # logoURL represents a URL address of a logo of the token. It's always
+    # provided, but unknown tokens have this set to a generic logo file.
+    logoURL: String!
 
-    # claimed represents the time stamp of the reward claim
-    # in Unix Epoch units, e.g. number of seconds from the Unix Epoch start.
-    claimed: Long!
+    # balanceOf represents the total available balance of the token
+    # on the account regardless of the DeFi usage of the token.
+    # It's effectively the amount available held by the ERC20 token
+    # on the account behalf.
+    balanceOf(owner: Address!): BigInt!
 
-    # amount represents the amount of tokens rewarded on the claim.
-    amount: BigInt!
+    # allowance represents the amount of ERC20 tokens unlocked
+    # by the owner / token holder to be accessible for the given spender.
+    allowance(owner: Address!, spender: Address!): BigInt!
 
-    # isRestaked signals if the claim was added to the delegation
-    # effectively increasing the staked amount and raising the delegation value.
-    isRestaked: Boolean!
+    # totalDeposited represents total amount of deposited tokens collateral on fMint.
+    totalDeposit: BigInt!
 
-    # trxHash is the hash pf the transaction calling for the rewards
-    # to be processed and granted.
-    trxHash: Bytes32!
+    # totalDebt represents total amount of borrowed/minted tokens on fMint.
+    totalDebt: BigInt!
 }
+
 # ERC1155Transaction represents a transaction on an ERC1155 NFT token.
 type ERC1155Transaction {
     # trxHash represents a hash of the transaction
@@ -1690,419 +2286,186 @@ type ERC1155Transaction {
     # of the ERC1155 transaction processing.
     timeStamp: Long!
 }
-# FeeFlowDaily represents daily aggregated flow of the transaction fee distribution in native FTM.
-type FeeFlowDaily {
-    # date is the signature date of the data point, the time part is set to 00:00:00Z.
-    date: Time!
-
-    # blocksCount represents the number of blocks included in the data point.
-    blocksCount: Int!
-
-    # fee is the amount of FTM collected from transaction fees;
-    # represented as fixed point decimal of FTM with 9 digits.
-    fee: Long!
-
-    # feeFTM is the amount of FTM collected from transaction fees;
-    # represented as floating point value in FTM units.
-    feeFTM: Float!
-
-    # burned is the amount of FTM burned;
-    # represented as fixed point decimal with 9 digits.
-    burned: Long!
-
-    # burnedFTM is the amount of FTM burned;
-    # represented as floating point value in FTM units.
-    burnedFTM: Float!
-
-    # treasury is the amount of FTM sent to treasury;
-    # represented as fixed point decimal with 9 digits.
-    treasury: Long!
-
-    # treasuryFTM is the amount of FTM sent to treasury;
-    # represented as floating point value in FTM units.
-    treasuryFTM: Float!
-
-    # rewards is the amount of FTM sent to rewards distribution;
-    # represented as fixed point decimal with 9 digits.
-    # -----------------------------------------------------------------------------------------------------------
-    # Please note this is the max amount of rewards available for distribution. The actual amount is scaled
-    # down based on locking period of individual stakers and the real amount distributed by the SFC contract
-    # will be lower in most cases. The remaining reward tokens after the scaling are effectively also burned
-    # and removed from the total supply, but this process is not reflected in this aggregated approximation.
-    # Please see the current Fantom SFC contract implementation for the rewards distribution details.
-    rewards: Long!
-
-    # rewardsFTM is the amount of FTM sent to rewards distribution;
-    # represented as floating point value in FTM units.
-    rewardsFTM: Float!
-}
-# ERC721Transaction represents a transaction on an ERC721 NFT token.
-type ERC721Transaction {
-    # trxHash represents a hash of the transaction
-    # executing the ERC721 call.
+# InternalTransaction represents a call made by a contract during execution
+# of a transaction, e.g. a value transfer from a contract, a contract creation,
+# or a self-destruct of a contract. Internal transactions are available only
+# if the API server is configured to trace transactions.
+type InternalTransaction {
+    # trxHash represents the hash of the parent transaction.
     trxHash: Bytes32!
 
-    # transaction represents the transaction
-    # executing the ERC721 call.
+    # transaction represents the parent transaction.
     transaction: Transaction!
 
-    # trxIndex represents the index
-    # of the ERC721 call in the transaction logs.
-    trxIndex: Long!
-
-    # tokenAddress represents the address
-    # of the ERC721 token contract.
-    tokenAddress: Address!
-
-    # token represents the ERC721 contract detail involved.
-    token: ERC721Contract!
-
-    # tokenId represents the NFT token - one ERC721 contract can handle multiple NFTs.
-    tokenId: BigInt!
-
-    # trxType is the type of the transaction.
-    trxType: TokenTransactionType!
-
-    # sender represents the address of the token owner
-    # sending the tokens, e.g. the sender.
-    sender: Address!
-
-    # recipient represents the address of the token recipient.
-    recipient: Address!
-
-    # amount represents the amount of tokens involved
-    # in the transaction; please make sure to interpret the amount
-    # with the correct number of decimals from the ERC721 token detail.
-    amount: BigInt!
-
-    # timeStamp represents the Unix epoch time stamp
-    # of the ERC721 transaction processing.
-    timeStamp: Long!
-}
-# TokenTransaction represents a generic token transaction
-# of a supported type of token.
-type TokenTransaction {
-    # Hash is the hash of the executed transaction call.
-    hash: Bytes32!
-
-    # trxIndex is the index of the transaction call in a block.
-    trxIndex: Long!
-
-    # blockNumber represents the number of the block
-    # the transaction was executed in.
+    # blockNumber represents the number of the block of the parent transaction.
     blockNumber: Long!
 
-    # tokenAddress represents the address of the token involved.
-    tokenAddress: Address!
-
-    # tokenName represents the name of the token contract.
-    # Is empty, if not provided for the given token.
-    tokenName: String!
-
-    # tokenSymbol represents the symbol of the token contract.
-    # Is empty, if not provided for the given token.
-    tokenSymbol: String!
-
-    # tokenType represents the type of the token (i.e. ERC20/ERC721/ERC1155).
-    tokenType: String!
+    # position represents the position of the call in the flattened call tree
+    # of the parent transaction.
+    position: Int!
 
-    # tokenDecimals is the number of decimals the token supports.
-    # The most common value is 18 to mimic the ETH to WEI relationship.
-    tokenDecimals: Int!
+    # traceAddress represents the path to the call in the call tree
+    # of the parent transaction.
+    traceAddress: [Int!]!
 
-    # type represents the type of the transaction executed (i.e. Transfer/Mint/Approval).
+    # type represents the type of the call, i.e. CALL, DELEGATECALL, CREATE, CREATE2, or SELFDESTRUCT.
     type: String!
 
-    # sender of the transaction.
-    sender: Address!
-
-    # recipient of the transaction.
-    recipient: Address!
-
-    # amount of tokens involved in the transaction.
-    amount: BigInt!
-
-    # multi-token contracts (ERC-721/ERC-1155) token ID involved in the transaction.
-    tokenId: BigInt!
-
-    # time stamp of the block processing.
-    timeStamp: Long!
-}
-
-# Account defines block-chain account information container
-type Account {
-    # Address is the address of the account.
-    address: Address!
-
-    # Balance is the current balance of the Account in WEI.
-    balance: BigInt!
-
-    # TotalValue is the current total value of the account in WEI.
-    # It includes available balance, delegated amount and pending rewards.
-    # NOTE: This values is slow to calculate.
-    totalValue: BigInt!
-
-    # txCount represents number of transaction sent from the account (Nonce).
-    txCount: Long!
-
-    # txList represents list of transactions of the account in form of TransactionList.
-    txList(recipient: Address, cursor:Cursor, count:Int!): TransactionList!
-
-    # erc20TxList represents list of ERC20 transactions of the account.
-    erc20TxList(cursor:Cursor, count:Int = 25, token: Address, txType: [TokenTransactionType!]): ERC20TransactionList!
-
-    # erc721TxList represents list of ERC721 transactions of the account.
-    erc721TxList(cursor:Cursor, count:Int = 25, token: Address, tokenId: BigInt, txType: [TokenTransactionType!]): ERC721TransactionList!
-
-    # erc1155TxList represents list of ERC1155 transactions of the account.
-    erc1155TxList(cursor:Cursor, count:Int = 25, token: Address, tokenId: BigInt, txType: [TokenTransactionType!]): ERC1155TransactionList!
-
-    # Details of a staker, if the account is a staker.
-    staker: Staker
-
-    # List of delegations of the account, if the account is a delegator.
-    delegations(cursor:Cursor, count:Int = 25): DelegationList!
-
-    # Details about smart contract, if the account is a smart contract.
-    contract: Contract
-}
-
-# GovernanceContract represents basic information
-# about a Governance contract deployed on the block chain.
-type GovernanceContract {
-    # name represents the name of the contract
-    name: String!
-
-    # address represents the address of the Governance contract
-    address: Address!
-
-    # totalProposals represents the total number of proposals
-    # managed by the Governance contract.
-    totalProposals: BigInt!
+    # from represents the address of the caller.
+    from: Address!
 
-    # proposals represents list of proposals on the contract.
-    proposals(cursor:Cursor, count:Int!, activeOnly: Boolean = false):GovernanceProposalList!
+    # to represents the address of the callee, or the address of the created contract.
+    to: Address
 
-    # proposal provides specific Governance Proposal detail identified
-    # by its ID inside the Governance contract.
-    proposal(id: BigInt!):GovernanceProposal
+    # value represents the value transferred by the call in WEI.
+    value: BigInt!
 
-    # delegationsBy represents list of delegations for the given address.
-    # If the address does not delegate, the list is empty.
-    # Delegations are handled by the governed contract, so this list may
-    # be always empty for certain Governance instances. If the list is empty
-    # the source address may still be eligible for voting by itself.
-    delegationsBy(from: Address!): [Address!]!
+    # gas represents the gas provided to the call.
+    gas: Long!
 
-    # canVote checks if the given address can submit votes to Proposals
-    # of this Governance conract. The ability to vote is bound
-    # to the governed contract logic and can be unavailable
-    # to some network participants on certain situation.
-    canVote(from: Address!): Boolean!
+    # gasUsed represents the gas used by the call.
+    gasUsed: Long!
 
-    # proposalFee represents the fee required by the Governance
-    # to accept proposals. The fee is never refunded,
-    # even if a Proposal is canceled.
-    proposalFee: BigInt!
+    # input represents the input data of the call. Large inputs are not provided.
+    input: Bytes!
 
-    # totalVotingPower represents the total voting power available
-    # on the Governance contract in the form of votes
-    # weight.
-    totalVotingPower: BigInt!
+    # error represents the reason of the call failure, if the call failed.
+    error: String
+
+    # timeStamp represents the time stamp of the parent transaction
+    # in Unix Epoch units, e.g. number of seconds from the Unix Epoch start.
+    timeStamp: Long!
 }
 
-# GovernanceProposalList is a list of governance proposal edges
-# provided by sequential access request.
-type GovernanceProposalList {
+# InternalTransactionList is a list of internal transactions.
+type InternalTransactionList {
     # Edges contains provided edges of the sequential list.
-    edges: [GovernanceProposalListEdge!]!
+    edges: [InternalTransactionListEdge!]!
 
-    # TotalCount is the maximum number of governance proposals
+    # TotalCount is the maximum number of internal transactions
     # available for sequential access.
-    totalCount: BigInt!
+    totalCount: Long!
 
-    # PageInfo is an information about the current page of governance
-    # proposal edges.
+    # PageInfo is an information about the current page
+    # of internal transaction edges.
     pageInfo: ListPageInfo!
 }
 
-# TransactionListEdge is a single edge in a sequential list
-# of governance proposals.
-type GovernanceProposalListEdge {
+# InternalTransactionListEdge is a single edge in a sequential list
+# of internal transactions.
+type InternalTransactionListEdge {
+    # Cursor defines a scroll key to this edge.
     cursor: Cursor!
-    proposal: GovernanceProposal!
-}
-
-# GovernanceProposal represents the details of a single proposal
-# in the governance contract.
-type GovernanceProposal {
-    # governanceId represents the identifier of the Governance
-    # contract this Proposal belongs to.
-    governanceId: Address!
-
-    # governance represents the Governance contract reference.
-    # Please make sure not to engage in a circular reference too deep.
-    governance: GovernanceContract!
-
-    # id identifier of the proposal in the governance contract
-    # the proposal is managed by.
-    id: BigInt!
 
-    # name represents a name of the Proposal.
-    name: String!
-
-    # description represents a textual description of the Proposal.
-    description: String!
-
-    # state represents the state of the Proposal.
-    state: ProposalState!
-
-    # contract represents the contract of the Proposal. Each Proposal
-    # is represented by a contract responsible for maintaining the Proposal
-    # parameters, options and finalization actions.
-    contract: Address!
+    # transaction represents the internal transaction detail provided by this list edge.
+    transaction: InternalTransaction!
+}
 
-    # owner represents the owning wallet of the proposal
-    owner: Address!
+# Account defines block-chain account information container
+type Account {
+    # Address is the address of the account.
+    address: Address!
 
-    # proposalType represents the type of the Proposal that corresponds
-    # with the Proposal Template.
-    proposalType: Long!
+    # Balance is the current balance of the Account in WEI.
+    balance: BigInt!
 
-    # isExecutable identifies if the proposal will be finalized
-    # by executing a finalizing code.
-    isExecutable: Boolean!
+    # TotalValue is the current total value of the account in WEI.
+    # It includes available balance, delegated amount and pending rewards.
+    # NOTE: This values is slow to calculate.
+    totalValue: BigInt!
 
-    # minVotes corresponds with the minimal weight of votes
-    # required by the Proposal to be settled in any way
-    # other than REJECTED.
-    minVotes: BigInt!
+    # txCount represents number of transaction sent from the account (Nonce).
+    txCount: Long!
 
-    # minAgreement represents the minimal agreement weight
-    # required to be reached on any of the Proposal options
-    # so the Proposal could be settled in any way
-    # other than REJECTED.
-    minAgreement: BigInt!
+    # txList represents list of transactions of the account in form of TransactionList.
+    # The optional filter narrows the list of the account transactions further.
+    txList(recipient: Address, filter: TransactionFilter, cursor:Cursor, count:Int!): TransactionList!
 
-    # totalWeight represents the total voting weight
-    # of all voters allowed on the proposal. This is effectively
-    # the maximum weight an option can gain if all the voters
-    # would favor it with the top value of the scale.
-    totalWeight: BigInt!
+    # internalTxList represents list of internal transactions sent or received by the account.
+    # The list is available only if the API server is configured to trace transactions.
+    internalTxList(cursor:Cursor, count:Int = 25, sort: ListSort): InternalTransactionList!
 
-    # votedWeightRatio represents the percentage of the total voting weight
-    # already counted towards the proposal options. The ratio increases
-    # as more voters place their votes.
-    # The value is normalised to 1 digit precision, to get a percentage
-    # you need to divide the value by 10.
-    # The value is zero if no vote was placed. The value is 1000
-    # if all the voters placed their votes either directly,
-    # or through a vote delegation mechanism.
-    # Please note the value is an estimation. The voting status
-    # does not closely reflect changes in the total voting power,
-    # especially after the voting is closed.
-    votedWeightRatio: Int!
+    # erc20TxList represents list of ERC20 transactions of the account.
+    erc20TxList(cursor:Cursor, count:Int = 25, token: Address, txType: [TokenTransactionType!]): ERC20TransactionList!
 
-    # opinionScales is the scale of opinions on available options.
-    # A voter provides a single opinion picked from the scale
-    # for each option during the voting for a proposal.
-    # I.e.: Scales {0, 2, 3, 4, 5} represent opinions of
-    # {strongly disagree, disagree, neutral, agree and strongly agree}.
-    opinionScales: [Long!]!
+    # erc721TxList represents list of ERC721 transactions of the account.
+    erc721TxList(cursor:Cursor, count:Int = 25, token: Address, tokenId: BigInt, txType: [TokenTransactionType!]): ERC721TransactionList!
 
-    # options is a list of options available on the Proposal.
-    # A voter must provide their opinion expressed by a chosen scale
-    # for each option on the list. It's generally better to scatter
-    # opinions across options instead of having a binary view.
-    options: [String!]!
+    # erc1155TxList represents list of ERC1155 transactions of the account.
+    erc1155TxList(cursor:Cursor, count:Int = 25, token: Address, tokenId: BigInt, txType: [TokenTransactionType!]): ERC1155TransactionList!
 
-    # votingStarts is the time stamp of the voting getting opened
-    # to receive votes.
-    votingStarts: Long!
+    # Details of a staker, if the account is a staker.
+    staker: Staker
 
-    # votingMayEnd is the time stamp when the voting could be closed
-    # if enough votes are collected to settle the Proposal (winner option is selectable).
-    votingMayEnd: Long!
+    # List of delegations of the account, if the account is a delegator.
+    delegations(cursor:Cursor, count:Int = 25, sort: AmountListSort): DelegationList!
 
-    # votingMustEnd is the time stamp when the voting must be closed.
-    # If enough votes to settle the Proposal were not collected up until this time
-    # the Proposal is rejected and will not be settled in any way (no winner option is selectable).
-    votingMustEnd: Long!
+    # Details about smart contract, if the account is a smart contract.
+    contract: Contract
+}
 
-    # optionStates is the list of states of all the options in the Proposal.
-    # Warning: This is an expensive call, use with caution.
-    optionStates: [OptionState!]!
+# UniswapActionList is a list of uniswap action edges provided by sequential access request.
+type UniswapActionList {
+    # Edges contains provided edges of the sequential list.
+    edges: [UniswapActionListEdge!]!
 
-    # optionState represents a state of the selected option of the Proposal.
-    optionState(optionId:BigInt!):OptionState
+    # TotalCount is the maximum number of uniswap actions available for sequential access.
+    totalCount: BigInt!
 
-    # vote pulls the vote for the given <from> address linked with the <delegatedTo> delegation
-    # recipient. If the <from> address is not delegator in the context of the governance
-    # subject contract, the <delegatedTo> may be left empty, or set to the same address
-    # as the <from> address.
-    vote(from: Address!, delegatedTo: Address): GovernanceVote
+    # PageInfo is an information about the current page of uniswap action edges.
+    pageInfo: ListPageInfo!
 }
 
-# ProposalState represents the state of the whole proposal.
-type ProposalState {
-    # isResolved signals if the Proposal is already resolved.
-    isResolved: Boolean!
+# UniswapActionListEdge is a single edge in a sequential list of uniswap actions.
+type UniswapActionListEdge {
+    cursor: Cursor!
+    uniswapAction: UniswapAction!
+}
 
-    # winnerId is the identifier of the winning option.
-    winnerId: BigInt
+# UniswapAction represents a Uniswap action - swap, mint, burn
+type UniswapAction {
 
-    # votes is the number of votes received on the Proposal.
-    votes: BigInt!
+    # id of the action in the persistent db
+    id: Bytes32!
 
-    # status represents the status of the Proposal.
-    # 0 = Initial, 1 = Resolved, 2 = Failed, 4 = Canceled, 8 = Execution Expired
-    status: BigInt!
-}
+    # UniswapPair represents the information about single
+    # Uniswap pair managed by the Uniswap Core.
+    uniswapPair: UniswapPair!
 
-# OptionState represents a state in options of a Proposal.
-type OptionState {
-    # optionId is the identifier of the option,
-    # effectively option index in the options array
-    optionId: BigInt!
+    # pairAddress is address of the action's uniswap pair
+    pairAddress: Address!
 
-    # votes is the weight of all votes received across all votes;
-    # the projection of the votes to this state uses it to calculate
-    # actual agreement.
-    votes: BigInt!
+    # transactionHash represents the hash for this acstion transaction
+    transactionHash: Bytes32!
 
-    # agreement represents the rated weight of all the votes towards this option
-    # based on the opinion scale of the proposal and selected opinion scale level of
-    # each vote.
-    # this effectively reflects the absolute weight of affection of all voters
-    # towards this option.
-    agreement: BigInt!
+    # sender is address of action owner account
+    sender: Address!
 
-    # agreementRatio represents the relative ratio of the option agreement
-    # to the total weight of all votes in 18 digits.
-    agreementRatio: BigInt!
-}
+    # type represents action type:
+    # 0 - swap
+    # 1 - mint
+    # 2 - burn
+    type: Int!
 
-# GovernanceVote is the vote in the context of the given Governance Proposal.
-type GovernanceVote {
-    # governanceId is the identifier of the Governance contract.
-    governanceId: Address!
+    # blockNr is number of the block for this action
+    blockNr: Long!
 
-    # proposalId is the identifier of the proposal of the contract.
-    proposalId: BigInt!
+    # Time represents UTC ISO time tag for this reserve value
+    time: Long!
 
-    # from is the address of the voting party
-    from: Address!
+    # amount0in is amount of incoming tokens for Token0 in this action
+    amount0in: BigInt!
 
-    # delegatedTo is the address of the delegation the vote refers to.
-    delegatedTo: Address
+    # amount0out is amount of outgoing tokens for Token0 in this action
+    amount0out: BigInt!
 
-    # weight represents the weight of the vote
-    weight: BigInt!
+    # amount1in is amount of In tokens for Token1 in this action
+    amount1in: BigInt!
 
-    # choices represents the list of opinions on the Proposal options the vote
-    # presented.
-    choices: [Long!]!
+    # amount1out is amount of outgoing tokens for Token1 in this action
+    amount1out: BigInt!
 }
+
 # Root schema definition
 schema {
     query: Query
@@ -2137,26 +2500,51 @@ type Query {
     # or just contracts with validated byte code and available source/ABI.
     contracts(validatedOnly: Boolean = false, cursor:Cursor, count:Int!):ContractList!
 
+    # Get list of decoded events of contracts configured for the events indexer.
+    # The list can be narrowed by the contract address, the name of the event
+    # and by the decoded values of the event arguments.
+    # For undefined cursor, positive <count> starts the list from top,
+    # negative <count> starts the list from bottom.
+    contractEvents(address: Address, event: String, filter: [ContractEventArgFilter!], cursor: Cursor, count: Int!, sort: ListSort): ContractEventList!
+
     # Get block information by number or by hash.
     # If neither is provided, the most recent block is given.
-    block(number:Long, hash: Bytes32):Block
+    # If finalized is set, only a final block is provided; the most recent
+    # final block is given if neither number, nor hash is provided.
+    block(number:Long, hash: Bytes32, finalized: Boolean = false):Block
 
     # Get list of Blocks with at most <count> edges.
     # If <count> is positive, return edges after the cursor,
     # if negative, return edges before the cursor.
     # For undefined cursor, positive <count> starts the list from top,
     # negative <count> starts the list from bottom.
-    blocks(cursor:Cursor, count:Int!):BlockList!
+    # If finalized is set, only final blocks are listed.
+    blocks(cursor:Cursor, count:Int!, finalized: Boolean = false):BlockList!
 
     # Get transaction information for given transaction hash.
-    transaction(hash:Bytes32!):Transaction
+    # If finalized is set, only a transaction of a final block is provided.
+    transaction(hash:Bytes32!, finalized: Boolean = false):Transaction
 
     # Get list of Transactions with at most <count> edges.
     # If <count> is positive, return edges after the cursor,
     # if negative, return edges before the cursor.
     # For undefined cursor, positive <count> starts the list from top,
     # negative <count> starts the list from bottom.
-    transactions(cursor:Cursor, count:Int!):TransactionList!
+    # If finalized is set, only transactions of final blocks are listed.
+    transactions(cursor:Cursor, count:Int!, finalized: Boolean = false):TransactionList!
+
+    # Get list of Transactions matching the filter with at most <count> edges.
+    # The list is ordered the same way the full list of transactions is.
+    # If <count> is positive, return edges after the cursor,
+    # if negative, return edges before the cursor.
+    transactionsFiltered(filter: TransactionFilter!, cursor:Cursor, count:Int!):TransactionList!
+
+    # Get list of pending transactions observed by the API node, optionally
+    # narrowed by the sender and/or the recipient address. The list is ordered
+    # from the most recent pending transaction to the oldest one.
+    # If <count> is positive, return edges after the cursor,
+    # if negative, return edges before the cursor.
+    pendingTransactions(from: Address, to: Address, cursor:Cursor, count:Int = 25):TransactionList!
 
     # Get filtered list of ERC20 Transactions.
     erc20Transactions(cursor:Cursor, count:Int = 25, token: Address, account: Address, txType: [TokenTransactionType!]): ERC20TransactionList!
@@ -2197,15 +2585,16 @@ type Query {
 
     # The list of delegations for the given staker ID.
     # Cursor is used to obtain specific slice of the staker delegations.
-    # The most recent delegations are provided if cursor is omitted.
-    delegationsOf(staker:BigInt!, cursor: Cursor, count: Int = 25): DelegationList!
+    # The most recent delegations are provided if cursor is omitted,
+    # unless a different sort is requested.
+    delegationsOf(staker:BigInt!, cursor: Cursor, count: Int = 25, sort: AmountListSort): DelegationList!
 
     # Get the details of a specific delegation by it's delegator address
     # and staker the delegation belongs to.
     delegation(address:Address!, staker: BigInt!): Delegation
 
     # Get the list of all delegations by it's delegator address.
-    delegationsByAddress(address:Address!, cursor: Cursor, count: Int = 25): DelegationList!
+    delegationsByAddress(address:Address!, cursor: Cursor, count: Int = 25, sort: AmountListSort): DelegationList!
 
     # Returns the current price per gas in WEI units.
     gasPrice: Long!
@@ -2432,6 +2821,19 @@ type Subscription {
 
     # Subscribe to receive information about new transactions in the blockchain.
     onTransaction: Transaction!
+
+    # Subscribe to receive information about new pending transactions observed
+    # by the API node, optionally narrowed by the sender and/or the recipient.
+    onPendingTransaction(filter: PendingTransactionFilter): Transaction!
+}
+
+# PendingTransactionFilter represents a filter of pending transactions.
+input PendingTransactionFilter {
+    # from is the address of the sender of the transaction.
+    from: Address
+
+    # to is the address of the recipient of the transaction.
+    to: Address
 }
 
 `
@@ -0,0 +1,224 @@
+// Package handlers hold an HTTP/WS handlers chain along with separate middleware implementations.
+package handlers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/logger"
+	"fantom-api-graphql/internal/metrics"
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/svc"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// adminDeadLettersListSize represents the default size of the failed records list.
+const adminDeadLettersListSize = 100
+
+// adminHandler implements the administrative REST API of the API server.
+// All the requests are authorized by the configured bearer token
+// and logged, so the administrative actions can be audited.
+type adminHandler struct {
+	log   logger.Logger
+	token []byte
+	mux   *http.ServeMux
+}
+
+// Admin constructs and returns the administrative REST API HTTP handler.
+func Admin(cfg *config.Config, log logger.Logger) http.Handler {
+	h := adminHandler{
+		log:   log,
+		token: []byte(cfg.Admin.Token),
+		mux:   http.NewServeMux(),
+	}
+
+	h.mux.HandleFunc("/services", h.handle(http.MethodGet, h.services))
+	h.mux.HandleFunc("/services/pause", h.handle(http.MethodPost, h.pause))
+	h.mux.HandleFunc("/services/resume", h.handle(http.MethodPost, h.resume))
+	h.mux.HandleFunc("/rescan", h.handle(http.MethodPost, h.rescan))
+	h.mux.HandleFunc("/cache/evict", h.handle(http.MethodPost, h.evict))
+	h.mux.HandleFunc("/aggregate", h.handle(http.MethodPost, h.aggregate))
+	h.mux.HandleFunc("/deadletters", h.handle(http.MethodGet, h.deadLetters))
+	h.mux.HandleFunc("/deadletters/replay", h.handle(http.MethodPost, h.replayDeadLetter))
+	h.mux.HandleFunc("/deadletters/discard", h.handle(http.MethodPost, h.discardDeadLetter))
+	h.mux.HandleFunc("/upstreams", h.handle(http.MethodGet, h.upstreams))
+	h.mux.Handle("/metrics", metrics.Handler())
+	return &h
+}
+
+// ServeHTTP authorizes and audits the incoming request and passes it to the request MUXer.
+func (h *adminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		h.log.Warningf("admin request %s %s from %s rejected; not authorized", r.Method, r.URL.RequestURI(), r.RemoteAddr)
+		h.respond(w, http.StatusUnauthorized, map[string]string{"error": "not authorized"})
+		return
+	}
+
+	h.log.Noticef("admin request %s %s from %s", r.Method, r.URL.RequestURI(), r.RemoteAddr)
+	h.mux.ServeHTTP(w, r)
+}
+
+// authorized checks the bearer token of the request.
+// Requests are never authorized if the token is not configured.
+func (h *adminHandler) authorized(r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return len(h.token) > 0 && subtle.ConstantTimeCompare([]byte(token), h.token) == 1
+}
+
+// handle builds an HTTP handler function executing the given action for the given HTTP method.
+// The result of the action is responded and logged.
+func (h *adminHandler) handle(method string, action func(r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			h.respond(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+			return
+		}
+
+		res, err := action(r)
+		if err != nil {
+			h.log.Errorf("admin request %s %s failed; %s", r.Method, r.URL.RequestURI(), err.Error())
+			h.respond(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+
+		h.log.Noticef("admin request %s %s done", r.Method, r.URL.RequestURI())
+		h.respond(w, http.StatusOK, res)
+	}
+}
+
+// respond writes the given JSON response.
+func (h *adminHandler) respond(w http.ResponseWriter, status int, res interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.log.Errorf("can not encode admin response; %s", err.Error())
+	}
+}
+
+// services provides the status of the blockchain data processing services.
+func (h *adminHandler) services(_ *http.Request) (interface{}, error) {
+	return svc.Manager().Status(), nil
+}
+
+// adminGroupResponse represents the response to an action applied on a group of services.
+// Services of the processing pipeline depend on each other, so they are always paused
+// and resumed together; the Affected list contains all the services the action was applied on.
+type adminGroupResponse struct {
+	Affected []string            `json:"affected"`
+	Services []svc.ServiceStatus `json:"services"`
+}
+
+// pause stops a service of the given name, along with its group, until it's resumed.
+func (h *adminHandler) pause(r *http.Request) (interface{}, error) {
+	names, err := svc.Manager().Pause(r.URL.Query().Get("name"))
+	if err != nil {
+		return nil, err
+	}
+	return adminGroupResponse{Affected: names, Services: svc.Manager().Status()}, nil
+}
+
+// resume starts a paused service of the given name, along with its group, again.
+func (h *adminHandler) resume(r *http.Request) (interface{}, error) {
+	names, err := svc.Manager().Resume(r.URL.Query().Get("name"))
+	if err != nil {
+		return nil, err
+	}
+	return adminGroupResponse{Affected: names, Services: svc.Manager().Status()}, nil
+}
+
+// rescan starts a re-scan of the given range of blocks.
+func (h *adminHandler) rescan(r *http.Request) (interface{}, error) {
+	from, err := strconv.ParseUint(r.URL.Query().Get("from"), 0, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid starting block; %s", err.Error())
+	}
+
+	to, err := strconv.ParseUint(r.URL.Query().Get("to"), 0, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ending block; %s", err.Error())
+	}
+
+	if err := svc.Manager().Rescan(from, to); err != nil {
+		return nil, err
+	}
+	return map[string]uint64{"from": from, "to": to}, nil
+}
+
+// evict removes the given type of object of the given address from the in-memory cache.
+func (h *adminHandler) evict(r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+	if !common.IsHexAddress(q.Get("address")) {
+		return nil, fmt.Errorf("invalid address %s", q.Get("address"))
+	}
+	adr := common.HexToAddress(q.Get("address"))
+
+	switch q.Get("type") {
+	case "account":
+		repository.R().EvictAccount(&adr)
+	case "contract":
+		repository.R().EvictContract(&adr)
+	case "token":
+		repository.R().EvictErcToken(&adr)
+	default:
+		return nil, fmt.Errorf("unknown cache entry type %s", q.Get("type"))
+	}
+	return map[string]string{"type": q.Get("type"), "address": adr.String()}, nil
+}
+
+// aggregate forces an update of the given aggregation.
+func (h *adminHandler) aggregate(r *http.Request) (interface{}, error) {
+	name := r.URL.Query().Get("name")
+	switch name {
+	case "burns":
+		repository.R().BurnDailyUpdate()
+	case "trxflow":
+		repository.R().TrxFlowUpdate()
+	default:
+		return nil, fmt.Errorf("unknown aggregation %s", name)
+	}
+	return map[string]string{"aggregation": name}, nil
+}
+
+// deadLetters provides a list of failed processing records ordered by the time of the next retry.
+func (h *adminHandler) deadLetters(r *http.Request) (interface{}, error) {
+	count := int64(adminDeadLettersListSize)
+	if val := r.URL.Query().Get("count"); val != "" {
+		var err error
+		if count, err = strconv.ParseInt(val, 10, 64); err != nil || count < 1 {
+			return nil, fmt.Errorf("invalid count %s", val)
+		}
+	}
+	return repository.R().DeadLetters(nil, count)
+}
+
+// replayDeadLetter processes the failed record of the given identifier again.
+func (h *adminHandler) replayDeadLetter(r *http.Request) (interface{}, error) {
+	id := r.URL.Query().Get("id")
+	ok, err := svc.Manager().ReplayDeadLetter(id)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"id": id, "resolved": ok}, nil
+}
+
+// discardDeadLetter removes the failed record of the given identifier without processing it.
+func (h *adminHandler) discardDeadLetter(r *http.Request) (interface{}, error) {
+	id := r.URL.Query().Get("id")
+	ok, err := repository.R().DiscardDeadLetter(id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown failed record %s", id)
+	}
+	return map[string]interface{}{"id": id, "discarded": ok}, nil
+}
+
+// upstreams provides the status of the upstream nodes of the node pool.
+func (h *adminHandler) upstreams(_ *http.Request) (interface{}, error) {
+	return repository.R().UpstreamNodes(), nil
+}
@@ -22,13 +22,32 @@ func Api(cfg *config.Config, log logger.Logger, rs resolvers.ApiResolver) http.H
 	// we don't want to write a method for each type field if it could be matched directly
 	opts := []graphql.SchemaOpt{graphql.UseFieldResolvers()}
 
+	// the parser refuses queries too deep to be analysed by the limits
+	if cfg.Server.Limits.MaxDepth > 0 {
+		opts = append(opts, graphql.MaxDepth(cfg.Server.Limits.MaxDepth))
+	}
+
 	// create new parsed GraphQL schema
 	schema := graphql.MustParseSchema(gqlSchema.Schema(), rs, opts...)
 
+	// refuse queries over the configured limits before they are executed
+	limits := newLimitingHandler(&cfg.Server.Limits, log, schema)
+
+	// respond polled queries from the cache, if enabled; web socket operations are limited one by one
+	var gql http.Handler = graphqlws.NewHandlerFunc(
+		&limitedSchema{limits: limits, schema: schema},
+		&LoadersHandler{handler: &relay.Handler{Schema: schema}},
+		graphqlws.WithContextGenerator(graphqlws.ContextGeneratorFunc(limits.webSocketContext)),
+	)
+	if cfg.Server.ResponseCache.Enabled {
+		gql = newResponseCache(&cfg.Server.ResponseCache, log, rs, gql)
+	}
+	limits.handler = gql
+
 	// return the constructed API handler chain
 	return &LoggingHandler{
 		logger:  log,
-		handler: corsHandler.Handler(graphqlws.NewHandlerFunc(schema, &relay.Handler{Schema: schema})),
+		handler: corsHandler.Handler(limits),
 	}
 }
 
@@ -37,7 +56,16 @@ func corsOptions(cfg *config.Config) cors.Options {
 	return cors.Options{
 		AllowedOrigins: cfg.Server.CorsOrigin,
 		AllowedMethods: []string{"HEAD", "GET", "POST"},
-		AllowedHeaders: []string{"Origin", "Accept", "Content-Type", "X-Requested-With"},
+		AllowedHeaders: corsHeaders(cfg),
 		MaxAge:         300,
 	}
 }
+
+// corsHeaders provides the list of request headers allowed by the CORS handler.
+func corsHeaders(cfg *config.Config) []string {
+	headers := []string{"Origin", "Accept", "Content-Type", "X-Requested-With"}
+	if cfg.Server.Limits.ApiKeyHeader != "" {
+		headers = append(headers, cfg.Server.Limits.ApiKeyHeader)
+	}
+	return headers
+}
@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/graphql/complexity"
+	"fantom-api-graphql/internal/logger"
+	"fantom-api-graphql/internal/metrics"
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
+	gqlErrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-transport-ws/graphqlws"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// limitsMaxBodySize represents the max size of a GraphQL request body.
+const limitsMaxBodySize = 1 << 20
+
+// LimitingHandler defines HTTP handler middleware refusing GraphQL queries exceeding the configured
+// depth and complexity limits, or the rate limit of the client, before they are executed.
+// Requests which can not be analysed are refused as well.
+type LimitingHandler struct {
+	log      logger.Logger
+	cfg      *config.Limits
+	analyzer *complexity.Analyzer
+	limiter  *rateLimiter
+	apiKeys  map[string]bool
+	handler  http.Handler
+}
+
+// limitedRequest represents the parameters of a GraphQL request subject to the limits.
+type limitedRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// analysedQuery represents an accepted GraphQL request passed down the chain in the request context.
+type analysedQuery struct {
+	request *limitedRequest
+	score   *complexity.Score
+}
+
+// limitRefusal represents a GraphQL request refused by the limits.
+type limitRefusal struct {
+	status int
+	code   string
+	ext    map[string]interface{}
+	msg    string
+}
+
+// limitedSchema implements the GraphQL service of the web socket transport
+// applying the limits to each operation started on the connection.
+type limitedSchema struct {
+	limits *LimitingHandler
+	schema *graphql.Schema
+}
+
+// queryContextKey represents the type of the analysed query context key.
+type queryContextKey struct{}
+
+// clientContextKey represents the type of the web socket client context key.
+type clientContextKey struct{}
+
+// analysedQueryKey represents the context key of the analysed query.
+var analysedQueryKey = queryContextKey{}
+
+// limitedClientKey represents the context key of the client of a web socket connection.
+var limitedClientKey = clientContextKey{}
+
+// newLimitingHandler creates a new limiting middleware for the given schema and limits configuration.
+func newLimitingHandler(cfg *config.Limits, log logger.Logger, schema *graphql.Schema) *LimitingHandler {
+	h := LimitingHandler{
+		log:      log,
+		cfg:      cfg,
+		analyzer: complexity.New(schema.ASTSchema(), cfg),
+		limiter:  newRateLimiter(cfg.Rate, cfg.Burst),
+		apiKeys:  make(map[string]bool, len(cfg.ApiKeys)),
+	}
+	for _, key := range cfg.ApiKeys {
+		if key != "" {
+			h.apiKeys[key] = true
+		}
+	}
+	return &h
+}
+
+// ServeHTTP analyses the incoming GraphQL query and passes it down the chain if it fits the limits.
+func (h *LimitingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// web socket operations are analysed by the limited schema one by one
+	if isGraphQLWebSocket(r) {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, limitsMaxBodySize))
+	if err != nil {
+		http.Error(w, "Request too large.", http.StatusRequestEntityTooLarge)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	// decode the request the same way the GraphQL handler does, regardless of the method
+	var req limitedRequest
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&req); err != nil {
+		metrics.ObserveApiRejected("BAD_REQUEST")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	score, ref := h.check(h.client(r), &req)
+	if ref != nil {
+		if ref.status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", strconv.FormatInt(ref.ext["retryAfter"].(int64), 10))
+		}
+		h.refuse(w, ref)
+		return
+	}
+
+	h.handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), analysedQueryKey, &analysedQuery{request: &req, score: score})))
+}
+
+// check analyses the given GraphQL request of the given client against the limits.
+// Requests which can not be analysed are charged a single unit and refused.
+func (h *LimitingHandler) check(client string, req *limitedRequest) (*complexity.Score, *limitRefusal) {
+	score, err := h.analyzer.Analyze(req.Query, req.OperationName, req.Variables)
+	if err != nil {
+		h.log.Debugf("query not analysed; %s", err.Error())
+		if ref := h.take(client, 1); ref != nil {
+			return nil, ref
+		}
+		return nil, &limitRefusal{status: http.StatusOK, code: "QUERY_INVALID", ext: map[string]interface{}{},
+			msg: fmt.Sprintf("query can not be analysed; %s", err.Error())}
+	}
+
+	if h.cfg.MaxDepth > 0 && score.Depth > h.cfg.MaxDepth {
+		return nil, &limitRefusal{status: http.StatusOK, code: "QUERY_TOO_DEEP", ext: map[string]interface{}{"depth": score.Depth, "limit": h.cfg.MaxDepth},
+			msg: fmt.Sprintf("query depth %d exceeds the limit of %d", score.Depth, h.cfg.MaxDepth)}
+	}
+
+	if h.cfg.MaxComplexity > 0 && score.Complexity > h.cfg.MaxComplexity {
+		return nil, &limitRefusal{status: http.StatusOK, code: "QUERY_TOO_COMPLEX", ext: map[string]interface{}{"complexity": score.Complexity, "limit": h.cfg.MaxComplexity},
+			msg: fmt.Sprintf("query complexity %d exceeds the limit of %d", score.Complexity, h.cfg.MaxComplexity)}
+	}
+
+	if ref := h.take(client, score.Complexity); ref != nil {
+		return nil, ref
+	}
+
+	metrics.ObserveApiQuery(score.Complexity)
+	return score, nil
+}
+
+// take charges the given complexity against the rate limit of the given client.
+func (h *LimitingHandler) take(client string, cost int64) *limitRefusal {
+	if h.limiter == nil {
+		return nil
+	}
+
+	ok, wait := h.limiter.take(client, cost)
+	if ok {
+		return nil
+	}
+
+	retry := int64(math.Ceil(wait.Seconds()))
+	h.log.Debugf("client %s rate limited for %ds", client, retry)
+	return &limitRefusal{status: http.StatusTooManyRequests, code: "RATE_LIMITED", ext: map[string]interface{}{"complexity": cost, "retryAfter": retry},
+		msg: fmt.Sprintf("rate limit exceeded, retry in %d seconds", retry)}
+}
+
+// error converts the refusal into a GraphQL error.
+func (ref *limitRefusal) error() *gqlErrors.QueryError {
+	metrics.ObserveApiRejected(ref.code)
+
+	qe := gqlErrors.Errorf("%s", ref.msg)
+	qe.Extensions = ref.ext
+	qe.Extensions["code"] = ref.code
+	return qe
+}
+
+// Subscribe starts the given operation of a web socket connection if it fits the limits.
+func (ls *limitedSchema) Subscribe(ctx context.Context, document string, operationName string, variables map[string]interface{}) (<-chan interface{}, error) {
+	client, _ := ctx.Value(limitedClientKey).(string)
+	if _, ref := ls.limits.check(client, &limitedRequest{Query: document, OperationName: operationName, Variables: variables}); ref != nil {
+		return nil, ref.error()
+	}
+	return ls.schema.Subscribe(ctx, document, operationName, variables)
+}
+
+// webSocketContext keeps the client of a web socket connection in the connection context.
+func (h *LimitingHandler) webSocketContext(ctx context.Context, r *http.Request) (context.Context, error) {
+	return context.WithValue(ctx, limitedClientKey, h.client(r)), nil
+}
+
+// isGraphQLWebSocket checks if the request is a web socket upgrade handled by the GraphQL web socket transport.
+// Other requests fall back to the HTTP transport and must be analysed.
+func isGraphQLWebSocket(r *http.Request) bool {
+	if !websocket.IsWebSocketUpgrade(r) {
+		return false
+	}
+	for _, sp := range websocket.Subprotocols(r) {
+		if sp == graphqlws.ProtocolGraphQLWS {
+			return true
+		}
+	}
+	return false
+}
+
+// refuse responds with a GraphQL error of the refusal instead of executing the query.
+func (h *LimitingHandler) refuse(w http.ResponseWriter, ref *limitRefusal) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(ref.status)
+	if err := json.NewEncoder(w).Encode(struct {
+		Errors []*gqlErrors.QueryError `json:"errors"`
+	}{Errors: []*gqlErrors.QueryError{ref.error()}}); err != nil {
+		h.log.Errorf("can not respond refused query; %s", err.Error())
+	}
+}
+
+// client identifies the client of the given request by an allowed API key, or the IP address.
+// Keys not listed in the configuration are ignored so clients can not get a fresh bucket by changing them.
+func (h *LimitingHandler) client(r *http.Request) string {
+	if h.cfg.ApiKeyHeader != "" {
+		if key := r.Header.Get(h.cfg.ApiKeyHeader); key != "" && h.apiKeys[key] {
+			return fmt.Sprintf("key:%s", key)
+		}
+	}
+
+	if h.cfg.TrustForwarded {
+		if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+			return strings.TrimSpace(strings.Split(fwd, ",")[0])
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"fantom-api-graphql/internal/graphql/resolvers"
+	"net/http"
+)
+
+// LoadersHandler defines HTTP handler middleware attaching a fresh set of resolver batch loaders
+// to each GraphQL request, so related entities of list items are loaded in batches.
+// Web socket subscriptions are not served by this handler and resolve entities one by one.
+type LoadersHandler struct {
+	handler http.Handler
+}
+
+// ServeHTTP passes the request down the chain with the batch loaders in its context.
+func (h *LoadersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.handler.ServeHTTP(w, r.WithContext(resolvers.WithLoaders(r.Context())))
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// rateLimiterSweepPeriod represents the period of removing idle client buckets.
+const rateLimiterSweepPeriod = time.Minute
+
+// rateLimiter implements token bucket rate limiting of API clients.
+// Each client bucket is refilled by rate tokens per second, up to the burst size.
+type rateLimiter struct {
+	rate    float64
+	burst   float64
+	mu      sync.Mutex
+	buckets map[string]*rateBucket
+	swept   time.Time
+}
+
+// rateBucket represents the token bucket of a single client.
+type rateBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// newRateLimiter creates a new token bucket rate limiter; nil if the rate is not limited.
+func newRateLimiter(rate float64, burst int64) *rateLimiter {
+	if rate <= 0 || burst <= 0 {
+		return nil
+	}
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*rateBucket),
+		swept:   time.Now(),
+	}
+}
+
+// take removes the given number of tokens from the bucket of the given client.
+// If the bucket does not hold enough tokens, nothing is taken and the time
+// to wait for the bucket to refill is returned.
+func (rl *rateLimiter) take(client string, tokens int64) (bool, time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	rl.sweep(now)
+
+	b, ok := rl.buckets[client]
+	if !ok {
+		b = &rateBucket{tokens: rl.burst, updated: now}
+		rl.buckets[client] = b
+	}
+
+	// refill the bucket for the time passed since the last update
+	b.tokens = math.Min(rl.burst, b.tokens+now.Sub(b.updated).Seconds()*rl.rate)
+	b.updated = now
+
+	// a request larger than the bucket needs the full bucket
+	need := math.Min(float64(tokens), rl.burst)
+	if b.tokens < need {
+		return false, time.Duration((need - b.tokens) / rl.rate * float64(time.Second))
+	}
+
+	b.tokens -= need
+	return true, 0
+}
+
+// sweep removes buckets of clients idle long enough to have their bucket full again.
+func (rl *rateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.swept) < rateLimiterSweepPeriod {
+		return
+	}
+	rl.swept = now
+
+	for client, b := range rl.buckets {
+		if b.tokens+now.Sub(b.updated).Seconds()*rl.rate >= rl.burst {
+			delete(rl.buckets, client)
+		}
+	}
+}
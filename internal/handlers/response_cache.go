@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/graphql/complexity"
+	"fantom-api-graphql/internal/graphql/resolvers"
+	"fantom-api-graphql/internal/logger"
+	"fantom-api-graphql/internal/metrics"
+	"golang.org/x/sync/singleflight"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// responseCacheHeader represents the response header informing about the cache use.
+const responseCacheHeader = "X-Cache"
+
+// ResponseCacheHandler defines HTTP handler middleware responding GraphQL queries from a cache.
+// Responses are kept until a new block is dispatched, or their TTL expires. Mutations, subscriptions
+// and requests not analysed by the limiting handler are passed down the chain uncached.
+type ResponseCacheHandler struct {
+	log     logger.Logger
+	cfg     *config.ResponseCache
+	ttl     map[string]time.Duration
+	handler http.Handler
+	cg      singleflight.Group
+
+	mu      sync.RWMutex
+	gen     uint64
+	entries map[string]*cachedResponse
+}
+
+// cachedResponse represents a response kept in the cache.
+type cachedResponse struct {
+	status      int
+	contentType string
+	body        []byte
+	expires     time.Time
+}
+
+// responseRecorder captures a response of the handlers chain to be cached.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+// newResponseCache creates a new responses cache handler flushed on new blocks of the given resolver.
+func newResponseCache(cfg *config.ResponseCache, log logger.Logger, rs resolvers.ApiResolver, h http.Handler) *ResponseCacheHandler {
+	rc := ResponseCacheHandler{
+		log:     log,
+		cfg:     cfg,
+		ttl:     make(map[string]time.Duration, len(cfg.FieldTTL)),
+		handler: h,
+		entries: make(map[string]*cachedResponse),
+	}
+	for _, ft := range cfg.FieldTTL {
+		rc.ttl[ft.Field] = ft.TTL
+	}
+
+	go rc.observeBlocks(rs.OnBlock(context.Background()))
+	return &rc
+}
+
+// observeBlocks flushes the cache on each new block.
+func (rc *ResponseCacheHandler) observeBlocks(blocks <-chan *resolvers.Block) {
+	for range blocks {
+		rc.mu.Lock()
+		rc.gen++
+		rc.entries = make(map[string]*cachedResponse)
+		rc.mu.Unlock()
+	}
+}
+
+// ServeHTTP responds the GraphQL query from the cache, or passes it down the chain and keeps the response.
+func (rc *ResponseCacheHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	aq, ok := r.Context().Value(analysedQueryKey).(*analysedQuery)
+	if !ok || aq.score.Operation != "query" {
+		rc.handler.ServeHTTP(w, r)
+		return
+	}
+
+	ttl := rc.lifetime(aq.score)
+	key, err := rc.key(aq.request)
+	if ttl <= 0 || err != nil {
+		rc.handler.ServeHTTP(w, r)
+		return
+	}
+
+	// respond from the cache if possible
+	if res := rc.get(key); res != nil {
+		metrics.ObserveApiCache(true)
+		rc.respond(w, res, "HIT")
+		return
+	}
+	metrics.ObserveApiCache(false)
+
+	// concurrent requests of the same query wait for the first one to be executed
+	rc.mu.RLock()
+	gen := rc.gen
+	rc.mu.RUnlock()
+
+	v, _, _ := rc.cg.Do(key, func() (interface{}, error) {
+		rec := responseRecorder{header: make(http.Header), status: http.StatusOK}
+		rc.handler.ServeHTTP(&rec, r)
+
+		res := cachedResponse{
+			status:      rec.status,
+			contentType: rec.header.Get("Content-Type"),
+			body:        rec.body.Bytes(),
+			expires:     time.Now().Add(ttl),
+		}
+		rc.put(key, gen, &res)
+		return &res, nil
+	})
+	rc.respond(w, v.(*cachedResponse), "MISS")
+}
+
+// lifetime provides the time the response of the given query can be cached.
+func (rc *ResponseCacheHandler) lifetime(score *complexity.Score) time.Duration {
+	ttl := rc.cfg.TTL
+	for _, f := range score.Fields {
+		if ft, ok := rc.ttl[f]; ok && ft < ttl {
+			ttl = ft
+		}
+	}
+	return ttl
+}
+
+// key provides the cache key of the request by its normalized query, operation name and variables.
+func (rc *ResponseCacheHandler) key(req *limitedRequest) (string, error) {
+	query, err := complexity.Normalize(req.Query)
+	if err != nil {
+		return "", err
+	}
+
+	// the map keys are sorted by the encoder, so equal variables encode equally
+	vars, err := json.Marshal(req.Variables)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(query))
+	h.Write([]byte{0})
+	h.Write([]byte(req.OperationName))
+	h.Write([]byte{0})
+	h.Write(vars)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// get provides a live cached response of the given key; nil if not available.
+func (rc *ResponseCacheHandler) get(key string) *cachedResponse {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	res, ok := rc.entries[key]
+	if !ok || time.Now().After(res.expires) {
+		return nil
+	}
+	return res
+}
+
+// put keeps a successful response in the cache, unless a new block arrived while it was being resolved.
+func (rc *ResponseCacheHandler) put(key string, gen uint64, res *cachedResponse) {
+	if res.status != http.StatusOK || hasErrors(res.body) {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if gen != rc.gen {
+		return
+	}
+
+	// drop expired responses first; if still full, start over
+	if rc.cfg.MaxEntries > 0 && len(rc.entries) >= rc.cfg.MaxEntries {
+		now := time.Now()
+		for k, e := range rc.entries {
+			if now.After(e.expires) {
+				delete(rc.entries, k)
+			}
+		}
+		if len(rc.entries) >= rc.cfg.MaxEntries {
+			rc.entries = make(map[string]*cachedResponse)
+		}
+	}
+	rc.entries[key] = res
+}
+
+// respond writes the given cached response to the client.
+func (rc *ResponseCacheHandler) respond(w http.ResponseWriter, res *cachedResponse, result string) {
+	if res.contentType != "" {
+		w.Header().Set("Content-Type", res.contentType)
+	}
+	w.Header().Set(responseCacheHeader, result)
+	w.WriteHeader(res.status)
+
+	if _, err := w.Write(res.body); err != nil {
+		rc.log.Debugf("can not write cached response; %s", err.Error())
+	}
+}
+
+// hasErrors checks if the given GraphQL response contains errors.
+func hasErrors(body []byte) bool {
+	var res struct {
+		Errors []json.RawMessage `json:"errors"`
+	}
+	return json.Unmarshal(body, &res) != nil || len(res.Errors) > 0
+}
+
+// Header provides the header map of the recorded response.
+func (rec *responseRecorder) Header() http.Header {
+	return rec.header
+}
+
+// Write records the response body.
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	return rec.body.Write(b)
+}
+
+// WriteHeader records the response status code.
+func (rec *responseRecorder) WriteHeader(status int) {
+	rec.status = status
+}
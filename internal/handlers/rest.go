@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fantom-api-graphql/internal/logger"
 	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/svc"
 	"fantom-api-graphql/internal/types"
 	"io"
 	"net/http"
@@ -66,3 +67,17 @@ func ValidatorsDownHandler(log logger.Logger) http.Handler {
 		}
 	})
 }
+
+// ServicesStatus constructs and return the REST API HTTP handler for the state
+// of the blockchain data processing services.
+func ServicesStatus(log logger.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(svc.Manager().Status())
+		if err != nil {
+			log.Criticalf("can not encode services status; %s", err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+}
@@ -0,0 +1,54 @@
+// Package metrics implements Prometheus metrics of the API server
+// indexing services, and the RPC, database and cache bridges.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// apiComplexity represents the static complexity of the accepted GraphQL queries.
+	apiComplexity = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "query_complexity",
+		Help:      "The static complexity of the accepted GraphQL queries.",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+	})
+
+	// apiRejected represents the number of refused GraphQL queries by the reason.
+	apiRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "rejected_total",
+		Help:      "The number of refused GraphQL queries by the reason.",
+	}, []string{"reason"})
+
+	// apiCache represents the number of GraphQL queries looked up in the responses cache by the result.
+	apiCache = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "cache_requests_total",
+		Help:      "The number of GraphQL queries looked up in the responses cache by the result.",
+	}, []string{"result"})
+)
+
+// ObserveApiQuery collects the complexity of an accepted GraphQL query.
+func ObserveApiQuery(complexity int64) {
+	apiComplexity.Observe(float64(complexity))
+}
+
+// ObserveApiRejected collects a GraphQL query refused for the given reason.
+func ObserveApiRejected(reason string) {
+	apiRejected.WithLabelValues(reason).Inc()
+}
+
+// ObserveApiCache collects a look-up of a GraphQL query in the responses cache.
+func ObserveApiCache(hit bool) {
+	if hit {
+		apiCache.WithLabelValues("hit").Inc()
+		return
+	}
+	apiCache.WithLabelValues("miss").Inc()
+}
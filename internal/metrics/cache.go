@@ -0,0 +1,45 @@
+// Package metrics implements Prometheus metrics of the API server
+// indexing services, and the RPC, database and cache bridges.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RegisterCache registers the hits, misses and hit ratio metrics of the in-memory cache.
+// The counters are collected by the given function on each scrape; a repeated registration
+// replaces the previous one.
+func RegisterCache(stats func() (hits int64, misses int64)) {
+	register(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "hits_total",
+			Help:      "The number of cache look-ups finding the key.",
+		}, func() float64 {
+			hits, _ := stats()
+			return float64(hits)
+		}),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "misses_total",
+			Help:      "The number of cache look-ups not finding the key.",
+		}, func() float64 {
+			_, misses := stats()
+			return float64(misses)
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "hit_ratio",
+			Help:      "The ratio of cache look-ups finding the key.",
+		}, func() float64 {
+			hits, misses := stats()
+			if hits+misses == 0 {
+				return 0
+			}
+			return float64(hits) / float64(hits+misses)
+		}),
+	)
+}
@@ -0,0 +1,36 @@
+// Package metrics implements Prometheus metrics of the API server
+// indexing services, and the RPC, database and cache bridges.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+var (
+	// dbDuration represents the latency of the database operations by collection and command.
+	dbDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "operation_duration_seconds",
+		Help:      "The latency of the database operations by collection and command.",
+		Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+	}, []string{"collection", "command"})
+
+	// dbErrors represents the number of failed database operations by collection and command.
+	dbErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "operation_errors_total",
+		Help:      "The number of failed database operations by collection and command.",
+	}, []string{"collection", "command"})
+)
+
+// ObserveDbOperation collects the latency and the result of a database operation.
+func ObserveDbOperation(collection string, command string, duration time.Duration, failed bool) {
+	dbDuration.WithLabelValues(collection, command).Observe(duration.Seconds())
+	if failed {
+		dbErrors.WithLabelValues(collection, command).Inc()
+	}
+}
@@ -0,0 +1,37 @@
+// Package metrics implements Prometheus metrics of the API server
+// indexing services, and the RPC, database and cache bridges.
+package metrics
+
+import (
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+)
+
+// namespace represents the common prefix of all the API server metrics.
+const namespace = "ftm_api"
+
+// Handler provides the HTTP handler exposing collected metrics
+// in the Prometheus text exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// register registers the given collectors with the default registry.
+// A collector registered already is replaced, so the metrics of a re-created
+// component are collected from the new instance instead of causing a panic.
+func register(cs ...prometheus.Collector) {
+	for _, c := range cs {
+		err := prometheus.Register(c)
+
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			prometheus.Unregister(are.ExistingCollector)
+			err = prometheus.Register(c)
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+}
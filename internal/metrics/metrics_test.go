@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"testing"
+)
+
+func TestRegisterRepeated(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// repeated registrations must not panic
+	RegisterQueue("test", func() int { return 1 })
+	RegisterQueue("test", func() int { return 2 })
+	RegisterCache(func() (int64, int64) { return 1, 1 })
+	RegisterCache(func() (int64, int64) { return 3, 1 })
+
+	mfs, err := prometheus.DefaultGatherer.Gather()
+	g.Expect(err).To(gomega.BeNil())
+
+	values := make(map[string]float64)
+	for _, mf := range mfs {
+		for _, m := range mf.GetMetric() {
+			switch {
+			case m.GetGauge() != nil:
+				values[mf.GetName()] = m.GetGauge().GetValue()
+			case m.GetCounter() != nil:
+				values[mf.GetName()] = m.GetCounter().GetValue()
+			}
+		}
+	}
+
+	// the latest registration is collected
+	g.Expect(values["ftm_api_svc_queue_depth"]).To(gomega.Equal(2.0))
+	g.Expect(values["ftm_api_cache_hits_total"]).To(gomega.Equal(3.0))
+	g.Expect(values["ftm_api_cache_hit_ratio"]).To(gomega.Equal(0.75))
+}
@@ -0,0 +1,85 @@
+// Package metrics implements Prometheus metrics of the API server
+// indexing services, and the RPC, database and cache bridges.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+var (
+	// rpcDuration represents the latency of the RPC calls by method.
+	rpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "call_duration_seconds",
+		Help:      "The latency of the node RPC calls by method.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+	}, []string{"method"})
+
+	// rpcErrors represents the number of failed RPC calls by method.
+	rpcErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "call_errors_total",
+		Help:      "The number of failed node RPC calls by method.",
+	}, []string{"method"})
+
+	// rpcUpstreamHeight represents the block height of the upstream nodes.
+	rpcUpstreamHeight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "upstream_height",
+		Help:      "The block height reported by the upstream node.",
+	}, []string{"upstream"})
+
+	// rpcUpstreamLag represents the number of blocks the upstream nodes are behind the pool head.
+	rpcUpstreamLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "upstream_lag",
+		Help:      "The number of blocks the upstream node is behind the pool head.",
+	}, []string{"upstream"})
+
+	// rpcUpstreamHealthy represents the health status of the upstream nodes.
+	rpcUpstreamHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "upstream_healthy",
+		Help:      "The health of the upstream node; 1 if the node is used, 0 otherwise.",
+	}, []string{"upstream"})
+
+	// rpcUpstreamFailovers represents the number of calls and subscriptions moved away from the upstream nodes.
+	rpcUpstreamFailovers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "upstream_failovers_total",
+		Help:      "The number of calls and subscriptions moved away from the failed upstream node.",
+	}, []string{"upstream"})
+)
+
+// ObserveRpcUpstream collects the health check result of an upstream node.
+func ObserveRpcUpstream(name string, height uint64, lag uint64, healthy bool) {
+	rpcUpstreamHeight.WithLabelValues(name).Set(float64(height))
+	rpcUpstreamLag.WithLabelValues(name).Set(float64(lag))
+
+	var h float64
+	if healthy {
+		h = 1
+	}
+	rpcUpstreamHealthy.WithLabelValues(name).Set(h)
+}
+
+// ObserveRpcFailover collects a call, or a subscription moved away from the failed upstream node.
+func ObserveRpcFailover(name string) {
+	rpcUpstreamFailovers.WithLabelValues(name).Inc()
+}
+
+// ObserveRpcCall collects the latency and the result of a node RPC call.
+func ObserveRpcCall(method string, start time.Time, err error) {
+	rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		rpcErrors.WithLabelValues(method).Inc()
+	}
+}
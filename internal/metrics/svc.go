@@ -0,0 +1,86 @@
+// Package metrics implements Prometheus metrics of the API server
+// indexing services, and the RPC, database and cache bridges.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+var (
+	// scannerBlock represents the position of the block scanner.
+	scannerBlock = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scanner",
+		Name:      "block",
+		Help:      "The number of the next block to be scanned.",
+	})
+
+	// scannerHead represents the head of the chain as seen by the block scanner.
+	scannerHead = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scanner",
+		Name:      "head",
+		Help:      "The number of the current head block of the chain.",
+	})
+
+	// scannerDispatched represents the last block dispatched to processing.
+	scannerDispatched = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scanner",
+		Name:      "dispatched",
+		Help:      "The number of the last block dispatched to processing.",
+	})
+
+	// scannerLag represents the distance between the chain head and the last dispatched block.
+	scannerLag = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scanner",
+		Name:      "lag",
+		Help:      "The number of blocks the processing is behind the chain head.",
+	})
+
+	// logHandlerDuration represents the latency of the log handlers by topic.
+	logHandlerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "logs",
+		Name:      "handler_duration_seconds",
+		Help:      "The processing time of log records by the event topic.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+	}, []string{"topic"})
+)
+
+// ObserveScanner updates the block scanner position metrics.
+func ObserveScanner(next uint64, head uint64, dispatched uint64) {
+	scannerBlock.Set(float64(next))
+	scannerHead.Set(float64(head))
+	scannerDispatched.Set(float64(dispatched))
+
+	lag := float64(0)
+	if head > dispatched {
+		lag = float64(head - dispatched)
+	}
+	scannerLag.Set(lag)
+}
+
+// ObserveLogHandler collects the count and the latency of a log record processed
+// by the handler of the given topic.
+func ObserveLogHandler(topic string, start time.Time) {
+	logHandlerDuration.WithLabelValues(topic).Observe(time.Since(start).Seconds())
+}
+
+// RegisterQueue registers a gauge of the depth of a service queue.
+// The depth is collected by the given function on each scrape; a repeated registration
+// of the same queue replaces the previous one.
+func RegisterQueue(name string, depth func() int) {
+	register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Subsystem:   "svc",
+		Name:        "queue_depth",
+		Help:        "The number of items waiting in the service queue.",
+		ConstLabels: prometheus.Labels{"queue": name},
+	}, func() float64 {
+		return float64(depth())
+	}))
+}
@@ -66,6 +66,59 @@ func (p *proxy) getAccount(addr *common.Address) (*types.Account, error) {
 	return acc, nil
 }
 
+// Accounts returns accounts at Opera blockchain for the given addresses using a constant number
+// of cache and database round trips; the result is keyed by the account address.
+func (p *proxy) Accounts(addrs []common.Address) (map[common.Address]*types.Account, error) {
+	// try to get the accounts from cache
+	list := p.cache.PullAccounts(addrs)
+	if len(list) == len(addrs) {
+		return list, nil
+	}
+
+	// collect addresses missing in the cache
+	missing := make([]common.Address, 0, len(addrs)-len(list))
+	for _, addr := range addrs {
+		if _, ok := list[addr]; !ok {
+			missing = append(missing, addr)
+		}
+	}
+
+	// try to get the accounts from database
+	known, err := p.db.Accounts(missing)
+	if err != nil {
+		p.log.Errorf("can not get %d accounts; %s", len(missing), err.Error())
+		return nil, err
+	}
+	for _, acc := range known {
+		list[acc.Address] = acc
+	}
+
+	// unknown accounts are wallets, unless they are smart contracts
+	unknown := make([]common.Address, 0, len(missing)-len(known))
+	for _, addr := range missing {
+		if _, ok := list[addr]; !ok {
+			unknown = append(unknown, addr)
+		}
+	}
+	if len(unknown) > 0 {
+		p.log.Debugf("%d unknown addresses detected", len(unknown))
+
+		// we log the error on the call
+		sc, _ := p.db.ContractTransactions(unknown)
+		for _, addr := range unknown {
+			list[addr] = &types.Account{Address: addr, Type: types.AccountTypeWallet, ContractTx: sc[addr]}
+		}
+	}
+
+	// also keep a copy at the in-memory cache
+	for _, addr := range missing {
+		if err = p.cache.PushAccount(list[addr]); err != nil {
+			p.log.Warningf("can not keep account [%s] information in memory; %s", addr.Hex(), err.Error())
+		}
+	}
+	return list, nil
+}
+
 // AccountBalance returns the current balance of an account at Opera blockchain.
 func (p *proxy) AccountBalance(addr *common.Address) (*hexutil.Big, error) {
 	return p.rpc.AccountBalance(addr)
@@ -77,14 +130,14 @@ func (p *proxy) AccountNonce(addr *common.Address) (*hexutil.Uint64, error) {
 }
 
 // AccountTransactions returns slice of AccountTransaction structure for a given account at Opera blockchain.
-func (p *proxy) AccountTransactions(addr *common.Address, rec *common.Address, cursor *string, count int32) (*types.TransactionList, error) {
+func (p *proxy) AccountTransactions(addr *common.Address, rec *common.Address, tf *types.TransactionFilter, cursor *string, count int32) (*types.TransactionList, error) {
 	// do we have an account?
 	if addr == nil {
 		return nil, fmt.Errorf("can not get transaction list for empty account")
 	}
 
 	// go to the database for the list of hashes of transaction searched
-	return p.db.AccountTransactions(addr, rec, cursor, count)
+	return p.db.AccountTransactions(addr, rec, tf, cursor, count)
 }
 
 // AccountsActive returns total number of accounts known to repository.
@@ -129,3 +182,8 @@ func (p *proxy) StoreAccount(acc *types.Account) error {
 func (p *proxy) AccountMarkActivity(addr *common.Address, ts uint64) error {
 	return p.db.AccountMarkActivity(addr, ts)
 }
+
+// EvictAccount removes the account of the given address from the in-memory cache.
+func (p *proxy) EvictAccount(addr *common.Address) {
+	p.cache.EvictAccount(addr)
+}
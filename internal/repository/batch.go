@@ -0,0 +1,119 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/repository/db"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"sync"
+)
+
+// BlockBatch collects the persistent storage writes of a processed block,
+// so they can be committed together with the last known block checkpoint.
+type BlockBatch struct {
+	p     *proxy
+	batch *db.BlockBatch
+	mu    sync.Mutex
+	trx   []*types.Transaction
+	acc   []common.Address
+	burn  *types.FtmBurn
+}
+
+// NewBlockBatch creates a new batch of persistent storage writes of the given block.
+func (p *proxy) NewBlockBatch(blk *types.Block) *BlockBatch {
+	return &BlockBatch{
+		p:     p,
+		batch: p.db.NewBlockBatch(blk),
+		trx:   make([]*types.Transaction, 0),
+		acc:   make([]common.Address, 0),
+	}
+}
+
+// StoreTransaction queues the given transaction to be stored.
+// The transaction is written with the batch commit; there is nothing to fail here.
+func (b *BlockBatch) StoreTransaction(trx *types.Transaction) {
+	b.batch.AddTransaction(trx)
+
+	b.mu.Lock()
+	b.trx = append(b.trx, trx)
+	b.mu.Unlock()
+}
+
+// StoreAccount queues the given new account to be stored. The account is marked as known right away,
+// so any following activity of the account in the block is queued as an update.
+func (b *BlockBatch) StoreAccount(acc *types.Account) error {
+	b.batch.AddAccount(acc)
+	b.p.cache.PushAccountKnown(&acc.Address)
+
+	b.mu.Lock()
+	b.acc = append(b.acc, acc.Address)
+	b.mu.Unlock()
+	return nil
+}
+
+// AccountMarkActivity queues the latest account activity update.
+func (b *BlockBatch) AccountMarkActivity(addr *common.Address, ts uint64) error {
+	b.batch.AccountMarkActivity(addr, ts)
+	return nil
+}
+
+// StoreTokenTransaction queues the given token transaction to be stored.
+func (b *BlockBatch) StoreTokenTransaction(trx *types.TokenTransaction) error {
+	b.batch.AddERC20Transaction(trx)
+	return nil
+}
+
+// StoreRewardClaim queues the given reward claim to be stored.
+func (b *BlockBatch) StoreRewardClaim(rc *types.RewardClaim) error {
+	b.batch.AddRewardClaim(rc)
+	return nil
+}
+
+// FtmBurn provides the native FTM burn record of the block collected so far; nil if none.
+func (b *BlockBatch) FtmBurn() *types.FtmBurn {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.burn
+}
+
+// StoreFtmBurn sets the native FTM burn record of the block.
+func (b *BlockBatch) StoreFtmBurn(burn *types.FtmBurn) error {
+	b.batch.StoreBurn(burn)
+
+	b.mu.Lock()
+	b.burn = burn
+	b.mu.Unlock()
+	return nil
+}
+
+// Commit writes the batch into the persistent storage and advances the last known block.
+// The in-memory cache is updated with the committed data on success; new accounts marked
+// as known by the batch are dropped from the cache on failure.
+func (b *BlockBatch) Commit() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.batch.Commit(); err != nil {
+		for i := range b.acc {
+			b.p.cache.EvictAccount(&b.acc[i])
+		}
+		return err
+	}
+
+	for _, trx := range b.trx {
+		b.p.cache.AddTransaction(trx)
+	}
+	b.p.IncTrxCountEstimate(uint64(len(b.trx)))
+
+	if b.burn != nil {
+		b.p.cache.FtmBurnUpdate(b.burn, b.p.db.BurnTotal)
+	}
+	return nil
+}
@@ -34,6 +34,11 @@ func (p *proxy) BlockHeight() (*hexutil.Big, error) {
 	return p.rpc.BlockHeight()
 }
 
+// UpstreamNodes provides the status of the upstream nodes of the node pool.
+func (p *proxy) UpstreamNodes() []types.UpstreamStatus {
+	return p.rpc.Upstreams()
+}
+
 // LastKnownBlock returns number of the last block known to the repository.
 func (p *proxy) LastKnownBlock() (uint64, error) {
 	return p.db.LastKnownBlock()
@@ -44,6 +49,51 @@ func (p *proxy) UpdateLastKnownBlock(blockNo *hexutil.Uint64) error {
 	return p.db.UpdateLastKnownBlock(blockNo)
 }
 
+// StoreBlock adds the given processed block into the blocks' registry.
+func (p *proxy) StoreBlock(blk *types.Block) error {
+	return p.db.AddBlock(blk)
+}
+
+// KnownBlockHash returns the hash of the processed block at the given height.
+// Nil is returned if the block has not been processed yet.
+func (p *proxy) KnownBlockHash(num uint64) (*common.Hash, error) {
+	return p.db.BlockHash(num)
+}
+
+// LoadBlock returns a block at Opera blockchain represented by a number
+// loaded directly from the node, bypassing the in-memory cache.
+func (p *proxy) LoadBlock(num *hexutil.Uint64) (*types.Block, error) {
+	tag := num.String()
+	return p.blockByTag(&tag)
+}
+
+// RevertBlocks removes data of all the processed blocks starting at the given block number.
+// It's used to roll back blocks orphaned by a chain reorganization.
+func (p *proxy) RevertBlocks(from uint64) error {
+	top, err := p.db.TopBlockNumber()
+	if err != nil {
+		return err
+	}
+
+	// remove the data from the persistent storage
+	trx, err := p.db.RevertBlocks(from)
+	if err != nil {
+		return err
+	}
+
+	// drop everything we may still keep in the in-memory cache
+	for i := from; i <= top; i++ {
+		p.cache.EvictBlock(hexutil.EncodeUint64(i))
+	}
+	for i := range trx {
+		p.cache.EvictTransaction(&trx[i])
+	}
+	p.cache.ResetRecent()
+
+	p.log.Noticef("blocks #%d to #%d reverted, %d transactions removed", from, top, len(trx))
+	return nil
+}
+
 // CacheBlock puts a block to the internal block cache.
 func (p *proxy) CacheBlock(blk *types.Block) {
 	p.cache.AddBlock(blk)
@@ -61,6 +111,51 @@ func (p *proxy) BlockByNumber(num *hexutil.Uint64) (*types.Block, error) {
 	return p.getBlock(num.String(), p.blockByTag)
 }
 
+// BlocksByNumber returns blocks at Opera blockchain represented by the given numbers using
+// a constant number of cache and node round trips; the result is keyed by the block number.
+// Blocks not found in the blockchain are not included in the result.
+func (p *proxy) BlocksByNumber(nums []hexutil.Uint64) (map[hexutil.Uint64]*types.Block, error) {
+	// try to use the in-memory cache
+	keys := make([]string, len(nums))
+	for i, num := range nums {
+		keys[i] = num.String()
+	}
+	cached := p.cache.PullBlocks(keys)
+
+	list := make(map[hexutil.Uint64]*types.Block, len(nums))
+	missing := make([]hexutil.Uint64, 0, len(nums)-len(cached))
+	for i, num := range nums {
+		if blk, ok := cached[keys[i]]; ok {
+			list[num] = blk
+			continue
+		}
+		missing = append(missing, num)
+	}
+	if len(missing) == 0 {
+		return list, nil
+	}
+
+	// extract the missing blocks from the chain
+	blocks, err := p.rpc.Blocks(missing)
+	if err != nil {
+		p.log.Errorf("can not load %d blocks; %s", len(missing), err.Error())
+		return nil, err
+	}
+
+	for i, blk := range blocks {
+		if blk == nil {
+			continue
+		}
+		list[missing[i]] = blk
+
+		// try to store the block in cache for future use
+		if err := p.cache.PushBlock(missing[i].String(), blk); err != nil {
+			p.log.Errorf("can not cache; %s", err.Error())
+		}
+	}
+	return list, nil
+}
+
 // BlockByHash returns a block at Opera blockchain represented by a hash. Top block is returned if the hash
 // is not provided.
 // If the block is not found, ErrBlockNotFound error is returned.
@@ -59,8 +59,8 @@ func (p *proxy) StoreFtmBurn(burn *types.FtmBurn) error {
 	return p.db.StoreBurn(burn)
 }
 
-// FtmBurnTotal provides the total amount of burned native FTM.
-func (p *proxy) FtmBurnTotal() (int64, error) {
+// FtmBurnTotal provides the total amount of burned native FTM in WEI.
+func (p *proxy) FtmBurnTotal() (*big.Int, error) {
 	return p.cache.FtmBurnTotal(p.db.BurnTotal)
 }
 
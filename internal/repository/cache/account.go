@@ -4,6 +4,7 @@ package cache
 import (
 	"fantom-api-graphql/internal/types"
 	"fmt"
+	"github.com/allegro/bigcache"
 	"github.com/ethereum/go-ethereum/common"
 	"strings"
 )
@@ -40,6 +41,18 @@ func (b *MemBridge) PullAccount(addr *common.Address) *types.Account {
 	return acc
 }
 
+// PullAccounts extracts accounts of the given addresses from the in-memory cache.
+// Accounts not available in the cache are not included in the result.
+func (b *MemBridge) PullAccounts(addrs []common.Address) map[common.Address]*types.Account {
+	list := make(map[common.Address]*types.Account, len(addrs))
+	for i := range addrs {
+		if acc := b.PullAccount(&addrs[i]); acc != nil {
+			list[addrs[i]] = acc
+		}
+	}
+	return list
+}
+
 // PushAccount stores provided account in the in-memory cache.
 func (b *MemBridge) PushAccount(acc *types.Account) error {
 	// we need valid account
@@ -80,3 +93,21 @@ func (b *MemBridge) PushAccountKnown(addr *common.Address) {
 		b.log.Errorf("can not cache account %s existence; %s", addr.String(), err.Error())
 	}
 }
+
+// EvictAccount makes sure the account of the given address
+// is not kept in the cache, including its known state.
+func (b *MemBridge) EvictAccount(addr *common.Address) {
+	// make sure the request makes sense
+	if nil == addr {
+		b.log.Errorf("can not evict empty account")
+		return
+	}
+
+	// delete the records, if there are any
+	for _, key := range []string{accountId(addr), addr.Hex()} {
+		err := b.cache.Delete(key)
+		if err != nil && err != bigcache.ErrEntryNotFound {
+			b.log.Criticalf("cache error %s", err.Error())
+		}
+	}
+}
@@ -25,3 +25,9 @@ func (b *MemBridge) ListBlocks(length int) []*types.Block {
 	}
 	return out
 }
+
+// ResetRecent drops the recent blocks and transactions kept in the in-memory rings.
+func (b *MemBridge) ResetRecent() {
+	b.blkRing.Reset()
+	b.trxRing.Reset()
+}
@@ -4,6 +4,7 @@ package cache
 import (
 	"fantom-api-graphql/internal/types"
 	"fmt"
+	"github.com/allegro/bigcache"
 )
 
 // PullBlock extracts block information from the in-memory cache if available.
@@ -25,6 +26,18 @@ func (b *MemBridge) PullBlock(key string) *types.Block {
 	return blk
 }
 
+// PullBlocks extracts blocks stored under the given keys from the in-memory cache.
+// Blocks not available in the cache are not included in the result.
+func (b *MemBridge) PullBlocks(keys []string) map[string]*types.Block {
+	list := make(map[string]*types.Block, len(keys))
+	for _, key := range keys {
+		if blk := b.PullBlock(key); blk != nil {
+			list[key] = blk
+		}
+	}
+	return list
+}
+
 // PushBlock stores provided block in the in-memory cache.
 func (b *MemBridge) PushBlock(key string, blk *types.Block) error {
 	// we need valid account
@@ -42,3 +55,10 @@ func (b *MemBridge) PushBlock(key string, blk *types.Block) error {
 	// set the data to cache by block number
 	return b.cache.Set(key, data)
 }
+
+// EvictBlock removes the block stored under the given key from the in-memory cache.
+func (b *MemBridge) EvictBlock(key string) {
+	if err := b.cache.Delete(key); err != nil && err != bigcache.ErrEntryNotFound {
+		b.log.Errorf("can not evict block %s; %s", key, err.Error())
+	}
+}
@@ -4,6 +4,7 @@ package cache
 import (
 	"fantom-api-graphql/internal/config"
 	"fantom-api-graphql/internal/logger"
+	"fantom-api-graphql/internal/metrics"
 	"fantom-api-graphql/internal/repository/cache/ring"
 	"github.com/allegro/bigcache"
 	"time"
@@ -36,6 +37,12 @@ func New(cfg *config.Config, log logger.Logger) (*MemBridge, error) {
 		return nil, err
 	}
 
+	// collect the cache efficiency
+	metrics.RegisterCache(func() (int64, int64) {
+		st := c.Stats()
+		return st.Hits, st.Misses
+	})
+
 	// log the event
 	log.Notice("memory cache initialized")
 
@@ -4,7 +4,8 @@ package cache
 import (
 	"fantom-api-graphql/internal/types"
 	"fmt"
-	"sync/atomic"
+	"math/big"
+	"sync"
 )
 
 // burnedTotalReloadBlockPeriod represents the number of blocks pass before burned total is refreshed from the database.
@@ -12,27 +13,29 @@ const burnedTotalReloadBlockPeriod = 1200
 
 // burnTotalContainer represents a container for collecting total burned amount used to speed up resolving the total.
 type burnTotalContainer struct {
+	mu       sync.RWMutex
 	block    uint64
-	value    int64
+	value    *big.Int
 	nextLoad uint64
 }
 
 // burnContainer is the in-memory container for burned total amount.
 var burnContainer *burnTotalContainer
 
-// FtmBurnTotal returns the current amount of total burned FTM.
-func (b *MemBridge) FtmBurnTotal(loader func() (int64, error)) (int64, error) {
+// FtmBurnTotal returns the current amount of total burned FTM in WEI.
+func (b *MemBridge) FtmBurnTotal(loader func() (*big.Int, error)) (*big.Int, error) {
 	// we may not have the reference yet
 	if burnContainer == nil {
 		return loader()
 	}
 
-	v := atomic.LoadInt64(&burnContainer.value)
-	return v, nil
+	burnContainer.mu.RLock()
+	defer burnContainer.mu.RUnlock()
+	return new(big.Int).Set(burnContainer.value), nil
 }
 
 // FtmBurnUpdate updates in-memory value of the burned FTMs.
-func (b *MemBridge) FtmBurnUpdate(burn *types.FtmBurn, loader func() (int64, error)) {
+func (b *MemBridge) FtmBurnUpdate(burn *types.FtmBurn, loader func() (*big.Int, error)) {
 	// make sure we have the container properly loaded and fresh
 	if burnContainer == nil || (burnContainer != nil && burnContainer.nextLoad <= uint64(burn.BlockNumber)) {
 		err := b.refreshBurnUpdate(burn, loader)
@@ -42,6 +45,9 @@ func (b *MemBridge) FtmBurnUpdate(burn *types.FtmBurn, loader func() (int64, err
 		}
 	}
 
+	burnContainer.mu.Lock()
+	defer burnContainer.mu.Unlock()
+
 	// new burn received?
 	if uint64(burn.BlockNumber) <= burnContainer.block {
 		return
@@ -49,12 +55,11 @@ func (b *MemBridge) FtmBurnUpdate(burn *types.FtmBurn, loader func() (int64, err
 
 	// update the value we keep
 	burnContainer.block = uint64(burn.BlockNumber)
-	v := atomic.LoadInt64(&burnContainer.value)
-	atomic.StoreInt64(&burnContainer.value, v+burn.Value())
+	burnContainer.value = new(big.Int).Add(burnContainer.value, burn.BurnAmount.ToInt())
 }
 
 // FtmBurnUpdate updates in-memory value of the burned FTMs.
-func (b *MemBridge) refreshBurnUpdate(burn *types.FtmBurn, loader func() (int64, error)) error {
+func (b *MemBridge) refreshBurnUpdate(burn *types.FtmBurn, loader func() (*big.Int, error)) error {
 	if burn.BlockNumber == 0 {
 		return fmt.Errorf("zero block can not be used to load burns")
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fantom-api-graphql/internal/types"
 	"fmt"
+	"github.com/allegro/bigcache"
 	"github.com/ethereum/go-ethereum/common"
 	"strings"
 )
@@ -94,3 +95,21 @@ func (b *MemBridge) PushErc721Contract(tok *types.Erc721Contract) error {
 	}
 	return b.cache.Set(ErcTokenId(&tok.Address, Erc721CacheIdPrefix), data)
 }
+
+// EvictErcToken makes sure ERC token contract details of the given address
+// are not kept in the cache.
+func (b *MemBridge) EvictErcToken(addr *common.Address) {
+	// make sure the request makes sense
+	if nil == addr {
+		b.log.Errorf("can not evict empty token")
+		return
+	}
+
+	// delete the records, if there are any
+	for _, prefix := range []string{Erc20CacheIdPrefix, Erc721CacheIdPrefix} {
+		err := b.cache.Delete(ErcTokenId(addr, prefix))
+		if err != nil && err != bigcache.ErrEntryNotFound {
+			b.log.Criticalf("cache error %s", err.Error())
+		}
+	}
+}
@@ -34,6 +34,18 @@ func (b *MemBridge) PullStakerInfo(id *hexutil.Big) *types.StakerInfo {
 	return sti
 }
 
+// PullStakerInfos extracts information of the given stakers from the in-memory cache.
+// Stakers without information available in the cache are not included in the result.
+func (b *MemBridge) PullStakerInfos(ids []hexutil.Big) map[string]*types.StakerInfo {
+	list := make(map[string]*types.StakerInfo, len(ids))
+	for i := range ids {
+		if sti := b.PullStakerInfo(&ids[i]); sti != nil {
+			list[ids[i].String()] = sti
+		}
+	}
+	return list
+}
+
 // PushStakerInfo stores provided staker information in the in-memory cache.
 func (b *MemBridge) PushStakerInfo(id *hexutil.Big, sti *types.StakerInfo) error {
 	// encode account
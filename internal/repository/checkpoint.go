@@ -0,0 +1,32 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	etc "github.com/ethereum/go-ethereum/core/types"
+)
+
+// Checkpoints provides the map of the known ingestion checkpoints
+// of the data processors to their progress.
+func (p *proxy) Checkpoints() (map[string]types.Checkpoint, error) {
+	return p.db.Checkpoints()
+}
+
+// UpdateCheckpoint stores the progress of the given data processor.
+func (p *proxy) UpdateCheckpoint(name string, cp *types.Checkpoint) error {
+	return p.db.UpdateCheckpoint(name, cp)
+}
+
+// FilterLogs loads logs of the given block range matching any of the given event topics
+// directly from the node.
+func (p *proxy) FilterLogs(from uint64, to uint64, topics []common.Hash) ([]etc.Log, error) {
+	return p.rpc.FilterLogs(from, to, topics)
+}
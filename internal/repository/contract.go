@@ -9,9 +9,15 @@ results. BigCache for in-memory object storage to speed up loading of frequently
 package repository
 
 import (
+	"fantom-api-graphql/internal/solidity"
 	"fantom-api-graphql/internal/types"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
 )
 
 // Contract extract a smart contract information by account address, if available.
@@ -47,8 +53,89 @@ func (p *proxy) Contracts(validatedOnly bool, cursor *string, count int32) (*typ
 // ValidateContract tries to validate contract byte code using
 // provided source code. If successful, the contract information
 // is updated the repository.
-func (p *proxy) ValidateContract(_ *types.Contract) error {
-	return fmt.Errorf("contract validation not supported")
+func (p *proxy) ValidateContract(sc *types.Contract) error {
+	// get the deployed code of the contract
+	code, err := p.rpc.AccountCode(&sc.Address)
+	if err != nil {
+		return err
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("no contract code found at %s", sc.Address.String())
+	}
+
+	// compilation is expensive; refuse the validation if all the slots are taken
+	select {
+	case p.solSlots <- struct{}{}:
+		defer func() { <-p.solSlots }()
+	default:
+		return fmt.Errorf("contract validation is busy, please try again later")
+	}
+
+	// compile the source code with the requested compiler
+	solc, err := p.solCompilerPath(sc.Compiler)
+	if err != nil {
+		return err
+	}
+	ver, err := solidity.Version(solc)
+	if err != nil {
+		return err
+	}
+	list, err := solidity.Compile(solc, sc.SourceCode, sc.IsOptimized, sc.OptimizeRuns)
+	if err != nil {
+		return err
+	}
+
+	// find the compiled contract matching the deployed code; the named one goes first
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Name == sc.Name && list[j].Name != sc.Name
+	})
+	for _, c := range list {
+		if !c.Matches(code) {
+			continue
+		}
+
+		p.log.Noticef("contract %s validated as %s by solc %s", sc.Address.String(), c.Name, ver)
+		if sc.Name == "" {
+			sc.Name = c.Name
+		}
+		sc.Abi = c.Abi
+		sc.Compiler = fmt.Sprintf("Solidity %s", ver)
+		ts := hexutil.Uint64(time.Now().UTC().Unix())
+		sc.Validated = &ts
+		return p.StoreContract(sc)
+	}
+	return fmt.Errorf("source code does not match the code deployed at %s", sc.Address.String())
+}
+
+// compilationSlots returns the number of contract compilations allowed to run at once.
+func compilationSlots(max int) int {
+	if max < 1 {
+		return 1
+	}
+	return max
+}
+
+// solCompilerPath returns the path of the SOL compiler binary of the release requested
+// by the given compiler version. The default compiler is used if no release is requested.
+func (p *proxy) solCompilerPath(version string) (string, error) {
+	rel, ok := solidity.Release(version)
+	if !ok {
+		return p.solCompiler, nil
+	}
+
+	// is the default compiler of the requested release?
+	if ver, err := solidity.Version(p.solCompiler); err == nil {
+		if def, _ := solidity.Release(ver); def == rel {
+			return p.solCompiler, nil
+		}
+	}
+
+	// try the releases directory
+	path := filepath.Join(p.cfg.Compiler.SolReleasesPath, fmt.Sprintf("solc-%s", rel))
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("solidity compiler %s not available", rel)
+	}
+	return path, nil
 }
 
 // StoreContract adds new contract into the repository.
@@ -70,3 +157,8 @@ func (p *proxy) StoreContract(con *types.Contract) error {
 	}
 	return nil
 }
+
+// EvictContract removes the smart contract of the given address from the in-memory cache.
+func (p *proxy) EvictContract(addr *common.Address) {
+	p.cache.EvictContract(addr)
+}
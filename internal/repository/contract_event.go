@@ -0,0 +1,58 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"strings"
+)
+
+// StoreContractEvent stores the given decoded contract event in the persistent storage.
+func (p *proxy) StoreContractEvent(ce *types.ContractEvent) error {
+	return p.db.AddContractEvent(ce)
+}
+
+// ContractEvents provides list of decoded contract events filtered by the emitting contract,
+// name of the event and values of the event arguments. The default order is used if the sort is not given.
+func (p *proxy) ContractEvents(adr *common.Address, event *string, args []types.ContractEventArg, cursor *string, count int32, sort *types.ListSort) (*types.ContractEventList, error) {
+	filter := bson.D{}
+	if adr != nil {
+		filter = append(filter, bson.E{Key: types.FiContractEventAddress, Value: adr.String()})
+	}
+	if event != nil {
+		filter = append(filter, bson.E{Key: types.FiContractEventName, Value: *event})
+	}
+
+	// all the argument conditions must match
+	if len(args) > 0 {
+		match := make(bson.A, len(args))
+		for i, arg := range args {
+			match[i] = bson.D{{Key: types.FiContractEventArgs, Value: bson.D{{Key: "$elemMatch", Value: bson.D{
+				{Key: types.FiContractEventArgName, Value: arg.Name},
+				{Key: types.FiContractEventArgValue, Value: contractEventArgValue(arg.Value)},
+			}}}}}
+		}
+		filter = append(filter, bson.E{Key: "$and", Value: match})
+	}
+	return p.db.ContractEvents(cursor, count, &filter, sort)
+}
+
+// contractEventArgValue normalizes the given argument value
+// to the canonical form the values are stored in.
+func contractEventArgValue(val string) string {
+	if common.IsHexAddress(val) && len(val) == 2*common.AddressLength+2 {
+		return common.HexToAddress(val).String()
+	}
+	if strings.HasPrefix(val, "0x") || strings.HasPrefix(val, "0X") {
+		return strings.ToLower(val)
+	}
+	return val
+}
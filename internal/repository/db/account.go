@@ -97,6 +97,50 @@ func (db *MongoDbBridge) Account(addr *common.Address) (*types.Account, error) {
 	}, nil
 }
 
+// Accounts pulls the accounts of the given addresses from the database in a single query.
+// Accounts not known to the database are not included in the result.
+func (db *MongoDbBridge) Accounts(addrs []common.Address) ([]*types.Account, error) {
+	// get the collection for account transactions
+	col := db.client.Database(db.dbName).Collection(coAccounts)
+
+	// make the list of PKs to look for
+	ids := make(bson.A, len(addrs))
+	for i := range addrs {
+		ids[i] = addrs[i].String()
+	}
+
+	cursor, err := col.Find(context.Background(), bson.D{{Key: fiAccountPk, Value: bson.D{{Key: "$in", Value: ids}}}})
+	if err != nil {
+		db.log.Errorf("can not get accounts; %s", err.Error())
+		return nil, err
+	}
+	defer db.closeCursor(cursor)
+
+	list := make([]*types.Account, 0, len(addrs))
+	for cursor.Next(context.Background()) {
+		var row AccountRow
+		if err := cursor.Decode(&row); err != nil {
+			db.log.Errorf("can not decode account; %s", err.Error())
+			return nil, err
+		}
+
+		// any hash?
+		if row.Sc != nil {
+			h := common.HexToHash(*row.Sc)
+			row.ScHash = &h
+		}
+
+		list = append(list, &types.Account{
+			Address:      common.HexToAddress(row.Address),
+			ContractTx:   row.ScHash,
+			Type:         row.Type,
+			LastActivity: hexutil.Uint64(row.Activity),
+			TrxCounter:   hexutil.Uint64(row.Counter),
+		})
+	}
+	return list, nil
+}
+
 // AddAccount stores an account in the blockchain if not exists.
 func (db *MongoDbBridge) AddAccount(acc *types.Account) error {
 	// do we have account data?
@@ -170,7 +214,8 @@ func (db *MongoDbBridge) AccountCount() (uint64, error) {
 }
 
 // AccountTransactions loads list of transaction hashes of an account.
-func (db *MongoDbBridge) AccountTransactions(addr *common.Address, rec *common.Address, cursor *string, count int32) (*types.TransactionList, error) {
+// Optional filter narrows the list of the account transactions further.
+func (db *MongoDbBridge) AccountTransactions(addr *common.Address, rec *common.Address, tf *types.TransactionFilter, cursor *string, count int32) (*types.TransactionList, error) {
 	// nothing to load?
 	if count == 0 {
 		return nil, fmt.Errorf("nothing to do, zero blocks requested")
@@ -187,11 +232,17 @@ func (db *MongoDbBridge) AccountTransactions(addr *common.Address, rec *common.A
 	// make the filter for [(from = Account) OR (to = Account)]
 	if rec == nil {
 		filter := bson.D{{Key: "$or", Value: bson.A{bson.D{{Key: "from", Value: addr.String()}}, bson.D{{Key: "to", Value: addr.String()}}}}}
+		if tf != nil {
+			return db.Transactions(cursor, count, transactionFilter(tf, filter))
+		}
 		return db.Transactions(cursor, count, &filter)
 	}
 
 	// return list of transactions filtered by the account and recipient
 	filter := bson.D{{Key: "from", Value: addr.String()}, {Key: "to", Value: rec.String()}}
+	if tf != nil {
+		return db.Transactions(cursor, count, transactionFilter(tf, filter))
+	}
 	return db.Transactions(cursor, count, &filter)
 }
 
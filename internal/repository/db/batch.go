@@ -0,0 +1,297 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"errors"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// errCodeIllegalOperation is the error code of the server refusing session transactions,
+// i.e. a standalone server which is not a replica set member.
+const errCodeIllegalOperation = 20
+
+// BlockBatch collects database writes of a single processed block, so they can be committed together
+// using bulk writes. If the server supports session transactions, the writes are committed atomically
+// along with the last known block. Otherwise, the last known block is updated after all the other writes
+// are done, so an interrupted commit is repeated on the block re-scan.
+type BlockBatch struct {
+	db     *MongoDbBridge
+	block  *types.Block
+	mu     sync.Mutex
+	models map[string][]mongo.WriteModel
+	order  []string
+	burn   *types.FtmBurn
+}
+
+// NewBlockBatch creates a new batch of database writes of the given block.
+func (db *MongoDbBridge) NewBlockBatch(blk *types.Block) *BlockBatch {
+	return &BlockBatch{
+		db:     db,
+		block:  blk,
+		models: make(map[string][]mongo.WriteModel),
+		order:  make([]string, 0),
+	}
+}
+
+// add queues the given write model for the given collection.
+func (bb *BlockBatch) add(col string, wm mongo.WriteModel) {
+	bb.mu.Lock()
+	defer bb.mu.Unlock()
+
+	if _, ok := bb.models[col]; !ok {
+		bb.order = append(bb.order, col)
+	}
+	bb.models[col] = append(bb.models[col], wm)
+}
+
+// AddTransaction queues the given transaction to be stored.
+func (bb *BlockBatch) AddTransaction(trx *types.Transaction) {
+	bb.add(coTransactions, mongo.NewReplaceOneModel().
+		SetFilter(bson.D{{Key: fiTransactionPk, Value: trx.Hash.String()}}).
+		SetReplacement(trx).
+		SetUpsert(true))
+}
+
+// AddAccount queues the given account to be stored, if it does not exist,
+// and marks the account activity.
+func (bb *BlockBatch) AddAccount(acc *types.Account) {
+	var conTx *string
+	if acc.ContractTx != nil {
+		cx := acc.ContractTx.String()
+		conTx = &cx
+	}
+
+	bb.add(coAccounts, mongo.NewUpdateOneModel().
+		SetFilter(bson.D{{Key: fiAccountPk, Value: acc.Address.String()}}).
+		SetUpdate(bson.D{
+			{Key: "$setOnInsert", Value: bson.D{
+				{Key: fiScCreationTx, Value: conTx},
+				{Key: fiAccountType, Value: acc.Type},
+			}},
+			{Key: "$set", Value: bson.D{{Key: fiAccountLastActivity, Value: uint64(acc.LastActivity)}}},
+			{Key: "$inc", Value: bson.D{{Key: fiAccountTransactionCounter, Value: 1}}},
+		}).
+		SetUpsert(true))
+}
+
+// AccountMarkActivity queues the latest activity of the given known account.
+func (bb *BlockBatch) AccountMarkActivity(addr *common.Address, ts uint64) {
+	bb.add(coAccounts, mongo.NewUpdateOneModel().
+		SetFilter(bson.D{{Key: fiAccountPk, Value: addr.String()}}).
+		SetUpdate(bson.D{
+			{Key: "$set", Value: bson.D{{Key: fiAccountLastActivity, Value: ts}}},
+			{Key: "$inc", Value: bson.D{{Key: fiAccountTransactionCounter, Value: 1}}},
+		}))
+}
+
+// AddERC20Transaction queues the given token transaction to be stored.
+func (bb *BlockBatch) AddERC20Transaction(trx *types.TokenTransaction) {
+	bb.add(colErcTransactions, mongo.NewReplaceOneModel().
+		SetFilter(bson.D{{Key: types.FiTokenTransactionPk, Value: trx.Pk()}}).
+		SetReplacement(trx).
+		SetUpsert(true))
+}
+
+// AddRewardClaim queues the given reward claim to be stored.
+func (bb *BlockBatch) AddRewardClaim(rc *types.RewardClaim) {
+	bb.add(colRewards, mongo.NewReplaceOneModel().
+		SetFilter(bson.D{{Key: types.FiRewardClaimPk, Value: rc.Pk()}}).
+		SetReplacement(rc).
+		SetUpsert(true))
+}
+
+// StoreBurn sets the native FTM burn record of the block.
+func (bb *BlockBatch) StoreBurn(burn *types.FtmBurn) {
+	bb.mu.Lock()
+	bb.burn = burn
+	bb.mu.Unlock()
+}
+
+// Commit writes all the queued models into the database along with the block registry record
+// and the last known block number.
+func (bb *BlockBatch) Commit() error {
+	bb.mu.Lock()
+	defer bb.mu.Unlock()
+
+	start := time.Now()
+	if atomic.LoadInt32(&bb.db.noTxn) == 0 {
+		err := bb.commitTxn()
+		if err == nil || !isIllegalOperation(err) {
+			return bb.committed(start, err)
+		}
+
+		// the server does not support transactions; do not try again
+		atomic.StoreInt32(&bb.db.noTxn, 1)
+		bb.db.log.Noticef("database does not support session transactions, block writes are not atomic")
+	}
+	return bb.committed(start, bb.write(context.Background()))
+}
+
+// committed finalizes the commit of the batch.
+func (bb *BlockBatch) committed(start time.Time, err error) error {
+	if err != nil {
+		bb.db.log.Errorf("can not commit block #%d; %s", uint64(bb.block.Number), err.Error())
+		return err
+	}
+
+	bb.db.initCollections(bb.order)
+	if bb.burn != nil {
+		bb.db.initCollections([]string{colBurns})
+	}
+	bb.db.log.Debugf("block #%d committed in %s", uint64(bb.block.Number), time.Since(start).String())
+	return nil
+}
+
+// commitTxn writes all the queued models inside a session transaction.
+func (bb *BlockBatch) commitTxn() error {
+	sess, err := bb.db.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer sess.EndSession(context.Background())
+
+	_, err = sess.WithTransaction(context.Background(), func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, bb.write(sc)
+	})
+	return err
+}
+
+// write executes the bulk writes of the batch; the last known block is updated last.
+func (bb *BlockBatch) write(ctx context.Context) error {
+	database := bb.db.client.Database(bb.db.dbName)
+	for _, name := range bb.order {
+		if _, err := database.Collection(name).BulkWrite(ctx, bb.models[name], options.BulkWrite().SetOrdered(true)); err != nil {
+			return fmt.Errorf("%s write failed; %s", name, err.Error())
+		}
+	}
+
+	if err := bb.writeBurn(ctx); err != nil {
+		return err
+	}
+
+	// register the block
+	_, err := database.Collection(colBlocks).UpdateByID(ctx, uint64(bb.block.Number), bson.D{{Key: "$set", Value: bson.D{
+		{Key: fiBlockHash, Value: bb.block.Hash.String()},
+		{Key: fiBlockParent, Value: bb.block.ParentHash.String()},
+		{Key: fiBlockTimeStamp, Value: time.Unix(int64(bb.block.TimeStamp), 0)},
+	}}}, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("block registry write failed; %s", err.Error())
+	}
+
+	// advance the last known block
+	_, err = database.Collection(coConfiguration).UpdateByID(ctx, keyConfigLastKnownBlock, bson.D{{Key: "$set", Value: bson.D{
+		{Key: fiConfigPk, Value: keyConfigLastKnownBlock},
+		{Key: fiConfigValue, Value: bb.block.Number.String()},
+	}}}, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("last known block write failed; %s", err.Error())
+	}
+	return nil
+}
+
+// writeBurn stores the burn record of the block and adds the burned amount to the total, if it's a new record.
+func (bb *BlockBatch) writeBurn(ctx context.Context) error {
+	if bb.burn == nil {
+		return nil
+	}
+
+	database := bb.db.client.Database(bb.db.dbName)
+	re, err := database.Collection(colBurns).UpdateOne(ctx,
+		bson.D{{Key: "block", Value: bb.burn.BlockNumber}},
+		bson.D{{Key: "$set", Value: bb.burn}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("burn write failed; %s", err.Error())
+	}
+
+	if re.UpsertedCount > 0 {
+		val, err := bb.burn.Value()
+		if err != nil {
+			return fmt.Errorf("burned total invalid; %s", err.Error())
+		}
+
+		_, err = database.Collection(colBurnsAggregate).UpdateByID(ctx, burnBaseAggregateDate, bson.D{
+			{Key: "$inc", Value: bson.D{{Key: "amount", Value: val}}},
+		})
+		if err != nil {
+			return fmt.Errorf("burned total write failed; %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// initCollections makes sure the given collections are initialized, if they were empty on the server start.
+func (db *MongoDbBridge) initCollections(names []string) {
+	database := db.client.Database(db.dbName)
+	for _, name := range names {
+		switch name {
+		case coTransactions:
+			if db.initTransactions != nil {
+				db.initTransactions.Do(func() { db.initTransactionsCollection(database.Collection(name)); db.initTransactions = nil })
+			}
+		case coAccounts:
+			if db.initAccounts != nil {
+				db.initAccounts.Do(func() { db.initAccountsCollection(); db.initAccounts = nil })
+			}
+		case colErcTransactions:
+			if db.initErc20Trx != nil {
+				db.initErc20Trx.Do(func() { db.initErc20TrxCollection(database.Collection(name)); db.initErc20Trx = nil })
+			}
+		case colRewards:
+			if db.initRewards != nil {
+				db.initRewards.Do(func() { db.initRewardsCollection(database.Collection(name)); db.initRewards = nil })
+			}
+		case coContract:
+			if db.initContracts != nil {
+				db.initContracts.Do(func() { db.initContractsCollection(database.Collection(name)); db.initContracts = nil })
+			}
+		case coUniswap:
+			if db.initSwaps != nil {
+				db.initSwaps.Do(func() { db.initUniswapCollection(database.Collection(name)); db.initSwaps = nil })
+			}
+		case colDelegations:
+			if db.initDelegations != nil {
+				db.initDelegations.Do(func() { db.initDelegationCollection(database.Collection(name)); db.initDelegations = nil })
+			}
+		case colWithdrawals:
+			if db.initWithdrawals != nil {
+				db.initWithdrawals.Do(func() { db.initWithdrawalsCollection(database.Collection(name)); db.initWithdrawals = nil })
+			}
+		case colFMintTransactions:
+			if db.initFMintTrx != nil {
+				db.initFMintTrx.Do(func() { db.initFMintTrxCollection(database.Collection(name)); db.initFMintTrx = nil })
+			}
+		case colEpochs:
+			if db.initEpochs != nil {
+				db.initEpochs.Do(func() { db.initEpochsCollection(database.Collection(name)); db.initEpochs = nil })
+			}
+		case colGasPrice:
+			if db.initGasPrice != nil {
+				db.initGasPrice.Do(func() { db.initGasPriceCollection(database.Collection(name)); db.initGasPrice = nil })
+			}
+		case colBurns:
+			if db.initBurns != nil {
+				db.initBurns.Do(func() { db.initBurnsCollection(database.Collection(name)); db.initBurns = nil })
+			}
+		}
+	}
+}
+
+// isIllegalOperation checks if the given error is the server refusing the operation.
+func isIllegalOperation(err error) bool {
+	var ce mongo.CommandError
+	if errors.As(err, &ce) {
+		return ce.Code == errCodeIllegalOperation
+	}
+	return false
+}
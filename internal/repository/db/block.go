@@ -0,0 +1,174 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const (
+	// colBlocks represents the name of the processed blocks' registry collection.
+	colBlocks = "blocks"
+
+	// fiBlockPk is the name of the primary key field of the blocks' registry, it's the block number.
+	fiBlockPk = "_id"
+
+	// fiBlockHash is the name of the block hash field.
+	fiBlockHash = "hash"
+
+	// fiBlockParent is the name of the parent block hash field.
+	fiBlockParent = "parent"
+
+	// fiBlockTimeStamp is the name of the block time stamp field.
+	fiBlockTimeStamp = "stamp"
+)
+
+// blockRow represents a record of a processed block in the blocks' registry.
+type blockRow struct {
+	Number uint64    `bson:"_id"`
+	Hash   string    `bson:"hash"`
+	Parent string    `bson:"parent"`
+	Stamp  time.Time `bson:"stamp"`
+}
+
+// blocksIndexes provides a list of indexes expected to exist on the blocks' registry collection.
+func blocksIndexes() []mongo.IndexModel {
+	ix := make([]mongo.IndexModel, 1)
+
+	ixHash := "ix_hash"
+	ix[0] = mongo.IndexModel{Keys: bson.D{{Key: fiBlockHash, Value: 1}}, Options: &options.IndexOptions{
+		Name: &ixHash,
+	}}
+
+	return ix
+}
+
+// AddBlock stores the given processed block into the blocks' registry.
+// A block already known at the same height is replaced.
+func (db *MongoDbBridge) AddBlock(blk *types.Block) error {
+	if blk == nil {
+		return fmt.Errorf("can not add empty block")
+	}
+
+	col := db.client.Database(db.dbName).Collection(colBlocks)
+	_, err := col.UpdateByID(context.Background(), uint64(blk.Number), bson.D{{Key: "$set", Value: bson.D{
+		{Key: fiBlockHash, Value: blk.Hash.String()},
+		{Key: fiBlockParent, Value: blk.ParentHash.String()},
+		{Key: fiBlockTimeStamp, Value: time.Unix(int64(blk.TimeStamp), 0)},
+	}}}, options.Update().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("could not store block #%d; %s", uint64(blk.Number), err.Error())
+		return err
+	}
+	return nil
+}
+
+// BlockHash provides the hash of the processed block at the given height.
+// It returns nil if the block is not known to the registry.
+func (db *MongoDbBridge) BlockHash(num uint64) (*common.Hash, error) {
+	col := db.client.Database(db.dbName).Collection(colBlocks)
+
+	sr := col.FindOne(context.Background(), bson.D{{Key: fiBlockPk, Value: num}})
+	if sr.Err() != nil {
+		if sr.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		db.log.Errorf("could not load block #%d; %s", num, sr.Err().Error())
+		return nil, sr.Err()
+	}
+
+	var row blockRow
+	if err := sr.Decode(&row); err != nil {
+		db.log.Errorf("could not decode block #%d; %s", num, err.Error())
+		return nil, err
+	}
+
+	hash := common.HexToHash(row.Hash)
+	return &hash, nil
+}
+
+// TopBlockNumber provides the number of the highest block in the blocks' registry.
+func (db *MongoDbBridge) TopBlockNumber() (uint64, error) {
+	col := db.client.Database(db.dbName).Collection(colBlocks)
+
+	sr := col.FindOne(context.Background(), bson.D{}, options.FindOne().
+		SetSort(bson.D{{Key: fiBlockPk, Value: -1}}).
+		SetProjection(bson.D{{Key: fiBlockPk, Value: true}}))
+	if sr.Err() != nil {
+		if sr.Err() == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+		return 0, sr.Err()
+	}
+
+	var row blockRow
+	if err := sr.Decode(&row); err != nil {
+		return 0, err
+	}
+	return row.Number, nil
+}
+
+// BottomBlockNumber provides the number of the lowest block in the blocks' registry.
+// It returns nil if the registry is empty.
+func (db *MongoDbBridge) BottomBlockNumber() (*uint64, error) {
+	col := db.client.Database(db.dbName).Collection(colBlocks)
+
+	sr := col.FindOne(context.Background(), bson.D{}, options.FindOne().
+		SetSort(bson.D{{Key: fiBlockPk, Value: 1}}).
+		SetProjection(bson.D{{Key: fiBlockPk, Value: true}}))
+	if sr.Err() != nil {
+		if sr.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, sr.Err()
+	}
+
+	var row blockRow
+	if err := sr.Decode(&row); err != nil {
+		return nil, err
+	}
+	return &row.Number, nil
+}
+
+// BlockNumbers provides the numbers of the processed blocks in the range <from, to>, at most limit of them.
+// The numbers are sorted from the highest block if desc is set, from the lowest block otherwise.
+func (db *MongoDbBridge) BlockNumbers(from uint64, to uint64, limit int64, desc bool) ([]uint64, error) {
+	col := db.client.Database(db.dbName).Collection(colBlocks)
+	ctx := context.Background()
+
+	dir := 1
+	if desc {
+		dir = -1
+	}
+
+	ld, err := col.Find(ctx, bson.D{{Key: fiBlockPk, Value: bson.D{
+		{Key: "$gte", Value: from},
+		{Key: "$lte", Value: to},
+	}}}, options.Find().
+		SetSort(bson.D{{Key: fiBlockPk, Value: dir}}).
+		SetProjection(bson.D{{Key: fiBlockPk, Value: true}}).
+		SetLimit(limit))
+	if err != nil {
+		db.log.Errorf("could not load blocks <#%d, #%d>; %s", from, to, err.Error())
+		return nil, err
+	}
+	defer db.closeCursor(ld)
+
+	list := make([]uint64, 0, limit)
+	for ld.Next(ctx) {
+		var row blockRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("could not decode block; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, row.Number)
+	}
+	return list, ld.Err()
+}
@@ -39,6 +39,9 @@ type MongoDbBridge struct {
 	initEpochs       *sync.Once
 	initGasPrice     *sync.Once
 	initBurns        *sync.Once
+
+	// noTxn marks the server does not support session transactions
+	noTxn int32
 }
 
 // docListCountAggregationTimeout represents a max duration of DB query executed to calculate
@@ -73,6 +76,10 @@ func New(cfg *config.Config, log logger.Logger) (*MongoDbBridge, error) {
 
 	// check the state
 	db.updateDatabaseIndexes()
+	if err := db.migrateDatabase(); err != nil {
+		log.Criticalf("can not migrate the database; %s", err.Error())
+		return nil, err
+	}
 	db.CheckDatabaseInitState()
 	return db, nil
 }
@@ -83,7 +90,10 @@ func connectDb(cfg *config.Database) (*mongo.Client, error) {
 	ctx := context.Background()
 
 	// create new Mongo client
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.Url).SetRegistry(registry.DefaultRegistry()))
+	client, err := mongo.Connect(ctx, options.Client().
+		ApplyURI(cfg.Url).
+		SetRegistry(registry.DefaultRegistry()).
+		SetMonitor(newCommandMonitor()))
 	if err != nil {
 		return nil, err
 	}
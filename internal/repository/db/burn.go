@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"math"
+	"math/big"
 	"time"
 )
 
@@ -130,17 +132,22 @@ func (db *MongoDbBridge) StoreBurn(burn *types.FtmBurn) error {
 	}
 
 	if re.UpsertedCount > 0 {
-		db.burnAddBurnValue(burn.Value())
+		db.burnAddBurnValue(burn.BurnAmount.ToInt())
 	}
 	return nil
 }
 
-// burnAddBurnValue adds the given value to the total burned amount.
-func (db *MongoDbBridge) burnAddBurnValue(v int64) {
-	col := db.client.Database(db.dbName).Collection(colBurnsAggregate)
+// burnAddBurnValue adds the given WEI value to the total burned amount.
+func (db *MongoDbBridge) burnAddBurnValue(v *big.Int) {
+	d, err := types.DecimalFromBig(v)
+	if err != nil {
+		db.log.Criticalf("could not update burned total by %s; %s", v.String(), err.Error())
+		return
+	}
 
-	_, err := col.UpdateByID(context.Background(), burnBaseAggregateDate, bson.D{
-		{Key: "$inc", Value: bson.D{{Key: "amount", Value: v}}},
+	col := db.client.Database(db.dbName).Collection(colBurnsAggregate)
+	_, err = col.UpdateByID(context.Background(), burnBaseAggregateDate, bson.D{
+		{Key: "$inc", Value: bson.D{{Key: "amount", Value: d}}},
 	})
 	if err != nil {
 		db.log.Criticalf("could not update burned total; %s", err.Error())
@@ -153,7 +160,7 @@ func (db *MongoDbBridge) burnAddTotalAggregate() error {
 	_, err := col.UpdateByID(context.Background(), burnBaseAggregateDate, bson.D{
 		{Key: "$setOnInsert", Value: bson.D{
 			{Key: "_id", Value: burnBaseAggregateDate},
-			{Key: "amount", Value: int64(0)},
+			{Key: "amount", Value: types.DecimalZero},
 		}},
 	}, options.Update().SetUpsert(true))
 	return err
@@ -164,31 +171,31 @@ func (db *MongoDbBridge) BurnCount() (uint64, error) {
 	return db.EstimateCount(db.client.Database(db.dbName).Collection(colBurns))
 }
 
-// BurnTotal aggregates the total amount of burned fee across all blocks.
-func (db *MongoDbBridge) BurnTotal() (int64, error) {
+// BurnTotal provides the total amount of burned fee across all blocks in WEI.
+func (db *MongoDbBridge) BurnTotal() (*big.Int, error) {
 	col := db.client.Database(db.dbName).Collection(colBurnsAggregate)
 
 	sr := col.FindOne(context.Background(), bson.D{{Key: "_id", Value: burnBaseAggregateDate}})
 	if sr.Err() != nil {
 		db.log.Criticalf("could not get burned total; %s", sr.Err().Error())
 		if sr.Err() == mongo.ErrNoDocuments {
-			return 0, db.burnAddTotalAggregate()
+			return new(big.Int), db.burnAddTotalAggregate()
 		}
 
-		return 0, sr.Err()
+		return nil, sr.Err()
 	}
 
 	var out struct {
-		Amount int64 `bson:"amount"`
+		Amount primitive.Decimal128 `bson:"amount"`
 	}
 	if err := sr.Decode(&out); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return out.Amount, nil
+	return types.BigFromDecimal(out.Amount)
 }
 
-// BurnTotalSlow aggregates the total amount of burned fee across all blocks.
-func (db *MongoDbBridge) BurnTotalSlow() (int64, error) {
+// BurnTotalSlow aggregates the total amount of burned fee across all blocks in WEI.
+func (db *MongoDbBridge) BurnTotalSlow() (*big.Int, error) {
 	col := db.client.Database(db.dbName).Collection(colBurns)
 
 	// aggregate the total amount of burned native tokens
@@ -200,22 +207,22 @@ func (db *MongoDbBridge) BurnTotalSlow() (int64, error) {
 	})
 	if err != nil {
 		db.log.Errorf("can not collect total burned fee; %s", err.Error())
-		return 0, err
+		return nil, err
 	}
 
 	defer db.closeCursor(cr)
 	if !cr.Next(context.Background()) {
-		return 0, fmt.Errorf("burned fee aggregation failed")
+		return nil, fmt.Errorf("burned fee aggregation failed")
 	}
 
 	var row struct {
-		Amount int64 `bson:"amount"`
+		Amount bson.RawValue `bson:"amount"`
 	}
 	if err := cr.Decode(&row); err != nil {
 		db.log.Errorf("can not decode burned fee aggregation cursor; %s", err.Error())
-		return 0, err
+		return nil, err
 	}
-	return row.Amount, nil
+	return types.BigFromDecimalValue(row.Amount)
 }
 
 // BurnList provides list of native FTM burns per blocks stored in the persistent database.
@@ -0,0 +1,98 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const (
+	// colCheckpoints represents the name of the ingestion checkpoints collection.
+	colCheckpoints = "checkpoints"
+
+	// fiCheckpointPk is the name of the primary key field of the checkpoints' collection.
+	// It's the name of the data processor the checkpoint belongs to.
+	fiCheckpointPk = "_id"
+
+	// fiCheckpointBlock is the name of the field of the last block processed.
+	// The field is kept by checkpoints of earlier versions only.
+	fiCheckpointBlock = "blk"
+
+	// fiCheckpointNext is the name of the field of the next block to be processed.
+	fiCheckpointNext = "next"
+
+	// fiCheckpointLog is the name of the field of the next log index to be processed.
+	fiCheckpointLog = "log"
+
+	// fiCheckpointLive is the name of the field of the first block processed by the block pipeline.
+	fiCheckpointLive = "live"
+
+	// fiCheckpointUpdated is the name of the field of the checkpoint update time stamp.
+	fiCheckpointUpdated = "upd"
+)
+
+// checkpointRow represents a row in the checkpoints' collection.
+type checkpointRow struct {
+	Name    string    `bson:"_id"`
+	Block   *uint64   `bson:"blk"`
+	Next    *uint64   `bson:"next"`
+	Log     uint      `bson:"log"`
+	Live    uint64    `bson:"live"`
+	Updated time.Time `bson:"upd"`
+}
+
+// Checkpoints loads all the known ingestion checkpoints
+// as a map of the processor name to its progress.
+func (db *MongoDbBridge) Checkpoints() (map[string]types.Checkpoint, error) {
+	col := db.client.Database(db.dbName).Collection(colCheckpoints)
+	ctx := context.Background()
+
+	ld, err := col.Find(ctx, bson.D{})
+	if err != nil {
+		db.log.Errorf("can not load checkpoints; %s", err.Error())
+		return nil, err
+	}
+	defer db.closeCursor(ld)
+
+	list := make(map[string]types.Checkpoint)
+	for ld.Next(ctx) {
+		var row checkpointRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode checkpoint; %s", err.Error())
+			return nil, err
+		}
+
+		cp := types.Checkpoint{Log: row.Log, Live: row.Live}
+		switch {
+		case row.Next != nil:
+			cp.Next = *row.Next
+		case row.Block != nil:
+			cp.Next = *row.Block + 1
+		}
+		list[row.Name] = cp
+	}
+	return list, nil
+}
+
+// UpdateCheckpoint stores the progress of the given data processor.
+func (db *MongoDbBridge) UpdateCheckpoint(name string, cp *types.Checkpoint) error {
+	col := db.client.Database(db.dbName).Collection(colCheckpoints)
+
+	_, err := col.UpdateByID(context.Background(), name, bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: fiCheckpointNext, Value: cp.Next},
+			{Key: fiCheckpointLog, Value: cp.Log},
+			{Key: fiCheckpointLive, Value: cp.Live},
+			{Key: fiCheckpointUpdated, Value: time.Now().UTC()},
+		}},
+		{Key: "$unset", Value: bson.D{{Key: fiCheckpointBlock, Value: ""}}},
+	}, options.Update().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not update checkpoint %s; %s", name, err.Error())
+		return err
+	}
+	return nil
+}
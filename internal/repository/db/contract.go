@@ -167,6 +167,37 @@ func (db *MongoDbBridge) ContractTransaction(addr *common.Address) (*common.Hash
 	return &c.TransactionHash, nil
 }
 
+// ContractTransactions returns contract creation transaction hashes of the given addresses
+// in a single query. Addresses not known to be contracts are not included in the result.
+func (db *MongoDbBridge) ContractTransactions(addrs []common.Address) (map[common.Address]*common.Hash, error) {
+	// get the collection for transactions
+	col := db.client.Database(db.dbName).Collection(coContract)
+
+	// make the list of PKs to look for
+	ids := make(bson.A, len(addrs))
+	for i := range addrs {
+		ids[i] = addrs[i].String()
+	}
+
+	cursor, err := col.Find(context.Background(), bson.D{{Key: fiContractPk, Value: bson.D{{Key: "$in", Value: ids}}}})
+	if err != nil {
+		db.log.Errorf("can not get contracts; %s", err.Error())
+		return nil, err
+	}
+	defer db.closeCursor(cursor)
+
+	list := make(map[common.Address]*common.Hash, len(addrs))
+	for cursor.Next(context.Background()) {
+		var con types.Contract
+		if err := cursor.Decode(&con); err != nil {
+			db.log.Errorf("can not decode contract; %s", err.Error())
+			return nil, err
+		}
+		list[con.Address] = &con.TransactionHash
+	}
+	return list, nil
+}
+
 // Contract returns details of a smart contract stored in the Mongo database
 // if available, or nil if contract does not exist.
 func (db *MongoDbBridge) Contract(addr *common.Address) (*types.Contract, error) {
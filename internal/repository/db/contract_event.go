@@ -0,0 +1,107 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// colContractEvents represents the name of the decoded contract events collection.
+	colContractEvents = "contract_events"
+
+	// fiContractEventBlock is the name of the field of the block number of the event.
+	fiContractEventBlock = "blk"
+)
+
+// contractEventsIndexes provides a list of indexes expected to exist on the decoded contract events' collection.
+func contractEventsIndexes() []mongo.IndexModel {
+	ix := make([]mongo.IndexModel, 4)
+
+	ixOrdinal := "ix_orx"
+	ix[0] = mongo.IndexModel{Keys: bson.D{{Key: types.FiContractEventOrdinal, Value: -1}}, Options: &options.IndexOptions{
+		Name: &ixOrdinal,
+	}}
+
+	ixAddressEvent := "ix_addr_event_orx"
+	ix[1] = mongo.IndexModel{Keys: bson.D{
+		{Key: types.FiContractEventAddress, Value: 1},
+		{Key: types.FiContractEventName, Value: 1},
+		{Key: types.FiContractEventOrdinal, Value: -1},
+	}, Options: &options.IndexOptions{
+		Name: &ixAddressEvent,
+	}}
+
+	ixArgs := "ix_args"
+	ix[2] = mongo.IndexModel{Keys: bson.D{
+		{Key: types.FiContractEventArgs + "." + types.FiContractEventArgName, Value: 1},
+		{Key: types.FiContractEventArgs + "." + types.FiContractEventArgValue, Value: 1},
+	}, Options: &options.IndexOptions{
+		Name: &ixArgs,
+	}}
+
+	ixBlock := "ix_blk"
+	ix[3] = mongo.IndexModel{Keys: bson.D{{Key: fiContractEventBlock, Value: 1}}, Options: &options.IndexOptions{
+		Name: &ixBlock,
+	}}
+
+	return ix
+}
+
+// AddContractEvent stores a decoded contract event in the database.
+// An event already known is replaced.
+func (db *MongoDbBridge) AddContractEvent(ce *types.ContractEvent) error {
+	if ce == nil {
+		return fmt.Errorf("can not add empty contract event")
+	}
+
+	col := db.client.Database(db.dbName).Collection(colContractEvents)
+	_, err := col.ReplaceOne(context.Background(),
+		bson.D{{Key: types.FiContractEventPk, Value: ce.Pk()}},
+		ce, options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store contract event %s of %s; %s", ce.Event, ce.Transaction.String(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// contractEventList describes the paginated list of decoded contract events; the newest events come first by default.
+var contractEventList = listSpec{
+	name:       "contract events",
+	collection: colContractEvents,
+	ordinal:    types.FiContractEventOrdinal,
+	sort:       ListSort{Field: types.FiContractEventOrdinal},
+	cursor:     listPk,
+}
+
+// ContractEvents pulls list of decoded contract events starting at the specified cursor.
+// The default order of the list is used if the sort is not provided.
+func (db *MongoDbBridge) ContractEvents(cursor *string, count int32, filter *bson.D, sort *ListSort) (*types.ContractEventList, error) {
+	if filter == nil {
+		filter = &bson.D{}
+	}
+
+	page, err := loadList[types.ContractEvent](db, &contractEventList, cursor, count, *filter, sort)
+	if err != nil {
+		db.log.Errorf("can not build contract events list; %s", err.Error())
+		return nil, err
+	}
+
+	list := types.ContractEventList{
+		Collection: page.Collection,
+		Total:      page.Total,
+		IsStart:    page.IsStart,
+		IsEnd:      page.IsEnd,
+		Filter:     *filter,
+	}
+	if len(list.Collection) > 0 {
+		list.First = list.Collection[0].OrdinalIndex()
+		list.Last = list.Collection[len(list.Collection)-1].OrdinalIndex()
+	}
+	return &list, nil
+}
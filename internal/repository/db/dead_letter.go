@@ -0,0 +1,189 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const (
+	// colDeadLetters represents the name of the failed processing records collection.
+	colDeadLetters = "dead_letters"
+
+	// fiDeadLetterPk is the name of the primary key field of the dead letters' collection.
+	fiDeadLetterPk = "_id"
+
+	// fiDeadLetterAttempts is the name of the field of the number of failed attempts.
+	fiDeadLetterAttempts = "attempts"
+
+	// fiDeadLetterFailed is the name of the field of the last failure time stamp.
+	fiDeadLetterFailed = "failed"
+
+	// fiDeadLetterNext is the name of the field of the next retry time stamp.
+	fiDeadLetterNext = "next"
+)
+
+// deadLetterRow represents a row in the dead letters' collection.
+type deadLetterRow struct {
+	ID        string    `bson:"_id"`
+	Type      string    `bson:"type"`
+	Handler   string    `bson:"handler"`
+	Block     uint64    `bson:"blk"`
+	Trx       string    `bson:"trx"`
+	LogIndex  *uint64   `bson:"lix"`
+	Error     string    `bson:"err"`
+	Attempts  int32     `bson:"attempts"`
+	Created   time.Time `bson:"created"`
+	Failed    time.Time `bson:"failed"`
+	NextRetry time.Time `bson:"next"`
+}
+
+// deadLettersIndexes provides a list of indexes expected to exist on the dead letters' collection.
+func deadLettersIndexes() []mongo.IndexModel {
+	ix := make([]mongo.IndexModel, 1)
+
+	ixNext := "ix_next"
+	ix[0] = mongo.IndexModel{Keys: bson.D{{Key: fiDeadLetterNext, Value: 1}}, Options: &options.IndexOptions{Name: &ixNext}}
+	return ix
+}
+
+// AddDeadLetter stores a failed processing record, or updates the existing record of the same failure.
+// The number of failed attempts on the record is returned.
+func (db *MongoDbBridge) AddDeadLetter(dl *types.DeadLetter) (int32, error) {
+	col := db.client.Database(db.dbName).Collection(colDeadLetters)
+
+	set := bson.D{
+		{Key: "type", Value: dl.Type},
+		{Key: "handler", Value: dl.Handler},
+		{Key: "blk", Value: uint64(dl.BlockNumber)},
+		{Key: "trx", Value: dl.Transaction.String()},
+		{Key: "err", Value: dl.Error},
+		{Key: fiDeadLetterFailed, Value: time.Now().UTC()},
+	}
+	if dl.LogIndex != nil {
+		set = append(set, bson.E{Key: "lix", Value: uint64(*dl.LogIndex)})
+	}
+
+	var row deadLetterRow
+	err := col.FindOneAndUpdate(context.Background(), bson.D{{Key: fiDeadLetterPk, Value: dl.Pk()}}, bson.D{
+		{Key: "$set", Value: set},
+		{Key: "$inc", Value: bson.D{{Key: fiDeadLetterAttempts, Value: 1}}},
+		{Key: "$setOnInsert", Value: bson.D{{Key: "created", Value: time.Now().UTC()}}},
+	}, options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)).Decode(&row)
+	if err != nil {
+		db.log.Errorf("can not store dead letter %s; %s", dl.Pk(), err.Error())
+		return 0, err
+	}
+	return row.Attempts, nil
+}
+
+// RetryDeadLetterAt schedules the next retry of the given failed processing record.
+func (db *MongoDbBridge) RetryDeadLetterAt(id string, next time.Time) error {
+	col := db.client.Database(db.dbName).Collection(colDeadLetters)
+
+	_, err := col.UpdateOne(context.Background(), bson.D{{Key: fiDeadLetterPk, Value: id}}, bson.D{
+		{Key: "$set", Value: bson.D{{Key: fiDeadLetterNext, Value: next.UTC()}}},
+	})
+	if err != nil {
+		db.log.Errorf("can not schedule dead letter %s; %s", id, err.Error())
+		return err
+	}
+	return nil
+}
+
+// ResolveDeadLetter removes the given failed processing record if it did not fail again since the given time.
+// It returns TRUE if the record has been removed.
+func (db *MongoDbBridge) ResolveDeadLetter(id string, since time.Time) (bool, error) {
+	col := db.client.Database(db.dbName).Collection(colDeadLetters)
+
+	res, err := col.DeleteOne(context.Background(), bson.D{
+		{Key: fiDeadLetterPk, Value: id},
+		{Key: fiDeadLetterFailed, Value: bson.D{{Key: "$lt", Value: since.UTC()}}},
+	})
+	if err != nil {
+		db.log.Errorf("can not resolve dead letter %s; %s", id, err.Error())
+		return false, err
+	}
+	return res.DeletedCount > 0, nil
+}
+
+// DiscardDeadLetter removes the given failed processing record without resolving it.
+func (db *MongoDbBridge) DiscardDeadLetter(id string) (bool, error) {
+	col := db.client.Database(db.dbName).Collection(colDeadLetters)
+
+	res, err := col.DeleteOne(context.Background(), bson.D{{Key: fiDeadLetterPk, Value: id}})
+	if err != nil {
+		db.log.Errorf("can not discard dead letter %s; %s", id, err.Error())
+		return false, err
+	}
+	return res.DeletedCount > 0, nil
+}
+
+// DeadLetter loads the failed processing record of the given identifier.
+func (db *MongoDbBridge) DeadLetter(id string) (*types.DeadLetter, error) {
+	col := db.client.Database(db.dbName).Collection(colDeadLetters)
+
+	var row deadLetterRow
+	if err := col.FindOne(context.Background(), bson.D{{Key: fiDeadLetterPk, Value: id}}).Decode(&row); err != nil {
+		db.log.Errorf("can not load dead letter %s; %s", id, err.Error())
+		return nil, err
+	}
+	return row.deadLetter(), nil
+}
+
+// DeadLetters loads a list of failed processing records ordered by the time of the next retry.
+// Only records due for retry at the given time are loaded, if the time is provided.
+func (db *MongoDbBridge) DeadLetters(due *time.Time, count int64) ([]*types.DeadLetter, error) {
+	col := db.client.Database(db.dbName).Collection(colDeadLetters)
+	ctx := context.Background()
+
+	filter := bson.D{}
+	if due != nil {
+		filter = bson.D{{Key: fiDeadLetterNext, Value: bson.D{{Key: "$lte", Value: due.UTC()}}}}
+	}
+
+	ld, err := col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: fiDeadLetterNext, Value: 1}}).SetLimit(count))
+	if err != nil {
+		db.log.Errorf("can not load dead letters; %s", err.Error())
+		return nil, err
+	}
+	defer db.closeCursor(ld)
+
+	list := make([]*types.DeadLetter, 0)
+	for ld.Next(ctx) {
+		var row deadLetterRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode dead letter; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, row.deadLetter())
+	}
+	return list, nil
+}
+
+// deadLetter converts the row into the dead letter record.
+func (row *deadLetterRow) deadLetter() *types.DeadLetter {
+	dl := types.DeadLetter{
+		ID:          row.ID,
+		Type:        row.Type,
+		Handler:     row.Handler,
+		BlockNumber: hexutil.Uint64(row.Block),
+		Transaction: common.HexToHash(row.Trx),
+		Error:       row.Error,
+		Attempts:    row.Attempts,
+		Created:     row.Created,
+		Failed:      row.Failed,
+		NextRetry:   row.NextRetry,
+	}
+	if row.LogIndex != nil {
+		lix := hexutil.Uint(*row.LogIndex)
+		dl.LogIndex = &lix
+	}
+	return &dl
+}
@@ -42,6 +42,9 @@ func (db *MongoDbBridge) initDelegationCollection(col *mongo.Collection) {
 	ix = append(ix, mongo.IndexModel{Keys: bson.D{{Key: types.FiDelegationOrdinal, Value: -1}}})
 	ix = append(ix, mongo.IndexModel{Keys: bson.D{{Key: types.FiDelegationStamp, Value: -1}}})
 
+	// index delegated amount for lists sorted by the amount
+	ix = append(ix, mongo.IndexModel{Keys: bson.D{{Key: types.FiDelegationValue, Value: -1}, {Key: types.FiDelegationOrdinal, Value: -1}}})
+
 	// create indexes
 	if _, err := col.Indexes().CreateMany(context.Background(), ix); err != nil {
 		db.log.Panicf("can not create indexes for delegation collection; %s", err.Error())
@@ -211,236 +214,46 @@ func (db *MongoDbBridge) DelegationsCount() (uint64, error) {
 	return db.EstimateCount(db.client.Database(db.dbName).Collection(colDelegations))
 }
 
-// dlgListInit initializes list of delegations based on provided cursor, count, and filter.
-func (db *MongoDbBridge) dlgListInit(col *mongo.Collection, cursor *string, count int32, filter *bson.D) (*types.DelegationList, error) {
-	// make sure some filter is used
-	if nil == filter {
-		filter = &bson.D{}
-	}
-
-	// find how many transactions do we have in the database
-	total, err := col.CountDocuments(context.Background(), *filter)
-	if err != nil {
-		db.log.Errorf("can not count delegations")
-		return nil, err
-	}
-
-	// make the list and notify the size of it
-	db.log.Debugf("found %d filtered delegations", total)
-	list := types.DelegationList{
-		Collection: make([]*types.Delegation, 0),
-		Total:      uint64(total),
-		First:      0,
-		Last:       0,
-		IsStart:    total == 0,
-		IsEnd:      total == 0,
-		Filter:     *filter,
-	}
-
-	// is the list non-empty? return the list with properly calculated range marks
-	if 0 < total {
-		return db.dlgListCollectRangeMarks(col, &list, cursor, count)
-	}
-
-	// this is an empty list
-	db.log.Debug("empty delegations list created")
-	return &list, nil
-}
-
-// trxListWithRangeMarks returns a list of delegations with proper First/Last marks.
-func (db *MongoDbBridge) dlgListCollectRangeMarks(col *mongo.Collection, list *types.DelegationList, cursor *string, count int32) (*types.DelegationList, error) {
-	var err error
-
-	// find out the cursor ordinal index
-	if cursor == nil && count > 0 {
-		// get the highest available pk
-		list.First, err = db.dlgListBorderPk(col,
-			list.Filter,
-			options.FindOne().SetSort(bson.D{{Key: types.FiDelegationOrdinal, Value: -1}}))
-		list.IsStart = true
-
-	} else if cursor == nil && count < 0 {
-		// get the lowest available pk
-		list.First, err = db.dlgListBorderPk(col,
-			list.Filter,
-			options.FindOne().SetSort(bson.D{{Key: types.FiDelegationOrdinal, Value: 1}}))
-		list.IsEnd = true
-
-	} else if cursor != nil {
-		// decode the cursor
-		id, err := primitive.ObjectIDFromHex(*cursor)
-		if err != nil {
-			db.log.Errorf("invalid delegation cursor ID; %s", err.Error())
-			return nil, err
-		}
-
-		// look for the first ordinal to make sure it's there
-		list.First, err = db.dlgListBorderPk(col,
-			append(list.Filter, bson.E{Key: types.FiDelegationPk, Value: id}),
-			options.FindOne())
-	}
-
-	// check the error
-	if err != nil {
-		db.log.Errorf("can not find the initial delegation; %s", err.Error())
-		return nil, err
-	}
-
-	// inform what we are about to do
-	db.log.Debugf("delegation list starts from #%d", list.First)
-	return list, nil
-}
-
-// dlgListBorderPk finds the top PK of the delegations collection based on given filter and options.
-func (db *MongoDbBridge) dlgListBorderPk(col *mongo.Collection, filter bson.D, opt *options.FindOneOptions) (uint64, error) {
-	// prep container
-	var row struct {
-		Value uint64 `bson:"orx"`
-	}
-
-	// make sure we pull only what we need
-	opt.SetProjection(bson.D{{Key: types.FiDelegationOrdinal, Value: true}})
-	sr := col.FindOne(context.Background(), filter, opt)
-
-	// try to decode
-	err := sr.Decode(&row)
-	if err != nil {
-		return 0, err
-	}
-
-	return row.Value, nil
-}
-
-// dlgListFilter creates a filter for delegations list loading.
-func (db *MongoDbBridge) dlgListFilter(cursor *string, count int32, list *types.DelegationList) *bson.D {
-	// build an extended filter for the query; add PK (decoded cursor) to the original filter
-	if cursor == nil {
-		if count > 0 {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiDelegationOrdinal, Value: bson.D{{Key: "$lte", Value: list.First}}})
-		} else {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiDelegationOrdinal, Value: bson.D{{Key: "$gte", Value: list.First}}})
-		}
-	} else {
-		if count > 0 {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiDelegationOrdinal, Value: bson.D{{Key: "$lt", Value: list.First}}})
-		} else {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiDelegationOrdinal, Value: bson.D{{Key: "$gt", Value: list.First}}})
-		}
-	}
-
-	// return the new filter
-	return &list.Filter
-}
-
-// dlgListOptions creates a filter options set for delegations list search.
-func (db *MongoDbBridge) dlgListOptions(count int32) *options.FindOptions {
-	// prep options
-	opt := options.Find()
-
-	// how to sort results in the collection
-	// from high (new) to low (old) by default; reversed if loading from bottom
-	sd := -1
-	if count < 0 {
-		sd = 1
-		count = -count
-	}
-
-	// sort with the direction we want
-	opt.SetSort(bson.D{{Key: types.FiDelegationOrdinal, Value: sd}})
-
-	// apply the limit, try to get one more record so we can detect list end
-	opt.SetLimit(int64(count) + 1)
-	return opt
-}
-
-// dlgListLoad load the initialized list of delegations from database.
-func (db *MongoDbBridge) dlgListLoad(col *mongo.Collection, cursor *string, count int32, list *types.DelegationList) (err error) {
-	// get the context for loader
-	ctx := context.Background()
-
-	// load the data
-	ld, err := col.Find(ctx, db.dlgListFilter(cursor, count, list), db.dlgListOptions(count))
-	if err != nil {
-		db.log.Errorf("error loading delegations list; %s", err.Error())
-		return err
-	}
-
-	// close the cursor as we leave
-	defer func() {
-		err = ld.Close(ctx)
+// delegationList describes the paginated list of delegations; the newest delegations come first by default.
+var delegationList = listSpec{
+	name:       "delegations",
+	collection: colDelegations,
+	ordinal:    types.FiDelegationOrdinal,
+	sort:       ListSort{Field: types.FiDelegationOrdinal},
+	cursor: func(cursor string) (bson.D, error) {
+		id, err := primitive.ObjectIDFromHex(cursor)
 		if err != nil {
-			db.log.Errorf("error closing delegations list cursor; %s", err.Error())
-		}
-	}()
-
-	// loop and load the list; we may not store the last value
-	var dlg *types.Delegation
-	for ld.Next(ctx) {
-		// append a previous value to the list, if we have one
-		if dlg != nil {
-			list.Collection = append(list.Collection, dlg)
+			return nil, fmt.Errorf("invalid delegation cursor ID; %s", err.Error())
 		}
-
-		// try to decode the next row
-		var row types.Delegation
-		if err = ld.Decode(&row); err != nil {
-			db.log.Errorf("can not decode the delegation list row; %s", err.Error())
-			return err
-		}
-
-		// use this row as the next item
-		dlg = &row
-	}
-
-	// we should have all the items already; we may just need to check if a boundary was reached
-	list.IsEnd = (cursor == nil && count < 0) || (count > 0 && int32(len(list.Collection)) < count)
-	list.IsStart = (cursor == nil && count > 0) || (count < 0 && int32(len(list.Collection)) < -count)
-
-	// add the last item as well if we hit the boundary
-	if (list.IsStart || list.IsEnd) && dlg != nil {
-		list.Collection = append(list.Collection, dlg)
-	}
-	return nil
+		return bson.D{{Key: types.FiDelegationPk, Value: id}}, nil
+	},
 }
 
 // Delegations pulls list of delegations starting at the specified cursor.
-func (db *MongoDbBridge) Delegations(cursor *string, count int32, filter *bson.D) (*types.DelegationList, error) {
-	// nothing to load?
-	if count == 0 {
-		return nil, fmt.Errorf("nothing to do, zero delegations requested")
+// The default order of the list is used if the sort is not provided.
+func (db *MongoDbBridge) Delegations(cursor *string, count int32, filter *bson.D, sort *ListSort) (*types.DelegationList, error) {
+	if filter == nil {
+		filter = &bson.D{}
 	}
 
-	// get the collection and context
-	col := db.client.Database(db.dbName).Collection(colDelegations)
-
-	// init the list
-	list, err := db.dlgListInit(col, cursor, count, filter)
+	page, err := loadList[types.Delegation](db, &delegationList, cursor, count, *filter, sort)
 	if err != nil {
 		db.log.Errorf("can not build delegation list; %s", err.Error())
 		return nil, err
 	}
 
-	// load data if there are any
-	if list.Total > 0 {
-		err = db.dlgListLoad(col, cursor, count, list)
-		if err != nil {
-			db.log.Errorf("can not load delegation list from database; %s", err.Error())
-			return nil, err
-		}
-
-		// reverse on negative so new-er delegations will be on top
-		if count < 0 {
-			list.Reverse()
-			count = -count
-		}
-
-		// cut the end?
-		if len(list.Collection) > int(count) {
-			list.Collection = list.Collection[:len(list.Collection)-1]
-		}
+	list := types.DelegationList{
+		Collection: page.Collection,
+		Total:      page.Total,
+		IsStart:    page.IsStart,
+		IsEnd:      page.IsEnd,
+		Filter:     *filter,
 	}
-
-	return list, nil
+	if len(list.Collection) > 0 {
+		list.First = list.Collection[0].OrdinalIndex()
+		list.Last = list.Collection[len(list.Collection)-1].OrdinalIndex()
+	}
+	return &list, nil
 }
 
 // DelegationsAll pulls list of delegations for the given filter un-paged.
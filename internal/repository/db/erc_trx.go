@@ -9,7 +9,6 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
-	"time"
 )
 
 // colErcTransactions represents the name of the ERC20 transaction collection in database.
@@ -102,225 +101,39 @@ func (db *MongoDbBridge) ErcTransactionCount() (uint64, error) {
 	return db.EstimateCount(db.client.Database(db.dbName).Collection(colErcTransactions))
 }
 
-// ercTrxListInit initializes list of ERC20 transactions based on provided cursor, count, and filter.
-func (db *MongoDbBridge) ercTrxListInit(col *mongo.Collection, cursor *string, count int32, filter *bson.D) (*types.TokenTransactionList, error) {
-	// make sure some filter is used
-	if nil == filter {
-		filter = &bson.D{}
-	}
-
-	// find how many transactions do we have in the database
-	total, err := db.ErcTransactionCountFiltered(filter)
-	if err != nil {
-		db.log.Errorf("can not count ERC20 transactions")
-		return nil, err
-	}
-
-	// make the list and notify the size of it
-	db.log.Debugf("found %d filtered ERC20 transactions", total)
-	list := types.TokenTransactionList{
-		Collection: make([]*types.TokenTransaction, 0),
-		Total:      total,
-		First:      0,
-		Last:       0,
-		IsStart:    total == 0,
-		IsEnd:      total == 0,
-		Filter:     *filter,
-	}
-
-	// is the list non-empty? return the list with properly calculated range marks
-	if 0 < total {
-		return db.ercTrxListCollectRangeMarks(col, &list, cursor, count)
-	}
-	// this is an empty list
-	db.log.Debug("empty erc trx list created")
-	return &list, nil
-}
-
-// ercTrxListCollectRangeMarks returns a list of ERC20 transactions with proper First/Last marks.
-func (db *MongoDbBridge) ercTrxListCollectRangeMarks(col *mongo.Collection, list *types.TokenTransactionList, cursor *string, count int32) (*types.TokenTransactionList, error) {
-	var err error
-
-	// find out the cursor ordinal index
-	if cursor == nil && count > 0 {
-		// get the highest available pk
-		list.First, err = db.ercTrxListBorderPk(col,
-			list.Filter,
-			options.FindOne().SetSort(bson.D{{Key: types.FiTokenTransactionOrdinal, Value: -1}}))
-		list.IsStart = true
-
-	} else if cursor == nil && count < 0 {
-		// get the lowest available pk
-		list.First, err = db.ercTrxListBorderPk(col,
-			list.Filter,
-			options.FindOne().SetSort(bson.D{{Key: types.FiTokenTransactionOrdinal, Value: 1}}))
-		list.IsEnd = true
-
-	} else if cursor != nil {
-		// the cursor itself is the starting point
-		list.First, err = db.ercTrxListBorderPk(col,
-			bson.D{{Key: types.FiTokenTransactionPk, Value: *cursor}},
-			options.FindOne())
-	}
-
-	// check the error
-	if err != nil {
-		db.log.Errorf("can not find the initial ERC20 trx")
-		return nil, err
-	}
-
-	// inform what we are about to do
-	db.log.Debugf("ERC20 transaction list initialized with ordinal %d", list.First)
-	return list, nil
-}
-
-// ercTrxListBorderPk finds the top PK of the ERC20 transactions collection based on given filter and options.
-func (db *MongoDbBridge) ercTrxListBorderPk(col *mongo.Collection, filter bson.D, opt *options.FindOneOptions) (uint64, error) {
-	// prep container
-	var row struct {
-		Value uint64 `bson:"orx"`
-	}
-
-	// make sure we pull only what we need
-	opt.SetProjection(bson.D{{Key: types.FiTokenTransactionOrdinal, Value: true}})
-
-	// try to decode
-	sr := col.FindOne(context.Background(), filter, opt)
-	err := sr.Decode(&row)
-	if err != nil {
-		return 0, err
-	}
-	return row.Value, nil
-}
-
-// ercTrxListFilter creates a filter for ERC20 transaction list loading.
-func (db *MongoDbBridge) ercTrxListFilter(cursor *string, count int32, list *types.TokenTransactionList) *bson.D {
-	// build an extended filter for the query; add PK (decoded cursor) to the original filter
-	if cursor == nil {
-		if count > 0 {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiTokenTransactionOrdinal, Value: bson.D{{Key: "$lte", Value: list.First}}})
-		} else {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiTokenTransactionOrdinal, Value: bson.D{{Key: "$gte", Value: list.First}}})
-		}
-	} else {
-		if count > 0 {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiTokenTransactionOrdinal, Value: bson.D{{Key: "$lt", Value: list.First}}})
-		} else {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiTokenTransactionOrdinal, Value: bson.D{{Key: "$gt", Value: list.First}}})
-		}
-	}
-	// return the new filter
-	return &list.Filter
-}
-
-// ercTrxListOptions creates a filter options set for ERC20 transactions list search.
-func (db *MongoDbBridge) ercTrxListOptions(count int32) *options.FindOptions {
-	// prep options
-	opt := options.Find()
-
-	// how to sort results in the collection
-	// from high (new) to low (old) by default; reversed if loading from bottom
-	sd := -1
-	if count < 0 {
-		sd = 1
-	}
-
-	// sort with the direction we want
-	opt.SetSort(bson.D{{Key: types.FiTokenTransactionOrdinal, Value: sd}})
-
-	// prep the loading limit
-	var limit = int64(count)
-	if limit < 0 {
-		limit = -limit
-	}
-
-	// apply the limit, try to get one more record so we can detect list end
-	opt.SetLimit(limit + 1)
-	return opt
-}
-
-// ercTrxListLoad load the initialized list of ERC20 transactions from database.
-func (db *MongoDbBridge) ercTrxListLoad(col *mongo.Collection, cursor *string, count int32, list *types.TokenTransactionList) (err error) {
-	// get the context for loader
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer cancel()
-
-	// load the data
-	ld, err := col.Find(ctx, db.ercTrxListFilter(cursor, count, list), db.ercTrxListOptions(count))
-	if err != nil {
-		db.log.Errorf("error loading ERC20 transactions list; %s", err.Error())
-		return err
-	}
-
-	// close the cursor as we leave
-	defer func() {
-		err = ld.Close(ctx)
-		if err != nil {
-			db.log.Errorf("error closing ERC20 transactions list cursor; %s", err.Error())
-		}
-	}()
-
-	// loop and load the list; we may not store the last value
-	var trx *types.TokenTransaction
-	for ld.Next(context.Background()) {
-		// append a previous value to the list, if we have one
-		if trx != nil {
-			list.Collection = append(list.Collection, trx)
-		}
-
-		// try to decode the next row
-		var row types.TokenTransaction
-		if err = ld.Decode(&row); err != nil {
-			db.log.Errorf("can not decode the ERC20 transaction list row; %s", err.Error())
-			return err
-		}
-
-		// use this row as the next item
-		trx = &row
-	}
-
-	// we should have all the items already; we may just need to check if a boundary was reached
-	list.IsEnd = (cursor == nil && count < 0) || (count > 0 && int32(len(list.Collection)) < count)
-	list.IsStart = (cursor == nil && count > 0) || (count < 0 && int32(len(list.Collection)) < -count)
-
-	// add the last item as well if we hit the boundary
-	if ((count < 0 && list.IsStart) || (count > 0 && list.IsEnd)) && trx != nil {
-		list.Collection = append(list.Collection, trx)
-	}
-	return nil
+// ercTrxList describes the paginated list of ERC transactions; the newest transactions come first.
+var ercTrxList = listSpec{
+	name:       "erc transactions",
+	collection: colErcTransactions,
+	ordinal:    types.FiTokenTransactionOrdinal,
+	sort:       ListSort{Field: types.FiTokenTransactionOrdinal},
+	cursor:     listPk,
 }
 
 // Erc20Transactions pulls list of ERC20 transactions starting at the specified cursor.
 func (db *MongoDbBridge) Erc20Transactions(cursor *string, count int32, filter *bson.D) (*types.TokenTransactionList, error) {
-	// nothing to load?
-	if count == 0 {
-		return nil, fmt.Errorf("nothing to do, zero erc transactions requested")
+	if filter == nil {
+		filter = &bson.D{}
 	}
 
-	// get the collection and context
-	col := db.client.Database(db.dbName).Collection(colErcTransactions)
-
-	// init the list
-	list, err := db.ercTrxListInit(col, cursor, count, filter)
+	page, err := loadList[types.TokenTransaction](db, &ercTrxList, cursor, count, *filter, nil)
 	if err != nil {
 		db.log.Errorf("can not build erc transaction list; %s", err.Error())
 		return nil, err
 	}
 
-	// load data if there are any
-	if list.Total > 0 {
-		err = db.ercTrxListLoad(col, cursor, count, list)
-		if err != nil {
-			db.log.Errorf("can not load erc transaction list from database; %s", err.Error())
-			return nil, err
-		}
-
-		// reverse on negative so new-er trx will be on top
-		if count < 0 {
-			list.Reverse()
-		}
+	list := types.TokenTransactionList{
+		Collection: page.Collection,
+		Total:      page.Total,
+		IsStart:    page.IsStart,
+		IsEnd:      page.IsEnd,
+		Filter:     *filter,
 	}
-	return list, nil
+	if len(list.Collection) > 0 {
+		list.First = list.Collection[0].OrdinalIndex()
+		list.Last = list.Collection[len(list.Collection)-1].OrdinalIndex()
+	}
+	return &list, nil
 }
 
 // Erc20Assets provides list of unique token addresses linked by transactions to the given owner address.
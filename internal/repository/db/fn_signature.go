@@ -0,0 +1,81 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// colFunctionSignatures represents the name of the imported function signatures collection.
+	colFunctionSignatures = "fn_signatures"
+
+	// fiFunctionSignaturePk is the name of the primary key field of the function signatures collection.
+	// The canonical signature of the function is the key.
+	fiFunctionSignaturePk = "_id"
+
+	// fiFunctionSignatureSelector is the name of the field of the function selector.
+	fiFunctionSignatureSelector = "sel"
+)
+
+// functionSignaturesIndexes provides a list of indexes expected to exist on the function signatures' collection.
+func functionSignaturesIndexes() []mongo.IndexModel {
+	ix := make([]mongo.IndexModel, 1)
+
+	ixSel := "ix_sel"
+	ix[0] = mongo.IndexModel{Keys: bson.D{{Key: fiFunctionSignatureSelector, Value: 1}}, Options: &options.IndexOptions{Name: &ixSel}}
+	return ix
+}
+
+// AddFunctionSignatures stores the given imported function signatures keyed by their canonical form.
+// Known signatures are skipped; the number of newly added signatures is returned.
+func (db *MongoDbBridge) AddFunctionSignatures(list []*types.TrxMethod) (int64, error) {
+	if len(list) == 0 {
+		return 0, nil
+	}
+
+	batch := make([]mongo.WriteModel, len(list))
+	for i, fn := range list {
+		batch[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: fiFunctionSignaturePk, Value: fn.Signature}}).
+			SetUpdate(bson.D{{Key: "$setOnInsert", Value: bson.D{{Key: fiFunctionSignatureSelector, Value: fn.Selector.String()}}}}).
+			SetUpsert(true)
+	}
+
+	col := db.client.Database(db.dbName).Collection(colFunctionSignatures)
+	res, err := col.BulkWrite(context.Background(), batch, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		db.log.Errorf("can not store function signatures; %s", err.Error())
+		return 0, err
+	}
+	return res.UpsertedCount, nil
+}
+
+// FunctionSignatures provides the list of imported function signatures of the given selector.
+func (db *MongoDbBridge) FunctionSignatures(sel *types.TrxSelector) ([]string, error) {
+	col := db.client.Database(db.dbName).Collection(colFunctionSignatures)
+
+	cr, err := col.Find(context.Background(), bson.D{{Key: fiFunctionSignatureSelector, Value: sel.String()}},
+		options.Find().SetProjection(bson.D{{Key: fiFunctionSignaturePk, Value: true}}))
+	if err != nil {
+		db.log.Errorf("can not load function signatures of %s; %s", sel.String(), err.Error())
+		return nil, err
+	}
+	defer db.closeCursor(cr)
+
+	list := make([]string, 0)
+	for cr.Next(context.Background()) {
+		var row struct {
+			Signature string `bson:"_id"`
+		}
+		if err := cr.Decode(&row); err != nil {
+			db.log.Errorf("can not decode function signature; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, row.Signature)
+	}
+	return list, cr.Err()
+}
@@ -19,14 +19,19 @@ type IndexList struct {
 	Indexes    []mongo.IndexModel
 }
 
+// ixLoaders represents the index list loaders of collections with prescribed indexes.
+var ixLoaders = map[string]indexListProvider{
+	colNetworkNodes:         operaNodeCollectionIndexes,
+	colLockedDelegations:    lockedDelegationsIndexes,
+	colBlocks:               blocksIndexes,
+	colContractEvents:       contractEventsIndexes,
+	colInternalTransactions: internalTransactionsIndexes,
+	colDeadLetters:          deadLettersIndexes,
+	colFunctionSignatures:   functionSignaturesIndexes,
+}
+
 // updateDatabaseIndexes checks for indexes existence; if an expected index is not found, it creates it.
 func (db *MongoDbBridge) updateDatabaseIndexes() {
-	// define index list loaders
-	var ixLoaders = map[string]indexListProvider{
-		colNetworkNodes:      operaNodeCollectionIndexes,
-		colLockedDelegations: lockedDelegationsIndexes,
-	}
-
 	// the DB bridge needs a way to terminate this thread
 	sig := make(chan bool, 1)
 	db.sig = append(db.sig, sig)
@@ -0,0 +1,145 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// colInternalTransactions represents the name of the internal transactions collection.
+const colInternalTransactions = "internal_transactions"
+
+// internalTransactionsIndexes provides a list of indexes expected to exist on the internal transactions' collection.
+func internalTransactionsIndexes() []mongo.IndexModel {
+	ix := make([]mongo.IndexModel, 4)
+
+	ixTrx := "ix_trx_pos"
+	ix[0] = mongo.IndexModel{Keys: bson.D{
+		{Key: types.FiInternalTrxParent, Value: 1},
+		{Key: types.FiInternalTrxPosition, Value: 1},
+	}, Options: &options.IndexOptions{
+		Name: &ixTrx,
+	}}
+
+	ixFrom := "ix_from_orx"
+	ix[1] = mongo.IndexModel{Keys: bson.D{
+		{Key: types.FiInternalTrxFrom, Value: 1},
+		{Key: types.FiInternalTrxOrdinal, Value: -1},
+	}, Options: &options.IndexOptions{
+		Name: &ixFrom,
+	}}
+
+	ixTo := "ix_to_orx"
+	ix[2] = mongo.IndexModel{Keys: bson.D{
+		{Key: types.FiInternalTrxTo, Value: 1},
+		{Key: types.FiInternalTrxOrdinal, Value: -1},
+	}, Options: &options.IndexOptions{
+		Name: &ixTo,
+	}}
+
+	ixBlock := "ix_blk"
+	ix[3] = mongo.IndexModel{Keys: bson.D{{Key: types.FiInternalTrxBlock, Value: 1}}, Options: &options.IndexOptions{
+		Name: &ixBlock,
+	}}
+
+	return ix
+}
+
+// AddInternalTransactions stores the internal transactions of the given parent transaction in the database.
+// Internal transactions already known for the parent transaction are replaced.
+func (db *MongoDbBridge) AddInternalTransactions(trx *common.Hash, list []*types.InternalTransaction) error {
+	if trx == nil {
+		return fmt.Errorf("can not add internal transactions of unknown transaction")
+	}
+
+	// remove previous set, if any; the transaction may have been processed before
+	col := db.client.Database(db.dbName).Collection(colInternalTransactions)
+	if _, err := col.DeleteMany(context.Background(), bson.D{{Key: types.FiInternalTrxParent, Value: trx.String()}}); err != nil {
+		db.log.Errorf("can not clear internal transactions of %s; %s", trx.String(), err.Error())
+		return err
+	}
+
+	// anything to store?
+	if len(list) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(list))
+	for i, itx := range list {
+		docs[i] = itx
+	}
+
+	if _, err := col.InsertMany(context.Background(), docs); err != nil {
+		db.log.Errorf("can not store internal transactions of %s; %s", trx.String(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// InternalTransactionsOf loads internal transactions of the given parent transaction
+// in the order of the flattened call tree.
+func (db *MongoDbBridge) InternalTransactionsOf(trx *common.Hash) ([]*types.InternalTransaction, error) {
+	col := db.client.Database(db.dbName).Collection(colInternalTransactions)
+	ctx := context.Background()
+
+	ld, err := col.Find(ctx,
+		bson.D{{Key: types.FiInternalTrxParent, Value: trx.String()}},
+		options.Find().SetSort(bson.D{{Key: types.FiInternalTrxPosition, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load internal transactions of %s; %s", trx.String(), err.Error())
+		return nil, err
+	}
+	defer db.closeCursor(ld)
+
+	list := make([]*types.InternalTransaction, 0)
+	for ld.Next(ctx) {
+		var row types.InternalTransaction
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode internal transaction of %s; %s", trx.String(), err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
+
+// internalTrxList describes the paginated list of internal transactions; the newest transactions come first by default.
+var internalTrxList = listSpec{
+	name:       "internal transactions",
+	collection: colInternalTransactions,
+	ordinal:    types.FiInternalTrxOrdinal,
+	sort:       ListSort{Field: types.FiInternalTrxOrdinal},
+	cursor:     listPk,
+}
+
+// InternalTransactions pulls list of internal transactions starting at the specified cursor.
+// The default order of the list is used if the sort is not provided.
+func (db *MongoDbBridge) InternalTransactions(cursor *string, count int32, filter *bson.D, sort *ListSort) (*types.InternalTransactionList, error) {
+	if filter == nil {
+		filter = &bson.D{}
+	}
+
+	page, err := loadList[types.InternalTransaction](db, &internalTrxList, cursor, count, *filter, sort)
+	if err != nil {
+		db.log.Errorf("can not build internal transactions list; %s", err.Error())
+		return nil, err
+	}
+
+	list := types.InternalTransactionList{
+		Collection: page.Collection,
+		Total:      page.Total,
+		IsStart:    page.IsStart,
+		IsEnd:      page.IsEnd,
+		Filter:     *filter,
+	}
+	if len(list.Collection) > 0 {
+		list.First = list.Collection[0].OrdinalIndex()
+		list.Last = list.Collection[len(list.Collection)-1].OrdinalIndex()
+	}
+	return &list, nil
+}
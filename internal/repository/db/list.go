@@ -0,0 +1,221 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"strings"
+)
+
+// ListSort represents a sort order of a paginated list.
+type ListSort = types.ListSort
+
+// listSpec describes a paginated collection.
+type listSpec struct {
+	// name represents the name of the list items used in logs and errors.
+	name string
+
+	// collection is the name of the collection the list is loaded from.
+	collection string
+
+	// ordinal is the name of a unique field ordering items with the same value of the sort field.
+	ordinal string
+
+	// sort is the default sort order of the list.
+	sort ListSort
+
+	// cursor decodes a list cursor into a filter of the item it identifies.
+	cursor func(string) (bson.D, error)
+}
+
+// listPage represents a single page of a paginated list.
+type listPage[T any] struct {
+	// Collection keeps the items of the page.
+	Collection []*T
+
+	// Total indicates total number of items of the whole filtered list.
+	Total uint64
+
+	// IsStart indicates there are no items available above the page.
+	IsStart bool
+
+	// IsEnd indicates there are no items available below the page.
+	IsEnd bool
+}
+
+// listPk decodes cursors referring to the string primary key of the list items.
+func listPk(cursor string) (bson.D, error) {
+	return bson.D{{Key: "_id", Value: cursor}}, nil
+}
+
+// loadList loads a page of the list described by the given spec. The page starts behind the item
+// identified by the cursor, or on the list border if no cursor is given. Positive count loads items
+// down the sort order, negative count loads items up the sort order; the page is always
+// provided in the sort order. The default sort order of the list is used if the sort is not given.
+func loadList[T any](db *MongoDbBridge, spec *listSpec, cursor *string, count int32, filter bson.D, sort *ListSort) (*listPage[T], error) {
+	// nothing to load?
+	if count == 0 {
+		return nil, fmt.Errorf("nothing to do, zero %s requested", spec.name)
+	}
+	if sort == nil {
+		sort = &spec.sort
+	}
+	if filter == nil {
+		filter = bson.D{}
+	}
+
+	col := db.client.Database(db.dbName).Collection(spec.collection)
+	total, err := db.CountFiltered(col, &filter)
+	if err != nil {
+		db.log.Errorf("can not count %s; %s", spec.name, err.Error())
+		return nil, err
+	}
+
+	page := listPage[T]{
+		Collection: make([]*T, 0),
+		Total:      total,
+		IsStart:    total == 0,
+		IsEnd:      total == 0,
+	}
+	if total == 0 {
+		db.log.Debugf("empty %s list created", spec.name)
+		return &page, nil
+	}
+
+	// continue behind the cursor item, if any
+	if cursor != nil {
+		rng, err := listRangeFilter(db, col, spec, sort, *cursor, count)
+		if err != nil {
+			db.log.Errorf("can not find the initial %s; %s", spec.name, err.Error())
+			return nil, err
+		}
+		if len(filter) > 0 {
+			rng = bson.D{{Key: "$and", Value: bson.A{filter, rng}}}
+		}
+		filter = rng
+	}
+
+	if err := listLoad(db, col, spec, sort, filter, count, &page); err != nil {
+		db.log.Errorf("can not load %s list; %s", spec.name, err.Error())
+		return nil, err
+	}
+
+	// set the borders of the page
+	more := len(page.Collection) > int(listAbs(count))
+	if more {
+		page.Collection = page.Collection[:listAbs(count)]
+	}
+	if count > 0 {
+		page.IsStart = cursor == nil
+		page.IsEnd = !more
+	} else {
+		page.IsStart = !more
+		page.IsEnd = cursor == nil
+
+		// items were loaded up the sort order
+		for i, j := 0, len(page.Collection)-1; i < j; i, j = i+1, j-1 {
+			page.Collection[i], page.Collection[j] = page.Collection[j], page.Collection[i]
+		}
+	}
+	return &page, nil
+}
+
+// listLoad loads one more item than requested by the count, so the list end can be detected.
+func listLoad[T any](db *MongoDbBridge, col *mongo.Collection, spec *listSpec, sort *ListSort, filter bson.D, count int32, page *listPage[T]) error {
+	dir := listSortDirection(sort, count)
+	opt := options.Find().SetLimit(listAbs(count) + 1)
+	if sort.Field == spec.ordinal {
+		opt.SetSort(bson.D{{Key: spec.ordinal, Value: dir}})
+	} else {
+		opt.SetSort(bson.D{{Key: sort.Field, Value: dir}, {Key: spec.ordinal, Value: dir}})
+	}
+
+	ld, err := col.Find(context.Background(), filter, opt)
+	if err != nil {
+		return err
+	}
+	defer db.closeCursor(ld)
+
+	for ld.Next(context.Background()) {
+		var row T
+		if err = ld.Decode(&row); err != nil {
+			return err
+		}
+		page.Collection = append(page.Collection, &row)
+	}
+	return ld.Err()
+}
+
+// listRangeFilter builds the filter of the items behind the item identified by the cursor
+// in the direction given by the count.
+func listRangeFilter(db *MongoDbBridge, col *mongo.Collection, spec *listSpec, sort *ListSort, cursor string, count int32) (bson.D, error) {
+	fi, err := spec.cursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// get the sort values of the cursor item
+	pro := bson.D{{Key: spec.ordinal, Value: true}}
+	if sort.Field != spec.ordinal {
+		pro = append(pro, bson.E{Key: sort.Field, Value: true})
+	}
+	raw, err := col.FindOne(context.Background(), fi, options.FindOne().SetProjection(pro)).DecodeBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	ord, err := raw.LookupErr(strings.Split(spec.ordinal, ".")...)
+	if err != nil {
+		return nil, fmt.Errorf("%s cursor %s has no ordinal; %s", spec.name, cursor, err.Error())
+	}
+	if sort.Field == spec.ordinal {
+		return listRange(spec.ordinal, ord, nil, "", sort, count), nil
+	}
+
+	val, err := raw.LookupErr(strings.Split(sort.Field, ".")...)
+	if err != nil {
+		return nil, fmt.Errorf("%s cursor %s has no %s; %s", spec.name, cursor, sort.Field, err.Error())
+	}
+	return listRange(sort.Field, val, ord, spec.ordinal, sort, count), nil
+}
+
+// listRange builds the filter of the items behind the given sort field value and ordinal.
+// The ordinal is not used if the list is sorted by the ordinal itself.
+func listRange(field string, val interface{}, ord interface{}, ordinal string, sort *ListSort, count int32) bson.D {
+	op := "$gt"
+	if listSortDirection(sort, count) < 0 {
+		op = "$lt"
+	}
+
+	if ordinal == "" {
+		return bson.D{{Key: field, Value: bson.D{{Key: op, Value: val}}}}
+	}
+	return bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: field, Value: bson.D{{Key: op, Value: val}}}},
+		bson.D{{Key: field, Value: val}, {Key: ordinal, Value: bson.D{{Key: op, Value: ord}}}},
+	}}}
+}
+
+// listSortDirection provides the direction of the database sort for loading the given count.
+func listSortDirection(sort *ListSort, count int32) int {
+	dir := -1
+	if sort.Ascending {
+		dir = 1
+	}
+	if count < 0 {
+		dir = -dir
+	}
+	return dir
+}
+
+// listAbs provides the absolute size of the page for the given count.
+func listAbs(count int32) int64 {
+	if count < 0 {
+		return -int64(count)
+	}
+	return int64(count)
+}
@@ -0,0 +1,28 @@
+package db
+
+import (
+	"github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+	"testing"
+)
+
+func TestListRange(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// newest first; the next page is below the cursor, the previous page above it
+	desc := &ListSort{Field: "orx"}
+	g.Expect(listRange("orx", 10, nil, "", desc, 25)).To(gomega.Equal(bson.D{{Key: "orx", Value: bson.D{{Key: "$lt", Value: 10}}}}))
+	g.Expect(listRange("orx", 10, nil, "", desc, -25)).To(gomega.Equal(bson.D{{Key: "orx", Value: bson.D{{Key: "$gt", Value: 10}}}}))
+
+	// items of the same value are ordered by the ordinal
+	asc := &ListSort{Field: "val", Ascending: true}
+	g.Expect(listRange("val", 5, 10, "orx", asc, 25)).To(gomega.Equal(bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "val", Value: bson.D{{Key: "$gt", Value: 5}}}},
+		bson.D{{Key: "val", Value: 5}, {Key: "orx", Value: bson.D{{Key: "$gt", Value: 10}}}},
+	}}}))
+
+	g.Expect(listSortDirection(desc, 1)).To(gomega.Equal(-1))
+	g.Expect(listSortDirection(desc, -1)).To(gomega.Equal(1))
+	g.Expect(listSortDirection(asc, -1)).To(gomega.Equal(-1))
+	g.Expect(listAbs(-25)).To(gomega.Equal(int64(25)))
+}
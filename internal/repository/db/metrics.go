@@ -0,0 +1,51 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/metrics"
+	"go.mongodb.org/mongo-driver/event"
+	"sync"
+)
+
+// dbCommandMonitor collects latencies of the database commands by collection.
+// The collection name is known only to the started event, so it's kept
+// by the request ID until the command finishes.
+type dbCommandMonitor struct {
+	pending sync.Map
+}
+
+// newCommandMonitor creates a new database command monitor feeding the database metrics.
+func newCommandMonitor() *event.CommandMonitor {
+	mon := new(dbCommandMonitor)
+	return &event.CommandMonitor{
+		Started: mon.started,
+		Succeeded: func(_ context.Context, evt *event.CommandSucceededEvent) {
+			mon.finished(&evt.CommandFinishedEvent, false)
+		},
+		Failed: func(_ context.Context, evt *event.CommandFailedEvent) {
+			mon.finished(&evt.CommandFinishedEvent, true)
+		},
+	}
+}
+
+// started registers a new command; the name of the collection is the value of the first command element.
+// Commands not related to a collection (i.e. ping, or endSessions) are not collected.
+func (mon *dbCommandMonitor) started(_ context.Context, evt *event.CommandStartedEvent) {
+	el, err := evt.Command.IndexErr(0)
+	if err != nil {
+		return
+	}
+	if col, ok := el.Value().StringValueOK(); ok {
+		mon.pending.Store(evt.RequestID, col)
+	}
+}
+
+// finished collects the latency of a finished command.
+func (mon *dbCommandMonitor) finished(evt *event.CommandFinishedEvent, failed bool) {
+	col, ok := mon.pending.LoadAndDelete(evt.RequestID)
+	if !ok {
+		return
+	}
+	metrics.ObserveDbOperation(col.(string), evt.CommandName, evt.Duration, failed)
+}
@@ -0,0 +1,362 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"math/big"
+	"time"
+)
+
+const (
+	// colMigrations represents the name of the collection keeping applied database migrations.
+	colMigrations = "migrations"
+
+	// migrationBatchSize represents the number of documents updated in a single bulk write.
+	migrationBatchSize = 1000
+)
+
+// migration represents a database migration applied once before the database is used.
+type migration struct {
+	id  string
+	run func(db *MongoDbBridge) error
+}
+
+// amountField represents a Decimal128 amount field converted from its hex encoded source field.
+type amountField struct {
+	target string
+	source string
+}
+
+// amountMigration represents amount fields of a collection converted to Decimal128.
+type amountMigration struct {
+	collection string
+	fields     []amountField
+	unset      []string
+}
+
+// migrations represents the list of database migrations in the order they are applied.
+var migrations = []migration{
+	{id: "exact-amounts", run: (*MongoDbBridge).migrateExactAmounts},
+	{id: "transaction-filters", run: (*MongoDbBridge).migrateTransactionFilters},
+}
+
+// exactAmounts represents the amount fields previously stored as integers with reduced precision.
+var exactAmounts = []amountMigration{
+	{
+		collection: coTransactions,
+		fields:     []amountField{{target: "amo", source: "value"}, {target: "gas_wei", source: "gas_pri"}},
+		unset:      []string{"gwx100"},
+	},
+	{
+		collection: colBurns,
+		fields: []amountField{
+			{target: "amount", source: "value"},
+			{target: "fee_amount", source: "fee_value"},
+			{target: "try_amount", source: "try_value"},
+			{target: "rew_amount", source: "rew_value"},
+		},
+	},
+	{
+		collection: colEpochs,
+		fields:     []amountField{{target: "burned", source: "feb"}, {target: "treasured", source: "fet"}},
+	},
+	{
+		collection: colRewards,
+		fields:     []amountField{{target: types.FiRewardClaimedValue, source: "amount"}},
+	},
+	{
+		collection: colWithdrawals,
+		fields:     []amountField{{target: types.FiWithdrawalValue, source: "amo"}},
+	},
+}
+
+// exactSwapAmounts represents the decimal corrections of swap amounts previously stored
+// as integers. Swaps do not keep the hex encoded amounts, so the precision removed
+// by the correction can not be restored.
+var exactSwapAmounts = map[string]*big.Int{
+	fiSwapAmount0in:  big.NewInt(1_000_000_000),
+	fiSwapAmount0out: big.NewInt(1_000_000_000),
+	fiSwapAmount1in:  big.NewInt(1_000_000_000),
+	fiSwapAmount1out: big.NewInt(1_000_000_000),
+	fiSwapReserve0:   big.NewInt(1_000_000_000_000),
+	fiSwapReserve1:   big.NewInt(1_000_000_000_000),
+}
+
+// migrateDatabase applies all the database migrations not applied yet.
+// The server does not start if a migration fails; it's retried on the next start.
+func (db *MongoDbBridge) migrateDatabase() error {
+	col := db.client.Database(db.dbName).Collection(colMigrations)
+
+	for _, mg := range migrations {
+		count, err := col.CountDocuments(context.Background(), bson.D{{Key: "_id", Value: mg.id}})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+
+		db.log.Noticef("applying database migration %s", mg.id)
+		start := time.Now()
+		if err := mg.run(db); err != nil {
+			return fmt.Errorf("migration %s failed; %s", mg.id, err.Error())
+		}
+
+		if _, err := col.InsertOne(context.Background(), bson.D{
+			{Key: "_id", Value: mg.id},
+			{Key: "applied", Value: time.Now().UTC()},
+		}); err != nil {
+			return err
+		}
+		db.log.Noticef("database migration %s applied in %s", mg.id, time.Since(start).String())
+	}
+	return nil
+}
+
+// migrateExactAmounts converts amounts stored with reduced precision into exact Decimal128 amounts in WEI
+// and rebuilds the aggregates calculated from them.
+func (db *MongoDbBridge) migrateExactAmounts() error {
+	for i := range exactAmounts {
+		if err := db.migrateAmounts(&exactAmounts[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := db.migrateSwapAmounts(); err != nil {
+		return err
+	}
+	return db.migrateAmountAggregates()
+}
+
+// migrateAmounts converts amount fields of a collection from their hex encoded source fields.
+func (db *MongoDbBridge) migrateAmounts(am *amountMigration) error {
+	col := db.client.Database(db.dbName).Collection(am.collection)
+
+	// documents with any amount not converted yet
+	or := make(bson.A, 0, len(am.fields))
+	pro := bson.D{{Key: "_id", Value: true}}
+	for _, f := range am.fields {
+		or = append(or, bson.D{{Key: f.target, Value: bson.D{{Key: "$not", Value: bson.D{{Key: "$type", Value: "decimal"}}}}}})
+		pro = append(pro, bson.E{Key: f.source, Value: true})
+	}
+
+	ctx := context.Background()
+	cr, err := col.Find(ctx, bson.D{{Key: "$or", Value: or}}, options.Find().SetProjection(pro).SetBatchSize(migrationBatchSize))
+	if err != nil {
+		return err
+	}
+	defer db.closeCursor(cr)
+
+	var total int
+	batch := make([]mongo.WriteModel, 0, migrationBatchSize)
+	for cr.Next(ctx) {
+		set := make(bson.D, 0, len(am.fields))
+		for _, f := range am.fields {
+			set = append(set, bson.E{Key: f.target, Value: db.migrationAmount(am.collection, cr.Current, f.source)})
+		}
+
+		upd := bson.D{{Key: "$set", Value: set}}
+		if len(am.unset) > 0 {
+			uns := make(bson.D, len(am.unset))
+			for i, name := range am.unset {
+				uns[i] = bson.E{Key: name, Value: ""}
+			}
+			upd = append(upd, bson.E{Key: "$unset", Value: uns})
+		}
+
+		batch = append(batch, mongo.NewUpdateOneModel().SetFilter(bson.D{{Key: "_id", Value: cr.Current.Lookup("_id")}}).SetUpdate(upd))
+		if len(batch) < migrationBatchSize {
+			continue
+		}
+
+		if _, err := col.BulkWrite(ctx, batch, options.BulkWrite().SetOrdered(false)); err != nil {
+			return err
+		}
+		total += len(batch)
+		batch = batch[:0]
+		db.log.Infof("%d %s converted to exact amounts", total, am.collection)
+	}
+	if err := cr.Err(); err != nil {
+		return err
+	}
+
+	if len(batch) > 0 {
+		if _, err := col.BulkWrite(ctx, batch, options.BulkWrite().SetOrdered(false)); err != nil {
+			return err
+		}
+		total += len(batch)
+	}
+	db.log.Noticef("%d %s converted to exact amounts", total, am.collection)
+	return nil
+}
+
+// migrationAmount decodes the hex encoded amount of the given source field.
+// Missing and invalid amounts are converted to zero. Amounts beyond the Decimal128 precision
+// are truncated; the exact amount is kept in the source field.
+func (db *MongoDbBridge) migrationAmount(collection string, doc bson.Raw, source string) interface{} {
+	hex, ok := doc.Lookup(source).StringValueOK()
+	if !ok {
+		return types.DecimalZero
+	}
+
+	val, err := types.DecimalFromHex(hex)
+	if err == types.ErrDecimalOverflow {
+		db.log.Warningf("%s amount %s of %s truncated; %s", source, hex, collection, err.Error())
+		return val
+	}
+	if err != nil {
+		db.log.Warningf("invalid %s amount %s of %s; %s", source, hex, collection, err.Error())
+		return types.DecimalZero
+	}
+	return val
+}
+
+// migrateSwapAmounts restores the decimal correction of swap amounts and reserves.
+func (db *MongoDbBridge) migrateSwapAmounts() error {
+	col := db.client.Database(db.dbName).Collection(coUniswap)
+
+	for field, cr := range exactSwapAmounts {
+		corr, err := types.DecimalFromBig(cr)
+		if err != nil {
+			return err
+		}
+
+		res, err := col.UpdateMany(context.Background(), bson.D{
+			{Key: field, Value: bson.D{
+				{Key: "$exists", Value: true},
+				{Key: "$not", Value: bson.D{{Key: "$type", Value: "decimal"}}},
+			}},
+		}, mongo.Pipeline{
+			{{Key: "$set", Value: bson.D{
+				{Key: field, Value: bson.D{{Key: "$multiply", Value: bson.A{
+					bson.D{{Key: "$toDecimal", Value: "$" + field}},
+					corr,
+				}}}},
+			}}},
+		})
+		if err != nil {
+			return err
+		}
+		db.log.Noticef("%d swap %s converted to exact amounts", res.ModifiedCount, field)
+	}
+	return nil
+}
+
+// migrateAmountAggregates rebuilds the aggregates of converted amounts.
+func (db *MongoDbBridge) migrateAmountAggregates() error {
+	burns, err := db.BurnCount()
+	if err != nil {
+		return err
+	}
+
+	if burns > 0 {
+		total, err := db.BurnTotalSlow()
+		if err != nil {
+			return err
+		}
+
+		amount, err := types.DecimalFromBig(total)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.client.Database(db.dbName).Collection(colBurnsAggregate).UpdateByID(context.Background(), burnBaseAggregateDate, bson.D{
+			{Key: "$set", Value: bson.D{{Key: "amount", Value: amount}}},
+		}, options.Update().SetUpsert(true))
+		if err != nil {
+			return err
+		}
+
+		if err := db.FeeFlowAggregateUpdate(time.Unix(0, 0).UTC(), time.Now().UTC()); err != nil {
+			return err
+		}
+	}
+
+	trx, err := db.TransactionsCount()
+	if err != nil {
+		return err
+	}
+	if trx > 0 {
+		return db.TrxDailyFlowUpdate(time.Unix(0, 0).UTC())
+	}
+	return nil
+}
+
+// migrateTransactionFilters adds method selectors to stored contract calls and creates indexes
+// of filtered transaction lists. Selectors of calls with large input can not be restored
+// since the input is not stored.
+func (db *MongoDbBridge) migrateTransactionFilters() error {
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+
+	ctx := context.Background()
+	cr, err := col.Find(ctx, bson.D{
+		{Key: fiTransactionRecipient, Value: bson.D{{Key: "$ne", Value: nil}}},
+		{Key: fiTransactionInput, Value: bson.D{{Key: "$type", Value: "binData"}}},
+		{Key: fiTransactionSelector, Value: bson.D{{Key: "$exists", Value: false}}},
+	}, options.Find().SetProjection(bson.D{
+		{Key: fiTransactionRecipient, Value: true},
+		{Key: fiTransactionInput, Value: true},
+	}).SetBatchSize(migrationBatchSize))
+	if err != nil {
+		return err
+	}
+	defer db.closeCursor(cr)
+
+	var total int
+	batch := make([]mongo.WriteModel, 0, migrationBatchSize)
+	for cr.Next(ctx) {
+		var row struct {
+			Hash  string `bson:"_id"`
+			To    string `bson:"to"`
+			Input []byte `bson:"input"`
+		}
+		if err := cr.Decode(&row); err != nil {
+			return err
+		}
+
+		to := common.HexToAddress(row.To)
+		sel := types.TrxSelectorOf(&to, row.Input)
+		if sel == nil {
+			continue
+		}
+
+		batch = append(batch, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: fiTransactionPk, Value: row.Hash}}).
+			SetUpdate(bson.D{{Key: "$set", Value: bson.D{{Key: fiTransactionSelector, Value: sel.String()}}}}))
+		if len(batch) < migrationBatchSize {
+			continue
+		}
+
+		if _, err := col.BulkWrite(ctx, batch, options.BulkWrite().SetOrdered(false)); err != nil {
+			return err
+		}
+		total += len(batch)
+		batch = batch[:0]
+		db.log.Infof("%d transaction selectors added", total)
+	}
+	if err := cr.Err(); err != nil {
+		return err
+	}
+
+	if len(batch) > 0 {
+		if _, err := col.BulkWrite(ctx, batch, options.BulkWrite().SetOrdered(false)); err != nil {
+			return err
+		}
+		total += len(batch)
+	}
+	db.log.Noticef("%d transaction selectors added", total)
+
+	// empty collection gets the indexes on the first transaction
+	trx, err := db.TransactionsCount()
+	if err != nil || trx == 0 {
+		return err
+	}
+	_, err = col.Indexes().CreateMany(ctx, transactionFilterIndexes())
+	return err
+}
@@ -0,0 +1,133 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"math/big"
+)
+
+// RevertBlocks removes all the data collected from blocks starting at the given block number
+// from the database. It's used to clean up after chain reorganization, when the blocks
+// became orphaned and the canonical chain is re-ingested. Hashes of all the removed
+// transactions are returned, so they can be evicted from any other storage.
+func (db *MongoDbBridge) RevertBlocks(from uint64) ([]common.Hash, error) {
+	// collect the transactions we are about to remove
+	trx, err := db.revertedTransactions(from)
+	if err != nil {
+		return nil, err
+	}
+
+	// remove data linked to the transactions; it may not reference the block directly
+	if len(trx) > 0 {
+		in := bson.D{{Key: "$in", Value: trx}}
+		db.revertDocuments(colErcTransactions, bson.D{{Key: "trx", Value: in}})
+		db.revertDocuments(colRewards, bson.D{{Key: "_id", Value: in}})
+		db.revertDocuments(colDelegations, bson.D{{Key: "trx", Value: in}})
+	}
+
+	// remove block based data
+	db.revertBurns(from)
+	db.revertDocuments(colInternalTransactions, bson.D{{Key: types.FiInternalTrxBlock, Value: bson.D{{Key: "$gte", Value: from}}}})
+	db.revertDocuments(colContractEvents, bson.D{{Key: fiContractEventBlock, Value: bson.D{{Key: "$gte", Value: from}}}})
+	db.revertDocuments(coUniswap, bson.D{{Key: fiSwapBlock, Value: bson.D{{Key: "$gte", Value: from}}}})
+	db.revertDocuments(coTransactions, bson.D{{Key: fiTransactionBlock, Value: bson.D{{Key: "$gte", Value: from}}}})
+	db.revertDocuments(colBlocks, bson.D{{Key: fiBlockPk, Value: bson.D{{Key: "$gte", Value: from}}}})
+
+	// failed processing of the orphaned blocks must not be retried
+	db.revertDocuments(colDeadLetters, bson.D{{Key: "blk", Value: bson.D{{Key: "$gte", Value: from}}}})
+
+	// convert the list of removed transactions
+	list := make([]common.Hash, len(trx))
+	for i, h := range trx {
+		list[i] = common.HexToHash(h)
+	}
+	return list, nil
+}
+
+// revertedTransactions loads hashes of all the transactions stored for blocks starting at the given number.
+func (db *MongoDbBridge) revertedTransactions(from uint64) ([]string, error) {
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+	ctx := context.Background()
+
+	ld, err := col.Find(ctx,
+		bson.D{{Key: fiTransactionBlock, Value: bson.D{{Key: "$gte", Value: from}}}},
+		options.Find().SetProjection(bson.D{{Key: fiTransactionPk, Value: true}}))
+	if err != nil {
+		db.log.Errorf("can not load reverted transactions; %s", err.Error())
+		return nil, err
+	}
+	defer db.closeCursor(ld)
+
+	list := make([]string, 0)
+	for ld.Next(ctx) {
+		var row struct {
+			Hash string `bson:"_id"`
+		}
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode reverted transaction; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, row.Hash)
+	}
+	return list, nil
+}
+
+// revertBurns removes burns of reverted blocks and adjusts the burned total aggregate.
+func (db *MongoDbBridge) revertBurns(from uint64) {
+	col := db.client.Database(db.dbName).Collection(colBurns)
+	filter := bson.D{{Key: "block", Value: bson.D{{Key: "$gte", Value: int64(from)}}}}
+
+	// calculate the amount removed from the total
+	cr, err := col.Aggregate(context.Background(), mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: nil},
+			{Key: "amount", Value: bson.D{{Key: "$sum", Value: "$amount"}}},
+		}}},
+	})
+	if err != nil {
+		db.log.Errorf("can not aggregate reverted burns; %s", err.Error())
+		return
+	}
+	defer db.closeCursor(cr)
+
+	var row struct {
+		Amount bson.RawValue `bson:"amount"`
+	}
+	amount := new(big.Int)
+	if cr.Next(context.Background()) {
+		if err := cr.Decode(&row); err != nil {
+			db.log.Errorf("can not decode reverted burns; %s", err.Error())
+			return
+		}
+		if amount, err = types.BigFromDecimalValue(row.Amount); err != nil {
+			db.log.Errorf("can not read reverted burns amount; %s", err.Error())
+			return
+		}
+	}
+
+	// remove the burns and subtract the amount from the total
+	if db.revertDocuments(colBurns, filter) > 0 && amount.Sign() != 0 {
+		db.burnAddBurnValue(amount.Neg(amount))
+	}
+}
+
+// revertDocuments removes documents matching the given filter from the collection.
+// It returns the number of documents removed.
+func (db *MongoDbBridge) revertDocuments(name string, filter bson.D) int64 {
+	res, err := db.client.Database(db.dbName).Collection(name).DeleteMany(context.Background(), filter)
+	if err != nil {
+		db.log.Errorf("can not revert %s; %s", name, err.Error())
+		return 0
+	}
+
+	if res.DeletedCount > 0 {
+		db.log.Noticef("%d documents reverted in %s", res.DeletedCount, name)
+	}
+	return res.DeletedCount
+}
@@ -0,0 +1,379 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"hash"
+	"io"
+	"time"
+)
+
+const (
+	// snapshotVersion represents the version of the snapshot archive layout.
+	// Archives of a different version are refused on import.
+	snapshotVersion = 2
+
+	// snapshotMinVersion represents the oldest version of the snapshot archive accepted on import.
+	// Archives of version 1 don't keep the applied migrations; all of them are applied after import.
+	snapshotMinVersion = 1
+
+	// snapshotManifestName is the name of the archive entry containing the snapshot manifest.
+	snapshotManifestName = "manifest.json"
+
+	// snapshotInsertBatchSize represents the number of documents inserted in a single call on import.
+	snapshotInsertBatchSize = 1000
+
+	// snapshotMaxDocumentSize represents the max size of a document accepted on import.
+	snapshotMaxDocumentSize = 16 * 1024 * 1024
+)
+
+// snapshotCollections represents the list of collections the repository owns and exports to snapshots.
+// The configuration, checkpoints and migrations go first, so the ingestion checkpoints of the snapshot never pass
+// the exported data; blocks indexed while the export is in progress are re-scanned after import.
+var snapshotCollections = []string{
+	coConfiguration,
+	colCheckpoints,
+	colMigrations,
+	colBlocks,
+	coTransactions,
+	coAccounts,
+	coContract,
+	colContractEvents,
+	colInternalTransactions,
+	colErcTransactions,
+	colFMintTransactions,
+	coUniswap,
+	colDelegations,
+	colLockedDelegations,
+	colWithdrawals,
+	colRewards,
+	colEpochs,
+	colGasPrice,
+	colBurns,
+	colBurnsAggregate,
+	colFeeFlowAggregate,
+	coTransactionVolume,
+	colNetworkNodes,
+	colDeadLetters,
+	colFunctionSignatures,
+}
+
+// SnapshotManifest represents the description of the content of a snapshot archive.
+type SnapshotManifest struct {
+	Version        int                         `json:"version"`
+	Created        time.Time                   `json:"created"`
+	LastKnownBlock uint64                      `json:"lastKnownBlock"`
+	Checkpoints    map[string]types.Checkpoint `json:"checkpoints"`
+	Collections    []SnapshotCollection        `json:"collections"`
+}
+
+// SnapshotCollection represents a collection stored in a snapshot archive.
+type SnapshotCollection struct {
+	Name      string `json:"name"`
+	Documents uint64 `json:"documents"`
+	Checksum  string `json:"sha256"`
+}
+
+// ExportSnapshot writes a snapshot archive of all the collections owned by the repository into the given writer.
+// Each collection is stored as a stream of BSON documents; the manifest with the collections' checksums
+// and the ingestion checkpoints is added at the end of the archive.
+func (db *MongoDbBridge) ExportSnapshot(w io.Writer) (*SnapshotManifest, error) {
+	lnb, err := db.LastKnownBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	cps, err := db.Checkpoints()
+	if err != nil {
+		return nil, err
+	}
+
+	man := SnapshotManifest{
+		Version:        snapshotVersion,
+		Created:        time.Now().UTC(),
+		LastKnownBlock: lnb,
+		Checkpoints:    cps,
+		Collections:    make([]SnapshotCollection, 0, len(snapshotCollections)),
+	}
+
+	zw := zip.NewWriter(w)
+	for _, name := range snapshotCollections {
+		sc, err := db.exportCollection(zw, name)
+		if err != nil {
+			db.log.Errorf("can not export collection %s; %s", name, err.Error())
+			return nil, err
+		}
+
+		man.Collections = append(man.Collections, *sc)
+		db.log.Noticef("exported %d documents of %s", sc.Documents, name)
+	}
+
+	// add the manifest
+	mw, err := zw.Create(snapshotManifestName)
+	if err != nil {
+		return nil, err
+	}
+
+	enc := json.NewEncoder(mw)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(&man); err != nil {
+		return nil, err
+	}
+	return &man, zw.Close()
+}
+
+// exportCollection writes all the documents of the given collection into a new archive entry.
+func (db *MongoDbBridge) exportCollection(zw *zip.Writer, name string) (*SnapshotCollection, error) {
+	ew, err := zw.Create(snapshotEntryName(name))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	ld, err := db.client.Database(db.dbName).Collection(name).Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	defer db.closeCursor(ld)
+
+	sum := sha256.New()
+	out := io.MultiWriter(ew, sum)
+
+	sc := SnapshotCollection{Name: name}
+	for ld.Next(ctx) {
+		if _, err := out.Write(ld.Current); err != nil {
+			return nil, err
+		}
+		sc.Documents++
+	}
+	if ld.Err() != nil {
+		return nil, ld.Err()
+	}
+
+	sc.Checksum = hex.EncodeToString(sum.Sum(nil))
+	return &sc, nil
+}
+
+// ImportSnapshot restores the snapshot archive provided by the given reader into the database.
+// The whole archive is verified against the manifest before any document is written and the import
+// is refused if any of the collections owned by the repository already contains data.
+// Indexes of the restored collections are created once all the documents are inserted.
+func (db *MongoDbBridge) ImportSnapshot(r io.ReaderAt, size int64) (*SnapshotManifest, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	man, err := snapshotManifest(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify the archive content
+	for _, sc := range man.Collections {
+		if err := verifySnapshotCollection(zr, &sc); err != nil {
+			db.log.Errorf("snapshot collection %s invalid; %s", sc.Name, err.Error())
+			return nil, err
+		}
+	}
+
+	// wait for the index sync started with the bridge, so it does not collide with the restore
+	db.wg.Wait()
+	if err := db.checkEmptyDatabase(); err != nil {
+		return nil, err
+	}
+
+	// migrations applied on the empty database are replaced by the migrations of the snapshot
+	if err := db.client.Database(db.dbName).Collection(colMigrations).Drop(context.Background()); err != nil {
+		db.log.Errorf("can not reset migrations; %s", err.Error())
+		return nil, err
+	}
+
+	names := make([]string, 0, len(man.Collections))
+	for _, sc := range man.Collections {
+		if err := db.importCollection(zr, &sc); err != nil {
+			db.log.Errorf("can not import collection %s; %s", sc.Name, err.Error())
+			return nil, err
+		}
+
+		names = append(names, sc.Name)
+		db.log.Noticef("imported %d documents of %s", sc.Documents, sc.Name)
+	}
+
+	// apply migrations the snapshot data did not pass yet
+	if err := db.migrateDatabase(); err != nil {
+		db.log.Errorf("can not migrate the imported snapshot; %s", err.Error())
+		return nil, err
+	}
+
+	// create indexes of the restored collections
+	db.initCollections(names)
+	sig := make(chan bool, 1)
+	for cn, ld := range ixLoaders {
+		if err := db.updateIndexes(db.client.Database(db.dbName).Collection(cn), ld(), sig); err != nil {
+			db.log.Errorf("%s index list sync failed; %s", cn, err.Error())
+			return nil, err
+		}
+	}
+	return man, nil
+}
+
+// snapshotManifest loads and validates the manifest of the given snapshot archive.
+func snapshotManifest(zr *zip.Reader) (*SnapshotManifest, error) {
+	f, err := zr.Open(snapshotManifestName)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot manifest not found; %s", err.Error())
+	}
+	defer func() { _ = f.Close() }()
+
+	var man SnapshotManifest
+	if err := json.NewDecoder(f).Decode(&man); err != nil {
+		return nil, fmt.Errorf("snapshot manifest invalid; %s", err.Error())
+	}
+
+	if man.Version < snapshotMinVersion || man.Version > snapshotVersion {
+		return nil, fmt.Errorf("snapshot version %d not supported, expected version %d to %d", man.Version, snapshotMinVersion, snapshotVersion)
+	}
+
+	// make sure we know all the collections of the snapshot
+	for _, sc := range man.Collections {
+		if !isSnapshotCollection(sc.Name) {
+			return nil, fmt.Errorf("unknown snapshot collection %s", sc.Name)
+		}
+	}
+	return &man, nil
+}
+
+// verifySnapshotCollection checks the content of the collection entry against the manifest.
+func verifySnapshotCollection(zr *zip.Reader, sc *SnapshotCollection) error {
+	sum := sha256.New()
+	var count uint64
+
+	err := readSnapshotCollection(zr, sc.Name, sum, func(_ bson.Raw) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if count != sc.Documents {
+		return fmt.Errorf("expected %d documents, found %d", sc.Documents, count)
+	}
+	if hex.EncodeToString(sum.Sum(nil)) != sc.Checksum {
+		return fmt.Errorf("checksum mismatch")
+	}
+	return nil
+}
+
+// importCollection inserts the documents of the given snapshot collection into the database.
+func (db *MongoDbBridge) importCollection(zr *zip.Reader, sc *SnapshotCollection) error {
+	col := db.client.Database(db.dbName).Collection(sc.Name)
+	batch := make([]interface{}, 0, snapshotInsertBatchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := col.InsertMany(context.Background(), batch); err != nil {
+			return err
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	err := readSnapshotCollection(zr, sc.Name, sha256.New(), func(doc bson.Raw) error {
+		batch = append(batch, doc)
+		if len(batch) < snapshotInsertBatchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// checkEmptyDatabase makes sure none of the collections owned by the repository contains any data.
+// Migrations are recorded on an empty database, too, so they are not checked.
+func (db *MongoDbBridge) checkEmptyDatabase() error {
+	database := db.client.Database(db.dbName)
+	for _, name := range snapshotCollections {
+		if name == colMigrations {
+			continue
+		}
+		count, err := database.Collection(name).CountDocuments(context.Background(), bson.D{})
+		if err != nil {
+			db.log.Errorf("can not count documents of %s; %s", name, err.Error())
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("database %s is not empty, found %d documents in %s", db.dbName, count, name)
+		}
+	}
+	return nil
+}
+
+// readSnapshotCollection reads the documents of the given collection from the snapshot archive
+// and passes them to the given callback. The raw content of the entry is written into the hash.
+func readSnapshotCollection(zr *zip.Reader, name string, sum hash.Hash, fn func(bson.Raw) error) error {
+	f, err := zr.Open(snapshotEntryName(name))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	in := io.TeeReader(f, sum)
+	head := make([]byte, 4)
+	for {
+		// each document starts with its total length
+		if _, err := io.ReadFull(in, head); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		size := binary.LittleEndian.Uint32(head)
+		if size < 5 || size > snapshotMaxDocumentSize {
+			return fmt.Errorf("invalid document size %d", size)
+		}
+
+		doc := make([]byte, size)
+		copy(doc, head)
+		if _, err := io.ReadFull(in, doc[4:]); err != nil {
+			return err
+		}
+
+		if err := bson.Raw(doc).Validate(); err != nil {
+			return err
+		}
+		if err := fn(doc); err != nil {
+			return err
+		}
+	}
+}
+
+// snapshotEntryName provides the name of the archive entry of the given collection.
+func snapshotEntryName(name string) string {
+	return name + ".bson"
+}
+
+// isSnapshotCollection checks if the given collection is owned by the repository.
+func isSnapshotCollection(name string) bool {
+	for _, cn := range snapshotCollections {
+		if cn == name {
+			return true
+		}
+	}
+	return false
+}
@@ -39,6 +39,24 @@ const (
 
 	// fiTransactionTimeStamp is the name of the field of the transaction time stamp.
 	fiTransactionTimeStamp = "stamp"
+
+	// fiTransactionAmount is the name of the field of the exact transaction value in WEI.
+	fiTransactionAmount = "amo"
+
+	// fiTransactionGasPrice is the name of the field of the exact gas price in WEI.
+	fiTransactionGasPrice = "gas_wei"
+
+	// fiTransactionStatus is the name of the field of the transaction status.
+	fiTransactionStatus = "stat"
+
+	// fiTransactionContract is the name of the address field of the contract created by the transaction.
+	fiTransactionContract = "contr"
+
+	// fiTransactionSelector is the name of the field of the called contract method selector.
+	fiTransactionSelector = "sel"
+
+	// fiTransactionInput is the name of the field of the transaction input data.
+	fiTransactionInput = "input"
 )
 
 // initTransactionsCollection initializes the transaction collection with
@@ -81,6 +99,9 @@ func (db *MongoDbBridge) initTransactionsCollection(col *mongo.Collection) {
 		},
 	})
 
+	// indexes of filtered transaction lists
+	ix = append(ix, transactionFilterIndexes()...)
+
 	// create indexes
 	if _, err := col.Indexes().CreateMany(context.Background(), ix); err != nil {
 		db.log.Panicf("can not create indexes for transaction collection; %s", err.Error())
@@ -90,6 +111,37 @@ func (db *MongoDbBridge) initTransactionsCollection(col *mongo.Collection) {
 	db.log.Debugf("transactions collection initialized")
 }
 
+// transactionFilterIndexes provides indexes of the filtered transaction lists.
+// Each index ends with the ordinal index, so the filtered list can be sorted by the index.
+func transactionFilterIndexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: fiTransactionSender, Value: 1}, {Key: fiTransactionStatus, Value: 1}, {Key: fiTransactionOrdinalIndex, Value: -1}},
+			Options: options.Index().SetName("from_stat_orx"),
+		},
+		{
+			Keys:    bson.D{{Key: fiTransactionRecipient, Value: 1}, {Key: fiTransactionStatus, Value: 1}, {Key: fiTransactionOrdinalIndex, Value: -1}},
+			Options: options.Index().SetName("to_stat_orx"),
+		},
+		{
+			Keys:    bson.D{{Key: fiTransactionRecipient, Value: 1}, {Key: fiTransactionSelector, Value: 1}, {Key: fiTransactionOrdinalIndex, Value: -1}},
+			Options: options.Index().SetName("to_sel_orx"),
+		},
+		{
+			Keys:    bson.D{{Key: fiTransactionSelector, Value: 1}, {Key: fiTransactionOrdinalIndex, Value: -1}},
+			Options: options.Index().SetName("sel_orx"),
+		},
+		{
+			Keys:    bson.D{{Key: fiTransactionContract, Value: 1}, {Key: fiTransactionOrdinalIndex, Value: -1}},
+			Options: options.Index().SetName("contr_orx"),
+		},
+		{
+			Keys:    bson.D{{Key: fiTransactionAmount, Value: 1}, {Key: fiTransactionOrdinalIndex, Value: -1}},
+			Options: options.Index().SetName("amo_orx"),
+		},
+	}
+}
+
 // shouldAddTransaction validates if the transaction should be added to the persistent storage.
 func (db *MongoDbBridge) shouldAddTransaction(col *mongo.Collection, trx *types.Transaction) bool {
 	// check if the transaction already exists
@@ -389,6 +441,16 @@ func (db *MongoDbBridge) TransactionsCount() (uint64, error) {
 	return db.EstimateCount(db.client.Database(db.dbName).Collection(coTransactions))
 }
 
+// FinalizedTransactions pulls list of transactions included in blocks up to the given finalized block.
+func (db *MongoDbBridge) FinalizedTransactions(cursor *string, count int32, fin uint64) (*types.TransactionList, error) {
+	return db.Transactions(cursor, count, &bson.D{{Key: fiTransactionBlock, Value: bson.D{{Key: "$lte", Value: fin}}}})
+}
+
+// TransactionsFiltered pulls list of transactions matching the given filter starting on the specified cursor.
+func (db *MongoDbBridge) TransactionsFiltered(tf *types.TransactionFilter, cursor *string, count int32) (*types.TransactionList, error) {
+	return db.Transactions(cursor, count, transactionFilter(tf))
+}
+
 // Transactions pulls list of transaction hashes starting on the specified cursor.
 func (db *MongoDbBridge) Transactions(cursor *string, count int32, filter *bson.D) (*types.TransactionList, error) {
 	// nothing to load?
@@ -0,0 +1,125 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"fantom-api-graphql/internal/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"math/big"
+)
+
+// trxOrdinalBlockMask represents the part of the transaction ordinal index keeping the index in the block.
+const trxOrdinalBlockMask = 0x3fff
+
+// transactionFilter builds the database filter of transactions matching the given filter.
+// The conditions are joined by $and so the list loader can extend the filter with the ordinal range.
+func transactionFilter(tf *types.TransactionFilter, base ...bson.D) *bson.D {
+	cond := make(bson.A, 0, len(base))
+	for _, b := range base {
+		cond = append(cond, b)
+	}
+	if tf != nil {
+		cond = append(cond, transactionFilterConditions(tf)...)
+	}
+
+	if len(cond) == 0 {
+		return &bson.D{}
+	}
+	return &bson.D{{Key: "$and", Value: cond}}
+}
+
+// transactionFilterConditions provides the list of conditions of the given transaction filter.
+func transactionFilterConditions(tf *types.TransactionFilter) bson.A {
+	cond := make(bson.A, 0)
+
+	// parties of the transaction
+	if tf.From != nil {
+		cond = append(cond, bson.D{{Key: fiTransactionSender, Value: tf.From.String()}})
+	}
+	if tf.To != nil {
+		cond = append(cond, bson.D{{Key: fiTransactionRecipient, Value: tf.To.String()}})
+	}
+	if tf.Party != nil {
+		cond = append(cond, bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: fiTransactionSender, Value: tf.Party.String()}},
+			bson.D{{Key: fiTransactionRecipient, Value: tf.Party.String()}},
+		}}})
+	}
+
+	// contract deployment
+	if tf.ContractCreated != nil {
+		op := "$eq"
+		if *tf.ContractCreated {
+			op = "$ne"
+		}
+		cond = append(cond, bson.D{{Key: fiTransactionContract, Value: bson.D{{Key: op, Value: nil}}}})
+	}
+
+	// the block range is applied to the ordinal index, which starts with the block number
+	if rng := trxFilterRange(trxFilterBlockOrdinal(tf.MinBlock, 0), trxFilterBlockOrdinal(tf.MaxBlock, trxOrdinalBlockMask)); rng != nil {
+		cond = append(cond, bson.D{{Key: fiTransactionOrdinalIndex, Value: rng}})
+	}
+
+	// time range
+	var minTime, maxTime interface{}
+	if tf.MinTime != nil {
+		minTime = tf.MinTime.UTC()
+	}
+	if tf.MaxTime != nil {
+		maxTime = tf.MaxTime.UTC()
+	}
+	if rng := trxFilterRange(minTime, maxTime); rng != nil {
+		cond = append(cond, bson.D{{Key: fiTransactionTimeStamp, Value: rng}})
+	}
+
+	// amounts are compared exactly as Decimal128 values
+	if rng := trxFilterRange(trxFilterAmount(tf.MinValue), trxFilterAmount(tf.MaxValue)); rng != nil {
+		cond = append(cond, bson.D{{Key: fiTransactionAmount, Value: rng}})
+	}
+	if rng := trxFilterRange(trxFilterAmount(tf.MinGasPrice), trxFilterAmount(tf.MaxGasPrice)); rng != nil {
+		cond = append(cond, bson.D{{Key: fiTransactionGasPrice, Value: rng}})
+	}
+
+	// status and called method
+	if tf.Status != nil {
+		cond = append(cond, bson.D{{Key: fiTransactionStatus, Value: *tf.Status}})
+	}
+	if tf.Selector != nil {
+		cond = append(cond, bson.D{{Key: fiTransactionSelector, Value: tf.Selector.String()}})
+	}
+	return cond
+}
+
+// trxFilterRange builds the condition of a value range; nil borders are not applied.
+func trxFilterRange(min interface{}, max interface{}) bson.D {
+	var rng bson.D
+	if min != nil {
+		rng = append(rng, bson.E{Key: "$gte", Value: min})
+	}
+	if max != nil {
+		rng = append(rng, bson.E{Key: "$lte", Value: max})
+	}
+	return rng
+}
+
+// trxFilterBlockOrdinal provides the ordinal index border of the given block, if any.
+func trxFilterBlockOrdinal(blk *uint64, ix uint64) interface{} {
+	if blk == nil {
+		return nil
+	}
+	return int64((*blk<<14)|ix) & 0x7FFFFFFFFFFFFFFF
+}
+
+// trxFilterAmount provides the Decimal128 border of the given amount, if any.
+// Borders beyond the Decimal128 precision are truncated; stored amounts never exceed
+// the precision, so the truncated border matches the same transactions.
+func trxFilterAmount(val *big.Int) interface{} {
+	if val == nil {
+		return nil
+	}
+
+	d, err := types.DecimalFromBig(val)
+	if err != nil && err != types.ErrDecimalOverflow {
+		return nil
+	}
+	return d
+}
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+	"math/big"
+	"testing"
+)
+
+func TestTransactionFilter(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	minAmount, _ := types.DecimalFromBig(big.NewInt(1000))
+
+	// no conditions, no filter
+	g.Expect(*transactionFilter(nil)).To(gomega.Equal(bson.D{}))
+	g.Expect(*transactionFilter(&types.TransactionFilter{})).To(gomega.Equal(bson.D{}))
+
+	// failed calls of a contract method in a block range above the given value
+	to := common.HexToAddress("0x5aa5")
+	fail := uint64(0)
+	minBlk, maxBlk := uint64(10), uint64(12)
+	sel := types.TrxSelector{0xa9, 0x05, 0x9c, 0xbb}
+	base := bson.D{{Key: "from", Value: "0x01"}}
+
+	g.Expect(*transactionFilter(&types.TransactionFilter{
+		To:       &to,
+		MinValue: big.NewInt(1000),
+		MinBlock: &minBlk,
+		MaxBlock: &maxBlk,
+		Status:   &fail,
+		Selector: &sel,
+	}, base)).To(gomega.Equal(bson.D{{Key: "$and", Value: bson.A{
+		base,
+		bson.D{{Key: "to", Value: to.String()}},
+		bson.D{{Key: "orx", Value: bson.D{{Key: "$gte", Value: int64(10 << 14)}, {Key: "$lte", Value: int64(12<<14 | 0x3fff)}}}},
+		bson.D{{Key: "amo", Value: bson.D{{Key: "$gte", Value: minAmount}}}},
+		bson.D{{Key: "stat", Value: uint64(0)}},
+		bson.D{{Key: "sel", Value: "0xa9059cbb"}},
+	}}}))
+}
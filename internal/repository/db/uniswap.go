@@ -38,13 +38,11 @@ const (
 	fiSwapAmount1out = "am1out"
 	fiSwapReserve0   = "reserve0"
 	fiSwapReserve1   = "reserve1"
-)
-
-// swapAmountDecimalsCorrection represents the decimal correction on swap value.
-var swapAmountDecimalsCorrection = new(big.Int).SetUint64(1000000000)
 
-// swapReserveDecimalsCorrection represents the decimal correction on swap reserve amount.
-var swapReserveDecimalsCorrection = new(big.Int).SetUint64(1000000000000)
+	// fiSwapExactSuffix is the suffix of the fields keeping the exact hex encoded amounts
+	// which do not fit into the Decimal128 precision.
+	fiSwapExactSuffix = "_hex"
+)
 
 // getHash generates hash for swap from transaction hash and pair address
 func getHash(swap *types.Swap) *common.Hash {
@@ -55,14 +53,13 @@ func getHash(swap *types.Swap) *common.Hash {
 	return &swapHash
 }
 
-// removeDecimals applies decimal correction to the given value.
-func removeDecimals(am *big.Int, cr *big.Int) uint64 {
-	return new(big.Int).Div(am, cr).Uint64()
-}
-
-// removes decimal correction from the given value.
-func returnDecimals(am *big.Int, cr *big.Int) *big.Int {
-	return new(big.Int).Mul(am, cr)
+// decodeSwapAmount decodes the given stored swap amount; invalid amounts are decoded as zero.
+func decodeSwapAmount(d primitive.Decimal128) hexutil.Big {
+	v, err := types.BigFromDecimal(d)
+	if err != nil {
+		return hexutil.Big{}
+	}
+	return hexutil.Big(*v)
 }
 
 // initUniswapCollection initializes the swap collection with
@@ -109,9 +106,8 @@ func isZeroSwap(swap *types.Swap) bool {
 	if swap.Type == types.SwapSync {
 		return false
 	}
-	am0small := removeDecimals(new(big.Int).Add(swap.Amount0In, swap.Amount0Out), swapAmountDecimalsCorrection)
-	am1small := removeDecimals(new(big.Int).Add(swap.Amount1In, swap.Amount1Out), swapAmountDecimalsCorrection)
-	return am0small == 0 || am1small == 0
+	return new(big.Int).Add(swap.Amount0In, swap.Amount0Out).Sign() == 0 ||
+		new(big.Int).Add(swap.Amount1In, swap.Amount1Out).Sign() == 0
 }
 
 // UniswapAdd stores a swap reference in connected persistent storage.
@@ -126,7 +122,7 @@ func (db *MongoDbBridge) UniswapAdd(swap *types.Swap) error {
 
 	// check for zero amounts in the swap, because of future div by 0 during aggregation in db
 	if isZeroSwap(swap) {
-		db.log.Debugf("swap from block %d will not be added, because swap amount is 0 after removing decimals", uint64(*swap.BlockNumber))
+		db.log.Debugf("swap from block %d will not be added, because swap amount is 0", uint64(*swap.BlockNumber))
 		return nil
 	}
 
@@ -141,7 +137,7 @@ func (db *MongoDbBridge) UniswapAdd(swap *types.Swap) error {
 
 	// try to do the insert
 	if _, err := col.InsertOne(context.Background(),
-		swapData(&bson.D{
+		db.swapData(&bson.D{
 			{Key: fiSwapPk, Value: swapHash.String()},
 			{Key: fiSwapBlock, Value: uint64(*swap.BlockNumber)},
 			{Key: fiSwapOrdIndex, Value: swap.OrdIndex},
@@ -163,7 +159,7 @@ func (db *MongoDbBridge) UniswapAdd(swap *types.Swap) error {
 }
 
 // swapData collects the data for the given swap.
-func swapData(base *bson.D, swap *types.Swap) bson.D {
+func (db *MongoDbBridge) swapData(base *bson.D, swap *types.Swap) bson.D {
 	// make a new instance if needed
 	if base == nil {
 		base = &bson.D{}
@@ -175,16 +171,56 @@ func swapData(base *bson.D, swap *types.Swap) bson.D {
 		bson.E{Key: fiSwapTxHash, Value: swap.Hash.String()},
 		bson.E{Key: fiSwapPair, Value: swap.Pair.String()},
 		bson.E{Key: fiSwapSender, Value: swap.Sender.String()},
-		bson.E{Key: fiSwapAmount0in, Value: removeDecimals(swap.Amount0In, swapAmountDecimalsCorrection)},
-		bson.E{Key: fiSwapAmount0out, Value: removeDecimals(swap.Amount0Out, swapAmountDecimalsCorrection)},
-		bson.E{Key: fiSwapAmount1in, Value: removeDecimals(swap.Amount1In, swapAmountDecimalsCorrection)},
-		bson.E{Key: fiSwapAmount1out, Value: removeDecimals(swap.Amount1Out, swapAmountDecimalsCorrection)},
-		bson.E{Key: fiSwapReserve0, Value: removeDecimals(swap.Reserve0, swapReserveDecimalsCorrection)},
-		bson.E{Key: fiSwapReserve1, Value: removeDecimals(swap.Reserve1, swapReserveDecimalsCorrection)},
 	)
+
+	*base = db.appendSwapAmount(*base, fiSwapAmount0in, swap.Amount0In)
+	*base = db.appendSwapAmount(*base, fiSwapAmount0out, swap.Amount0Out)
+	*base = db.appendSwapAmount(*base, fiSwapAmount1in, swap.Amount1In)
+	*base = db.appendSwapAmount(*base, fiSwapAmount1out, swap.Amount1Out)
+	*base = db.appendSwapAmount(*base, fiSwapReserve0, swap.Reserve0)
+	*base = db.appendSwapAmount(*base, fiSwapReserve1, swap.Reserve1)
 	return *base
 }
 
+// appendSwapAmount adds the given amount of the swap to the document as a Decimal128 value.
+// Token amounts may not fit into the Decimal128 precision; the value is truncated in that case
+// and the exact amount is added in the hex encoded field with the fiSwapExactSuffix.
+func (db *MongoDbBridge) appendSwapAmount(doc bson.D, field string, v *big.Int) bson.D {
+	d, err := types.DecimalFromBig(v)
+	switch {
+	case err == types.ErrDecimalOverflow:
+		db.log.Warningf("swap %s %s truncated; %s", field, v.String(), err.Error())
+		return append(doc, bson.E{Key: field, Value: d}, bson.E{Key: field + fiSwapExactSuffix, Value: hexutil.EncodeBig(v)})
+	case err != nil:
+		db.log.Errorf("invalid swap %s %s; %s", field, v.String(), err.Error())
+	}
+	return append(doc, bson.E{Key: field, Value: d})
+}
+
+// swapStaleExact provides the exact amount fields of the given fields not set by the update.
+func swapStaleExact(set bson.D, fields ...string) bson.D {
+	known := make(map[string]bool, len(set))
+	for _, e := range set {
+		known[e.Key] = true
+	}
+
+	uns := make(bson.D, 0, len(fields))
+	for _, fi := range fields {
+		if !known[fi+fiSwapExactSuffix] {
+			uns = append(uns, bson.E{Key: fi + fiSwapExactSuffix, Value: ""})
+		}
+	}
+	return uns
+}
+
+// swapReserve decodes the reserve of a swap preferring the exact amount, if available.
+func swapReserve(d primitive.Decimal128, exact *string) (*big.Int, error) {
+	if exact != nil {
+		return hexutil.DecodeBig(*exact)
+	}
+	return types.BigFromDecimal(d)
+}
+
 // IsSwapKnown checks if swap document already exists in the database.
 func (db *MongoDbBridge) IsSwapKnown(col *mongo.Collection, hash *common.Hash, swap *types.Swap) (bool, error) {
 	// try to find swap in the database (it may already exist)
@@ -211,11 +247,15 @@ func (db *MongoDbBridge) IsSwapKnown(col *mongo.Collection, hash *common.Hash, s
 	// if swap is sync type, then update reserves
 	if swap.Type == types.SwapSync {
 		db.log.Debugf("Updating reserves for Swap %s", hash.String())
-		_, err := col.UpdateOne(context.Background(),
-			bson.M{fiSwapPk: hash.String()},
-			bson.D{
-				{Key: "$set", Value: bson.M{fiSwapReserve0: removeDecimals(swap.Reserve0, swapReserveDecimalsCorrection)}},
-				{Key: "$set", Value: bson.M{fiSwapReserve1: removeDecimals(swap.Reserve1, swapReserveDecimalsCorrection)}}})
+		set := db.appendSwapAmount(db.appendSwapAmount(bson.D{}, fiSwapReserve0, swap.Reserve0), fiSwapReserve1, swap.Reserve1)
+		upd := bson.D{{Key: "$set", Value: set}}
+
+		// exact reserves of a previous sync are not valid anymore
+		if uns := swapStaleExact(set, fiSwapReserve0, fiSwapReserve1); len(uns) > 0 {
+			upd = append(upd, bson.E{Key: "$unset", Value: uns})
+		}
+
+		_, err := col.UpdateOne(context.Background(), bson.M{fiSwapPk: hash.String()}, upd)
 		if err != nil {
 			db.log.Errorf("unable to update reserves for swap %s", hash.String())
 		}
@@ -223,9 +263,11 @@ func (db *MongoDbBridge) IsSwapKnown(col *mongo.Collection, hash *common.Hash, s
 		// in case the sync event was recorded first, update reserves into actual swap
 		// and delete sync record.
 		type Values struct {
-			Type     int   `bson:"type"`
-			Reserve0 int64 `bson:"reserve0"`
-			Reserve1 int64 `bson:"reserve1"`
+			Type          int                  `bson:"type"`
+			Reserve0      primitive.Decimal128 `bson:"reserve0"`
+			Reserve1      primitive.Decimal128 `bson:"reserve1"`
+			Reserve0Exact *string              `bson:"reserve0_hex"`
+			Reserve1Exact *string              `bson:"reserve1_hex"`
 		}
 		var values Values
 		if err := sr.Decode(&values); err != nil {
@@ -240,8 +282,13 @@ func (db *MongoDbBridge) IsSwapKnown(col *mongo.Collection, hash *common.Hash, s
 				db.log.Errorf("can not delete swap data; %s", err.Error())
 			}
 
-			swap.Reserve0 = returnDecimals(big.NewInt(values.Reserve0), swapReserveDecimalsCorrection)
-			swap.Reserve1 = returnDecimals(big.NewInt(values.Reserve1), swapReserveDecimalsCorrection)
+			var err error
+			if swap.Reserve0, err = swapReserve(values.Reserve0, values.Reserve0Exact); err != nil {
+				return false, err
+			}
+			if swap.Reserve1, err = swapReserve(values.Reserve1, values.Reserve1Exact); err != nil {
+				return false, err
+			}
 			return false, nil
 		}
 	}
@@ -325,8 +372,8 @@ func (db *MongoDbBridge) UniswapUpdateLastKnownSwapBlock(blkNumber uint64) error
 
 // Volume represents one single sum of volumes for specified pair
 type Volume struct {
-	ID    string `bson:"_id"`
-	Total int64  `bson:"total"`
+	ID    string        `bson:"_id"`
+	Total bson.RawValue `bson:"total"`
 }
 
 // UniswapVolume resolves volume of swap trades for specified pair and date interval.
@@ -380,12 +427,16 @@ func (db *MongoDbBridge) UniswapVolume(pairAddress *common.Address, fromTime int
 	// get result and fill return data
 	for cursor.Next(context.Background()) {
 		var val Volume
-		err := cursor.Decode(&val)
-		if err != nil {
-			fmt.Println(err.Error())
+		if err := cursor.Decode(&val); err != nil {
+			db.log.Errorf("can not decode swap volume; %s", err.Error())
+			return def, err
 		}
 
-		v := returnDecimals(big.NewInt(val.Total), swapAmountDecimalsCorrection)
+		v, err := types.BigFromDecimalValue(val.Total)
+		if err != nil {
+			db.log.Errorf("can not read swap volume; %s", err.Error())
+			return def, err
+		}
 		def.Volume = v
 	}
 
@@ -442,13 +493,19 @@ func (db *MongoDbBridge) UniswapTimeVolumes(pairAddress *common.Address, resolut
 	// iterate thru results and construct data
 	for cursor.Next(context.Background()) {
 		var val Volume
-		err := cursor.Decode(&val)
+		if err := cursor.Decode(&val); err != nil {
+			db.log.Errorf("can not decode swap volume; %s", err.Error())
+			continue
+		}
+
+		v, err := types.BigFromDecimalValue(val.Total)
 		if err != nil {
-			db.log.Errorf(err.Error())
+			db.log.Errorf("can not read swap volume; %s", err.Error())
+			continue
 		}
 		def := types.DefiSwapVolume{
 			PairAddress: pairAddress,
-			Volume:      returnDecimals(big.NewInt(val.Total), swapAmountDecimalsCorrection),
+			Volume:      v,
 			DateString:  val.ID,
 		}
 		list = append(list, def)
@@ -527,12 +584,12 @@ func (db *MongoDbBridge) UniswapTimePrices(pairAddress *common.Address, resoluti
 	tokenASum := bson.D{{Key: "$add", Value: bson.A{"$am0in", "$am0out"}}}
 	tokenBSum := bson.D{{Key: "$add", Value: bson.A{"$am1in", "$am1out"}}}
 
-	// creating priceBsonD bson request object
+	// creating priceBsonD bson request object; prices are decoded as double
 	var priceBsonD bson.D
 	if direction == 0 {
-		priceBsonD = bson.D{{Key: "$divide", Value: bson.A{tokenASum, tokenBSum}}}
+		priceBsonD = bson.D{{Key: "$toDouble", Value: bson.D{{Key: "$divide", Value: bson.A{tokenASum, tokenBSum}}}}}
 	} else {
-		priceBsonD = bson.D{{Key: "$divide", Value: bson.A{tokenBSum, tokenASum}}}
+		priceBsonD = bson.D{{Key: "$toDouble", Value: bson.D{{Key: "$divide", Value: bson.A{tokenBSum, tokenASum}}}}}
 	}
 
 	// create query pipeline
@@ -625,8 +682,8 @@ func (db *MongoDbBridge) UniswapTimeReserves(pairAddress *common.Address, resolu
 		Time string `bson:"_id"`
 
 		// average price for this time period
-		Close0 int64 `bson:"close0"`
-		Close1 int64 `bson:"close1"`
+		Close0 primitive.Decimal128 `bson:"close0"`
+		Close1 primitive.Decimal128 `bson:"close1"`
 	}
 
 	list := make([]types.DefiTimeReserve, 0)
@@ -653,11 +710,20 @@ func (db *MongoDbBridge) UniswapTimeReserves(pairAddress *common.Address, resolu
 			db.log.Errorf(err.Error())
 		}
 
+		close0, err := types.BigFromDecimal(reserveVal.Close0)
+		if err != nil {
+			db.log.Errorf("can not read swap reserve; %s", err.Error())
+			continue
+		}
+		close1, err := types.BigFromDecimal(reserveVal.Close1)
+		if err != nil {
+			db.log.Errorf("can not read swap reserve; %s", err.Error())
+			continue
+		}
+
 		res := types.DefiTimeReserve{
-			Time: reserveVal.Time,
-			ReserveClose: []hexutil.Big{
-				hexutil.Big(*returnDecimals(new(big.Int).SetInt64(reserveVal.Close0), swapReserveDecimalsCorrection)),
-				hexutil.Big(*returnDecimals(new(big.Int).SetInt64(reserveVal.Close1), swapReserveDecimalsCorrection))},
+			Time:         reserveVal.Time,
+			ReserveClose: []hexutil.Big{hexutil.Big(*close0), hexutil.Big(*close1)},
 		}
 
 		list = append(list, res)
@@ -862,18 +928,18 @@ func (db *MongoDbBridge) uniswapActionListLoad(col *mongo.Collection, pairAddres
 	}()
 
 	type UniswapActionDB struct {
-		ID              string         `bson:"_id"`
-		OrdIndex        uint64         `bson:"orx"`
-		BlockNr         hexutil.Uint64 `bson:"blk"`
-		Type            int32          `bson:"type"`
-		PairAddress     string         `bson:"pair"`
-		Sender          string         `bson:"sender"`
-		TransactionHash string         `bson:"tx"`
-		Time            time.Time      `bson:"date"`
-		Amount0in       int64          `bson:"am0in"`
-		Amount0out      int64          `bson:"am0out"`
-		Amount1in       int64          `bson:"am1in"`
-		Amount1out      int64          `bson:"am1out"`
+		ID              string               `bson:"_id"`
+		OrdIndex        uint64               `bson:"orx"`
+		BlockNr         hexutil.Uint64       `bson:"blk"`
+		Type            int32                `bson:"type"`
+		PairAddress     string               `bson:"pair"`
+		Sender          string               `bson:"sender"`
+		TransactionHash string               `bson:"tx"`
+		Time            time.Time            `bson:"date"`
+		Amount0in       primitive.Decimal128 `bson:"am0in"`
+		Amount0out      primitive.Decimal128 `bson:"am0out"`
+		Amount1in       primitive.Decimal128 `bson:"am1in"`
+		Amount1out      primitive.Decimal128 `bson:"am1out"`
 	}
 	// loop and load
 	var uniswapAction *types.UniswapAction
@@ -901,10 +967,10 @@ func (db *MongoDbBridge) uniswapActionListLoad(col *mongo.Collection, pairAddres
 		ua.Sender = common.HexToAddress(udb.Sender)
 		ua.TransactionHash = common.HexToHash(udb.TransactionHash)
 		ua.Time = hexutil.Uint64(udb.Time.UTC().Unix())
-		ua.Amount0in = *(*hexutil.Big)(returnDecimals(big.NewInt(udb.Amount0in), swapAmountDecimalsCorrection))
-		ua.Amount0out = *(*hexutil.Big)(returnDecimals(big.NewInt(udb.Amount0out), swapAmountDecimalsCorrection))
-		ua.Amount1in = *(*hexutil.Big)(returnDecimals(big.NewInt(udb.Amount1in), swapAmountDecimalsCorrection))
-		ua.Amount1out = *(*hexutil.Big)(returnDecimals(big.NewInt(udb.Amount1out), swapAmountDecimalsCorrection))
+		ua.Amount0in = decodeSwapAmount(udb.Amount0in)
+		ua.Amount0out = decodeSwapAmount(udb.Amount0out)
+		ua.Amount1in = decodeSwapAmount(udb.Amount1in)
+		ua.Amount1out = decodeSwapAmount(udb.Amount1out)
 
 		// keep this one
 		uniswapAction = &ua
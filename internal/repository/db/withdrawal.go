@@ -175,9 +175,6 @@ func (db *MongoDbBridge) UpdateWithdrawal(wr *types.WithdrawRequest) error {
 	// get the collection for withdrawals
 	col := db.client.Database(db.dbName).Collection(colWithdrawals)
 
-	// calculate the value to 9 digits (and 18 billions remain available)
-	val := new(big.Int).Div(wr.Amount.ToInt(), types.WithdrawDecimalsCorrection).Uint64()
-
 	// withdraw transaction
 	var trx *string = nil
 	if wr.WithdrawTrx != nil {
@@ -192,6 +189,13 @@ func (db *MongoDbBridge) UpdateWithdrawal(wr *types.WithdrawRequest) error {
 		pen = &p
 	}
 
+	// the amount is stored exactly
+	val, err := types.DecimalFromBig(wr.Amount.ToInt())
+	if err != nil {
+		db.log.Criticalf("invalid withdrawal amount %s; %s", wr.Amount.String(), err.Error())
+		return err
+	}
+
 	// try to update a withdraw request by replacing it in the database
 	// we use request ID identify unique withdrawal
 	er, err := col.UpdateOne(context.Background(), bson.D{
@@ -256,240 +260,51 @@ func (db *MongoDbBridge) WithdrawalsCount() (uint64, error) {
 	return db.EstimateCount(db.client.Database(db.dbName).Collection(colWithdrawals))
 }
 
-// wrListInit initializes list of withdraw requests based on provided cursor, count, and filter.
-func (db *MongoDbBridge) wrListInit(col *mongo.Collection, cursor *string, count int32, filter *bson.D) (*types.WithdrawRequestList, error) {
-	// make sure some filter is used
-	if nil == filter {
-		filter = &bson.D{}
-	}
-
-	// find how many transactions do we have in the database
-	total, err := col.CountDocuments(context.Background(), *filter)
-	if err != nil {
-		db.log.Errorf("can not count withdraw requests")
-		return nil, err
-	}
-
-	// make the list and notify the size of it
-	db.log.Debugf("found %d filtered withdraw requests", total)
-	list := types.WithdrawRequestList{
-		Collection: make([]*types.WithdrawRequest, 0),
-		Total:      uint64(total),
-		First:      0,
-		Last:       0,
-		IsStart:    total == 0,
-		IsEnd:      total == 0,
-		Filter:     *filter,
-	}
-
-	// is the list non-empty? return the list with properly calculated range marks
-	if 0 < total {
-		return db.wrListCollectRangeMarks(col, &list, cursor, count)
-	}
-
-	// this is an empty list
-	db.log.Debug("empty withdraw requests list created")
-	return &list, nil
-}
-
-// wrListCollectRangeMarks returns the list of withdraw requests with proper First/Last marks.
-func (db *MongoDbBridge) wrListCollectRangeMarks(col *mongo.Collection, list *types.WithdrawRequestList, cursor *string, count int32) (*types.WithdrawRequestList, error) {
-	var err error
-
-	// find out the cursor ordinal index
-	if cursor == nil && count > 0 {
-		// get the highest available pk
-		list.First, err = db.wrListBorderPk(col,
-			list.Filter,
-			options.FindOne().SetSort(bson.D{{Key: types.FiWithdrawalOrdinal, Value: -1}}))
-		list.IsStart = true
-
-	} else if cursor == nil && count < 0 {
-		// get the lowest available pk
-		list.First, err = db.wrListBorderPk(col,
-			list.Filter,
-			options.FindOne().SetSort(bson.D{{Key: types.FiWithdrawalOrdinal, Value: 1}}))
-		list.IsEnd = true
-
-	} else if cursor != nil {
-		// the cursor itself is the starting point
-		list.First, err = db.wrListBorderPk(col,
-			bson.D{{Key: types.FiWithdrawalPk, Value: *cursor}},
-			options.FindOne())
-	}
-
-	// check the error
-	if err != nil {
-		db.log.Errorf("can not find the initial withdraw request")
-		return nil, err
-	}
-
-	// inform what we are about to do
-	db.log.Debugf("withdraw requests list initialized with PK %s", list.First)
-	return list, nil
-}
-
-// wrListBorderPk finds the top PK of the withdrawal requests collection based on given filter and options.
-func (db *MongoDbBridge) wrListBorderPk(col *mongo.Collection, filter bson.D, opt *options.FindOneOptions) (uint64, error) {
-	// prep container
-	var row struct {
-		Value uint64 `bson:"orx"`
-	}
-
-	// make sure we pull only what we need
-	opt.SetProjection(bson.D{{Key: types.FiWithdrawalOrdinal, Value: true}})
-	sr := col.FindOne(context.Background(), filter, opt)
-
-	// try to decode
-	if err := sr.Decode(&row); err != nil {
-		return 0, err
-	}
-	return row.Value, nil
-}
-
-// wrListFilter creates a filter for withdraw requests list loading.
-func (db *MongoDbBridge) wrListFilter(cursor *string, count int32, list *types.WithdrawRequestList) *bson.D {
-	// build an extended filter for the query; add PK (decoded cursor) to the original filter
-	if cursor == nil {
-		if count > 0 {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiWithdrawalOrdinal, Value: bson.D{{Key: "$lte", Value: list.First}}})
-		} else {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiWithdrawalOrdinal, Value: bson.D{{Key: "$gte", Value: list.First}}})
-		}
-	} else {
-		if count > 0 {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiWithdrawalOrdinal, Value: bson.D{{Key: "$lt", Value: list.First}}})
-		} else {
-			list.Filter = append(list.Filter, bson.E{Key: types.FiWithdrawalOrdinal, Value: bson.D{{Key: "$gt", Value: list.First}}})
-		}
-	}
-
-	// return the new filter
-	return &list.Filter
-}
-
-// wrListOptions creates a filter options set for withdraw requests list search.
-func (db *MongoDbBridge) wrListOptions(count int32) *options.FindOptions {
-	// prep options
-	opt := options.Find()
-
-	// how to sort results in the collection
-	// from high (new) to low (old) by default; reversed if loading from bottom
-	sd := -1
-	if count < 0 {
-		sd = 1
-	}
-
-	// sort with the direction we want
-	opt.SetSort(bson.D{{Key: types.FiWithdrawalOrdinal, Value: sd}})
-
-	// prep the loading limit
-	var limit = int64(count)
-	if limit < 0 {
-		limit = -limit
-	}
-
-	// apply the limit, try to get one more record so we can detect list end
-	opt.SetLimit(limit + 1)
-	return opt
-}
-
-// wrListLoad load the initialized list of withdraw requests from database.
-func (db *MongoDbBridge) wrListLoad(col *mongo.Collection, cursor *string, count int32, list *types.WithdrawRequestList) (err error) {
-	// get the context for loader
-	ctx := context.Background()
-
-	// load the data
-	ld, err := col.Find(ctx, db.wrListFilter(cursor, count, list), db.wrListOptions(count))
-	if err != nil {
-		db.log.Errorf("error loading with requests list; %s", err.Error())
-		return err
-	}
-
-	// close the cursor as we leave
-	defer db.closeCursor(ld)
-
-	// loop and load the list; we may not store the last value
-	var wr *types.WithdrawRequest
-	for ld.Next(ctx) {
-		// append a previous value to the list, if we have one
-		if wr != nil {
-			list.Collection = append(list.Collection, wr)
-		}
-
-		// try to decode the next row
-		var row types.WithdrawRequest
-		if err = ld.Decode(&row); err != nil {
-			db.log.Errorf("can not decode the withdraw request list row; %s", err.Error())
-			return err
-		}
-
-		// use this row as the next item
-		wr = &row
-	}
-
-	// we should have all the items already; we may just need to check if a boundary was reached
-	list.IsEnd = (cursor == nil && count < 0) || (count > 0 && int32(len(list.Collection)) < count)
-	list.IsStart = (cursor == nil && count > 0) || (count < 0 && int32(len(list.Collection)) < -count)
-
-	// add the last item as well if we hit the boundary
-	if (list.IsStart || list.IsEnd) && wr != nil {
-		list.Collection = append(list.Collection, wr)
-	}
-	return nil
+// withdrawalList describes the paginated list of withdraw requests; the newest requests come first.
+var withdrawalList = listSpec{
+	name:       "withdrawals",
+	collection: colWithdrawals,
+	ordinal:    types.FiWithdrawalOrdinal,
+	sort:       ListSort{Field: types.FiWithdrawalOrdinal},
+	cursor:     listPk,
 }
 
 // Withdrawals pulls list of withdraw requests starting at the specified cursor.
 func (db *MongoDbBridge) Withdrawals(cursor *string, count int32, filter *bson.D) (*types.WithdrawRequestList, error) {
-	// nothing to load?
-	if count == 0 {
-		return nil, fmt.Errorf("nothing to do, zero withdrawals requested")
+	if filter == nil {
+		filter = &bson.D{}
 	}
 
-	// get the collection and context
-	col := db.client.Database(db.dbName).Collection(colWithdrawals)
-
-	// init the list
-	list, err := db.wrListInit(col, cursor, count, filter)
+	page, err := loadList[types.WithdrawRequest](db, &withdrawalList, cursor, count, *filter, nil)
 	if err != nil {
 		db.log.Errorf("can not build withdraw requests list; %s", err.Error())
 		return nil, err
 	}
 
-	// load data if there are any
-	if list.Total > 0 {
-		err = db.wrListLoad(col, cursor, count, list)
-		if err != nil {
-			db.log.Errorf("can not load withdraw requests list from database; %s", err.Error())
-			return nil, err
-		}
-
-		// reverse on negative so new-er delegations will be on top
-		if count < 0 {
-			list.Reverse()
-			count = -count
-		}
-
-		// cut the end?
-		if len(list.Collection) > int(count) {
-			list.Collection = list.Collection[:len(list.Collection)-1]
-		}
+	list := types.WithdrawRequestList{
+		Collection: page.Collection,
+		Total:      page.Total,
+		IsStart:    page.IsStart,
+		IsEnd:      page.IsEnd,
+		Filter:     *filter,
 	}
-
-	return list, nil
+	if len(list.Collection) > 0 {
+		list.First = list.Collection[0].OrdinalIndex()
+		list.Last = list.Collection[len(list.Collection)-1].OrdinalIndex()
+	}
+	return &list, nil
 }
 
-// WithdrawalsSumValue calculates sum of values for all the withdrawals by a filter.
+// WithdrawalsSumValue calculates the exact sum of values in WEI for all the withdrawals by a filter.
 func (db *MongoDbBridge) WithdrawalsSumValue(filter *bson.D) (*big.Int, error) {
 	return db.sumFieldValue(
 		db.client.Database(db.dbName).Collection(colWithdrawals),
 		types.FiWithdrawalValue,
-		filter,
-		types.WithdrawDecimalsCorrection)
+		filter)
 }
 
-// sumFieldValue calculates sum of values for specified field of a specified collection by a given filter.
-func (db *MongoDbBridge) sumFieldValue(col *mongo.Collection, field string, filter *bson.D, decCorrection *big.Int) (*big.Int, error) {
+// sumFieldValue calculates sum of Decimal128 values for specified field of a specified collection by a given filter.
+func (db *MongoDbBridge) sumFieldValue(col *mongo.Collection, field string, filter *bson.D) (*big.Int, error) {
 	// make sure we have at least some filter
 	if filter == nil {
 		filter = &bson.D{}
@@ -512,11 +327,11 @@ func (db *MongoDbBridge) sumFieldValue(col *mongo.Collection, field string, filt
 		return nil, err
 	}
 	// read the data and return result
-	return db.readAggregatedSumFieldValue(cr, decCorrection)
+	return db.readAggregatedSumFieldValue(cr)
 }
 
 // readAggregatedSumFieldValue extract the aggregated value from the given result set.
-func (db *MongoDbBridge) readAggregatedSumFieldValue(cr *mongo.Cursor, decCorrection *big.Int) (*big.Int, error) {
+func (db *MongoDbBridge) readAggregatedSumFieldValue(cr *mongo.Cursor) (*big.Int, error) {
 	// make sure to close the cursor after we got the data
 	defer db.closeCursor(cr)
 
@@ -527,16 +342,11 @@ func (db *MongoDbBridge) readAggregatedSumFieldValue(cr *mongo.Cursor, decCorrec
 
 	// try to get the calculated value
 	var row struct {
-		Total uint64 `bson:"total"`
+		Total bson.RawValue `bson:"total"`
 	}
 	if err := cr.Decode(&row); err != nil {
 		db.log.Errorf("can not read withdrawal sum value; %s", err.Error())
 		return nil, err
 	}
-
-	// correct decimals?
-	if nil != decCorrection {
-		return new(big.Int).Mul(new(big.Int).SetUint64(row.Total), decCorrection), nil
-	}
-	return new(big.Int).SetUint64(row.Total), nil
+	return types.BigFromDecimalValue(row.Total)
 }
@@ -0,0 +1,47 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"time"
+)
+
+// StoreDeadLetter stores the record of a failed processing, so it can be retried later.
+// The number of failed attempts on the record is returned.
+func (p *proxy) StoreDeadLetter(dl *types.DeadLetter) (int32, error) {
+	return p.db.AddDeadLetter(dl)
+}
+
+// RetryDeadLetterAt schedules the next retry of the given failed processing record.
+func (p *proxy) RetryDeadLetterAt(id string, next time.Time) error {
+	return p.db.RetryDeadLetterAt(id, next)
+}
+
+// ResolveDeadLetter removes the given failed processing record
+// if it did not fail again since the given time.
+func (p *proxy) ResolveDeadLetter(id string, since time.Time) (bool, error) {
+	return p.db.ResolveDeadLetter(id, since)
+}
+
+// DiscardDeadLetter removes the given failed processing record without resolving it.
+func (p *proxy) DiscardDeadLetter(id string) (bool, error) {
+	return p.db.DiscardDeadLetter(id)
+}
+
+// DeadLetter provides the failed processing record of the given identifier.
+func (p *proxy) DeadLetter(id string) (*types.DeadLetter, error) {
+	return p.db.DeadLetter(id)
+}
+
+// DeadLetters provides a list of failed processing records ordered by the time of the next retry.
+// Only records due for retry at the given time are provided, if the time is set.
+func (p *proxy) DeadLetters(due *time.Time, count int64) ([]*types.DeadLetter, error) {
+	return p.db.DeadLetters(due, count)
+}
@@ -130,3 +130,8 @@ func (p *proxy) Erc20LogoURL(addr *common.Address) string {
 	}
 	return logo
 }
+
+// EvictErcToken removes the ERC token contract of the given address from the in-memory cache.
+func (p *proxy) EvictErcToken(addr *common.Address) {
+	p.cache.EvictErcToken(addr)
+}
@@ -0,0 +1,81 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"bufio"
+	_ "embed"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	retypes "github.com/ethereum/go-ethereum/core/types"
+	"strings"
+)
+
+// builtinEventSignatures represents the table of well known event signatures shipped with the server.
+//
+//go:embed event_signatures.txt
+var builtinEventSignatures string
+
+// LogEvent decodes the event of the given transaction log record.
+// The ABI of the verified emitting contract is used if available, the table of built-in
+// event signatures is used otherwise. Nil is returned if the event is not known.
+func (p *proxy) LogEvent(lg *retypes.Log) (*types.LogEvent, error) {
+	if len(lg.Topics) == 0 {
+		return nil, nil
+	}
+
+	// verified contract ABI
+	sc, err := p.Contract(&lg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if sc != nil && sc.Abi != "" {
+		if ca := p.contractAbi(sc); ca != nil {
+			if ev, err := ca.EventByID(lg.Topics[0]); err == nil {
+				le, err := types.DecodeLogEvent(ev, lg.Topics, lg.Data, true)
+				if err == nil {
+					return le, nil
+				}
+				p.log.Debugf("can not decode %s of %s; %s", ev.Sig, lg.Address.String(), err.Error())
+			}
+		}
+	}
+
+	// events sharing the topic differ in indexed arguments
+	for _, ev := range p.evtSignatures[lg.Topics[0]] {
+		if le, err := types.DecodeLogEvent(ev, lg.Topics, lg.Data, false); err == nil {
+			return le, nil
+		}
+	}
+	return nil, nil
+}
+
+// eventSignaturesMap builds the map of built-in event signatures keyed by their topic.
+func eventSignaturesMap() map[common.Hash][]*abi.Event {
+	res := make(map[common.Hash][]*abi.Event)
+
+	var line int
+	sc := bufio.NewScanner(strings.NewReader(builtinEventSignatures))
+	for sc.Scan() {
+		line++
+		sig := strings.TrimSpace(sc.Text())
+		if sig == "" || strings.HasPrefix(sig, "#") {
+			continue
+		}
+
+		ev, err := types.ParseEventSignature(sig)
+		if err != nil {
+			panic(fmt.Errorf("invalid built-in event signature on line %d; %s", line, err.Error()))
+		}
+		res[ev.ID] = append(res[ev.ID], ev)
+	}
+	return res
+}
@@ -0,0 +1,230 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"sync/atomic"
+)
+
+// UpdateHeadBlock sets the number of the most recent block observed on the chain.
+func (p *proxy) UpdateHeadBlock(num uint64) {
+	atomic.StoreUint64(&p.head, num)
+}
+
+// HeadBlock provides the number of the most recent block observed on the chain.
+// The current block height is pulled from the node if no head has been observed yet.
+func (p *proxy) HeadBlock() (uint64, error) {
+	if head := atomic.LoadUint64(&p.head); head > 0 {
+		return head, nil
+	}
+
+	bh, err := p.rpc.BlockHeight()
+	if err != nil {
+		p.log.Errorf("can not get the current block height; %s", err.Error())
+		return 0, err
+	}
+	return bh.ToInt().Uint64(), nil
+}
+
+// FinalizedBlock provides the number of the highest block with the configured
+// number of confirmations, e.g. the highest block considered final.
+func (p *proxy) FinalizedBlock() (uint64, error) {
+	head, err := p.HeadBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	if head < p.cfg.RepoCommand.Confirmations {
+		return 0, nil
+	}
+	return head - p.cfg.RepoCommand.Confirmations, nil
+}
+
+// Confirmations provides the number of blocks observed on top of the block of the given number.
+func (p *proxy) Confirmations(num uint64) (uint64, error) {
+	head, err := p.HeadBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	if head < num {
+		return 0, nil
+	}
+	return head - num, nil
+}
+
+// IsFinalized checks if the block of the given number is final.
+func (p *proxy) IsFinalized(num uint64) (bool, error) {
+	fin, err := p.FinalizedBlock()
+	if err != nil {
+		return false, err
+	}
+	return num <= fin, nil
+}
+
+// FinalizedBlocks pulls list of final blocks starting on the specified block number and going up,
+// or down based on count number. If the initial block number is not provided, we start
+// on the highest final block, or on the first block based on count value.
+// The blocks are listed from the blocks' registry, so only processed blocks are included.
+// Blocks processed before the registry was introduced are listed by their numbers.
+func (p *proxy) FinalizedBlocks(num *uint64, count int32) (*types.BlockList, error) {
+	// nothing to load?
+	if count == 0 {
+		return nil, fmt.Errorf("nothing to do, zero blocks requested")
+	}
+
+	fin, err := p.FinalizedBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	list := types.BlockList{Collection: make([]*types.Block, 0)}
+	var from, to uint64
+	if count > 0 {
+		// scan down from the highest final block, or from below the cursor
+		to, list.IsStart = fin, true
+		if num != nil && *num <= fin {
+			if *num == 0 {
+				list.IsEnd = true
+				return &list, nil
+			}
+			to, list.IsStart = *num-1, false
+		}
+	} else {
+		// scan up from the first block, or from above the cursor
+		to, list.IsEnd = fin, num == nil
+		if num != nil {
+			from = *num + 1
+		}
+		if from > fin {
+			list.IsStart = true
+			return &list, nil
+		}
+	}
+
+	toPull := int64(count)
+	if count < 0 {
+		toPull = -toPull
+	}
+
+	// pull one extra block number to find out if we reached the end of the range
+	nums, err := p.finalBlockNumbers(from, to, toPull+1, count > 0)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(nums)) <= toPull {
+		if count > 0 {
+			list.IsEnd = true
+		} else {
+			list.IsStart = true
+		}
+	} else {
+		nums = nums[:toPull]
+	}
+
+	// load the blocks in a single batch
+	bns := make([]hexutil.Uint64, len(nums))
+	for i, n := range nums {
+		bns[i] = hexutil.Uint64(n)
+	}
+	blocks, err := p.BlocksByNumber(bns)
+	if err != nil {
+		return nil, err
+	}
+	for _, bn := range bns {
+		blk, ok := blocks[bn]
+		if !ok {
+			return nil, fmt.Errorf("final block #%d not available", uint64(bn))
+		}
+		list.Collection = append(list.Collection, blk)
+	}
+
+	// if we scanned from bottom up, we need to reverse the list so newer blocks are on top
+	if count < 0 {
+		list.Reverse()
+	}
+	return &list, nil
+}
+
+// finalBlockNumbers provides the numbers of the processed blocks in the range <from, to>, at most limit of them.
+// The blocks' registry is filled only since its introduction, so all the blocks below the lowest
+// registered block are considered processed.
+func (p *proxy) finalBlockNumbers(from uint64, to uint64, limit int64, desc bool) ([]uint64, error) {
+	low, err := p.db.BottomBlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	// no registered blocks at all; all the blocks of the range are listed by numbers
+	if low == nil {
+		return blockNumbersRange(from, to, limit, desc), nil
+	}
+
+	var reg, plain []uint64
+	if to >= *low {
+		bottom := from
+		if bottom < *low {
+			bottom = *low
+		}
+		if reg, err = p.db.BlockNumbers(bottom, to, limit, desc); err != nil {
+			return nil, err
+		}
+	}
+	if from < *low {
+		top := to
+		if top >= *low {
+			top = *low - 1
+		}
+		plain = blockNumbersRange(from, top, limit, desc)
+	}
+
+	// the registered blocks are above the plain range
+	var list []uint64
+	if desc {
+		list = append(reg, plain...)
+	} else {
+		list = append(plain, reg...)
+	}
+	if int64(len(list)) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+// blockNumbersRange provides the block numbers of the range <from, to>, at most limit of them.
+func blockNumbersRange(from uint64, to uint64, limit int64, desc bool) []uint64 {
+	if from > to {
+		return []uint64{}
+	}
+	if span := to - from + 1; span < uint64(limit) {
+		limit = int64(span)
+	}
+
+	list := make([]uint64, limit)
+	for i := range list {
+		if desc {
+			list[i] = to - uint64(i)
+		} else {
+			list[i] = from + uint64(i)
+		}
+	}
+	return list
+}
+
+// FinalizedTransactions returns list of final transactions starting from the cursor.
+func (p *proxy) FinalizedTransactions(cursor *string, count int32) (*types.TransactionList, error) {
+	fin, err := p.FinalizedBlock()
+	if err != nil {
+		return nil, err
+	}
+	return p.db.FinalizedTransactions(cursor, count, fin)
+}
@@ -0,0 +1,162 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"bufio"
+	_ "embed"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"io"
+	"os"
+	"strings"
+)
+
+// builtinFunctionSignatures represents the database of well known function signatures shipped with the server.
+//
+//go:embed fn_signatures.txt
+var builtinFunctionSignatures string
+
+// contractAbi represents a parsed ABI of a verified contract along with its source definition.
+type contractAbi struct {
+	src string
+	abi *abi.ABI
+}
+
+// TransactionMethod resolves the contract method called with the given input data.
+// The ABI of the verified recipient contract is used if available, the databases
+// of imported and built-in function signatures are used otherwise. Nil is returned
+// if the method is not known.
+func (p *proxy) TransactionMethod(to *common.Address, input []byte) (*types.TrxMethod, error) {
+	sel := types.TrxSelectorOf(to, input)
+	if sel == nil {
+		return nil, nil
+	}
+
+	// verified contract ABI
+	sc, err := p.Contract(to)
+	if err != nil {
+		return nil, err
+	}
+	if sc != nil && sc.Abi != "" {
+		if ca := p.contractAbi(sc); ca != nil {
+			if m, err := ca.MethodById(sel[:]); err == nil {
+				return types.NewTrxMethod(m, true), nil
+			}
+		}
+	}
+
+	// imported signatures come first, the built-in ones cover the most common calls
+	imported, err := p.db.FunctionSignatures(sel)
+	if err != nil {
+		return nil, err
+	}
+	for _, sig := range imported {
+		m, err := types.ParseMethodSignature(sig)
+		if err != nil {
+			p.log.Warningf("invalid function signature %s; %s", sig, err.Error())
+			continue
+		}
+		if types.IsCallInputOf(m, input) {
+			return types.NewTrxMethod(m, false), nil
+		}
+	}
+	for _, fn := range p.fnSignatures[*sel] {
+		if types.IsCallInputOf(fn.Abi, input) {
+			return fn, nil
+		}
+	}
+	return nil, nil
+}
+
+// contractAbi provides the parsed ABI of the given verified contract.
+func (p *proxy) contractAbi(sc *types.Contract) *abi.ABI {
+	if v, ok := p.contractAbis.Load(sc.Address); ok && v.(*contractAbi).src == sc.Abi {
+		return v.(*contractAbi).abi
+	}
+
+	ca, err := abi.JSON(strings.NewReader(sc.Abi))
+	if err != nil {
+		p.log.Errorf("invalid ABI of contract %s; %s", sc.Address.String(), err.Error())
+		return nil
+	}
+
+	p.contractAbis.Store(sc.Address, &contractAbi{src: sc.Abi, abi: &ca})
+	return &ca
+}
+
+// functionSignaturesMap builds the map of built-in function signatures keyed by their selector.
+func functionSignaturesMap() map[types.TrxSelector][]*types.TrxMethod {
+	list, err := parseFunctionSignatures(strings.NewReader(builtinFunctionSignatures))
+	if err != nil {
+		panic(fmt.Errorf("invalid built-in function signatures; %s", err.Error()))
+	}
+
+	res := make(map[types.TrxSelector][]*types.TrxMethod, len(list))
+	for _, fn := range list {
+		res[fn.Selector] = append(res[fn.Selector], fn)
+	}
+	return res
+}
+
+// parseFunctionSignatures parses a function signatures file. The file contains a canonical
+// function signature, i.e. transfer(address,uint256), per line. Empty lines and lines
+// starting with # are ignored.
+func parseFunctionSignatures(r io.Reader) ([]*types.TrxMethod, error) {
+	list := make([]*types.TrxMethod, 0)
+
+	var line int
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line++
+		sig := strings.TrimSpace(sc.Text())
+		if sig == "" || strings.HasPrefix(sig, "#") {
+			continue
+		}
+
+		m, err := types.ParseMethodSignature(sig)
+		if err != nil {
+			return nil, fmt.Errorf("line %d; %s", line, err.Error())
+		}
+		list = append(list, types.NewTrxMethod(m, false))
+	}
+	return list, sc.Err()
+}
+
+// ImportFunctionSignatures imports function signatures from the file of the given path
+// into the database of known function signatures. The number of signatures found in the file
+// and the number of newly added signatures is returned.
+// Only the persistent storage is connected, the repository does not need to be running.
+func ImportFunctionSignatures(path string) (int, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Errorf("can not close signatures file; %s", err.Error())
+		}
+	}()
+
+	list, err := parseFunctionSignatures(f)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	dbBridge, err := commandDb()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer dbBridge.Close()
+
+	added, err := dbBridge.AddFunctionSignatures(list)
+	return len(list), added, err
+}
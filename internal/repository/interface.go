@@ -28,6 +28,10 @@ type Repository interface {
 	// Account returns account at Opera blockchain for an address, nil if not found.
 	Account(*common.Address) (*types.Account, error)
 
+	// Accounts returns accounts at Opera blockchain for a list of addresses
+	// using a constant number of round trips to the cache and the database.
+	Accounts([]common.Address) (map[common.Address]*types.Account, error)
+
 	// AccountBalance returns the current balance of an account at Opera blockchain.
 	AccountBalance(*common.Address) (*hexutil.Big, error)
 
@@ -47,8 +51,13 @@ type Repository interface {
 	// (or at the bottom without one) and loads at most defined number
 	// of transactions newer than that.
 	//
-	// Transactions are always sorted from newer to older.
-	AccountTransactions(*common.Address, *common.Address, *string, int32) (*types.TransactionList, error)
+	// Transactions are always sorted from newer to older. Optional filter narrows the list further.
+	AccountTransactions(*common.Address, *common.Address, *types.TransactionFilter, *string, int32) (*types.TransactionList, error)
+
+	// AccountInternalTransactions returns list of internal transactions of an account
+	// either sent, or received by the account. Internal transactions are sorted from newer to older
+	// unless a different sort is given.
+	AccountInternalTransactions(*common.Address, *string, int32, *types.ListSort) (*types.InternalTransactionList, error)
 
 	// AccountsActive total number of accounts known to the repository.
 	AccountsActive() (hexutil.Uint64, error)
@@ -56,6 +65,9 @@ type Repository interface {
 	// AccountIsKnown checks if the account of the given address is known to the API server.
 	AccountIsKnown(*common.Address) bool
 
+	// EvictAccount removes the account of the given address from the in-memory cache.
+	EvictAccount(*common.Address)
+
 	// StoreAccount adds specified account detail into the repository.
 	StoreAccount(*types.Account) error
 
@@ -65,21 +77,80 @@ type Repository interface {
 	// BlockHeight returns the current height of the Opera blockchain in blocks.
 	BlockHeight() (*hexutil.Big, error)
 
+	// UpstreamNodes provides the status of the upstream nodes of the node pool.
+	UpstreamNodes() []types.UpstreamStatus
+
 	// LastKnownBlock returns the number of the last block known to the repository.
 	LastKnownBlock() (uint64, error)
 
+	// UpdateHeadBlock sets the number of the most recent block observed on the chain.
+	UpdateHeadBlock(uint64)
+
+	// HeadBlock provides the number of the most recent block observed on the chain.
+	HeadBlock() (uint64, error)
+
+	// FinalizedBlock provides the number of the highest block considered final.
+	FinalizedBlock() (uint64, error)
+
+	// Confirmations provides the number of blocks observed on top of the block of the given number.
+	Confirmations(uint64) (uint64, error)
+
+	// IsFinalized checks if the block of the given number is final.
+	IsFinalized(uint64) (bool, error)
+
 	// UpdateLastKnownBlock update record about last known block.
 	UpdateLastKnownBlock(blockNo *hexutil.Uint64) error
 
+	// Checkpoints provides the map of the known ingestion checkpoints
+	// of the data processors to their progress.
+	Checkpoints() (map[string]types.Checkpoint, error)
+
+	// UpdateCheckpoint stores the progress of the given data processor.
+	UpdateCheckpoint(string, *types.Checkpoint) error
+
+	// StoreDeadLetter stores the record of a failed processing, so it can be retried later.
+	// The number of failed attempts on the record is returned.
+	StoreDeadLetter(*types.DeadLetter) (int32, error)
+
+	// RetryDeadLetterAt schedules the next retry of the given failed processing record.
+	RetryDeadLetterAt(string, time.Time) error
+
+	// ResolveDeadLetter removes the given failed processing record
+	// if it did not fail again since the given time.
+	ResolveDeadLetter(string, time.Time) (bool, error)
+
+	// DiscardDeadLetter removes the given failed processing record without resolving it.
+	DiscardDeadLetter(string) (bool, error)
+
+	// DeadLetter provides the failed processing record of the given identifier.
+	DeadLetter(string) (*types.DeadLetter, error)
+
+	// DeadLetters provides a list of failed processing records ordered by the time of the next retry.
+	// Only records due for retry at the given time are provided, if the time is set.
+	DeadLetters(*time.Time, int64) ([]*types.DeadLetter, error)
+
+	// FilterLogs loads logs of the given block range matching any of the given event topics
+	// directly from the node.
+	FilterLogs(uint64, uint64, []common.Hash) ([]etc.Log, error)
+
 	// ObservedHeaders provides a channel fed with new headers observed
 	// by the connected blockchain node.
 	ObservedHeaders() chan *etc.Header
 
+	// ObservedPendingTransactions provides a channel fed with hashes of new pending transactions
+	// observed by the connected blockchain node.
+	ObservedPendingTransactions() chan common.Hash
+
 	// BlockByNumber returns a block at Opera blockchain represented by a number.
 	// The Top block is returned if the number is not provided.
 	// If the block is not found, ErrBlockNotFound error is returned.
 	BlockByNumber(*hexutil.Uint64) (*types.Block, error)
 
+	// BlocksByNumber returns blocks at Opera blockchain represented by a list of numbers
+	// using a constant number of round trips to the cache and the node.
+	// Blocks not found are not included in the result.
+	BlocksByNumber([]hexutil.Uint64) (map[hexutil.Uint64]*types.Block, error)
+
 	// BlockByHash returns a block at Opera blockchain represented by a hash.
 	// The Top block is returned if the hash is not provided.
 	// If the block is not found, ErrBlockNotFound error is returned.
@@ -89,15 +160,46 @@ type Repository interface {
 	// and going up, or down based on count number.
 	Blocks(*uint64, int32) (*types.BlockList, error)
 
+	// FinalizedBlocks pull a list of final blocks starting on the specified block number
+	// and going up, or down based on count number.
+	FinalizedBlocks(*uint64, int32) (*types.BlockList, error)
+
+	// LoadBlock returns a block at Opera blockchain represented by a number
+	// loaded directly from the node, bypassing the in-memory cache.
+	LoadBlock(*hexutil.Uint64) (*types.Block, error)
+
+	// StoreBlock adds the given processed block into the blocks' registry.
+	StoreBlock(*types.Block) error
+
+	// NewBlockBatch creates a new batch of persistent storage writes of the given block.
+	NewBlockBatch(*types.Block) *BlockBatch
+
+	// KnownBlockHash returns the hash of the processed block at the given height.
+	// Nil is returned if the block has not been processed yet.
+	KnownBlockHash(uint64) (*common.Hash, error)
+
+	// RevertBlocks removes data of all the processed blocks starting at the given block number.
+	RevertBlocks(uint64) error
+
 	// CacheBlock puts a block to the internal block ring cache.
 	CacheBlock(blk *types.Block)
 
 	// Contract extracts smart contract information by address if available.
 	Contract(*common.Address) (*types.Contract, error)
 
+	// EvictContract removes the smart contract of the given address from the in-memory cache.
+	EvictContract(*common.Address)
+
 	// Contracts returns list of smart contracts at Opera blockchain.
 	Contracts(bool, *string, int32) (*types.ContractList, error)
 
+	// StoreContractEvent stores the given decoded contract event in the persistent storage.
+	StoreContractEvent(*types.ContractEvent) error
+
+	// ContractEvents provides list of decoded contract events filtered by the emitting contract,
+	// name of the event and values of the event arguments.
+	ContractEvents(*common.Address, *string, []types.ContractEventArg, *string, int32, *types.ListSort) (*types.ContractEventList, error)
+
 	// ValidateContract tries to validate contract byte code using
 	// provided source code. If successful, the contract information
 	// is updated the repository.
@@ -148,6 +250,22 @@ type Repository interface {
 	// StoreTransaction adds a new incoming transaction from blockchain to the repository.
 	StoreTransaction(*types.Block, *types.Transaction) error
 
+	// TraceInternalTransactions traces the given transaction on the node and provides the list
+	// of internal transactions made by contracts during its execution.
+	TraceInternalTransactions(*types.Transaction) ([]*types.InternalTransaction, error)
+
+	// StoreInternalTransactions stores internal transactions of the given parent transaction.
+	StoreInternalTransactions(*common.Hash, []*types.InternalTransaction) error
+
+	// InternalTransactions provides the list of internal transactions of the given parent transaction.
+	InternalTransactions(*common.Hash) ([]*types.InternalTransaction, error)
+
+	// TransactionMethod resolves the contract method called with the given input data, nil if not known.
+	TransactionMethod(*common.Address, []byte) (*types.TrxMethod, error)
+
+	// LogEvent decodes the event of the given transaction log record, nil if the event is not known.
+	LogEvent(*etc.Log) (*types.LogEvent, error)
+
 	// LoadTransaction returns a transaction at Opera blockchain
 	// by a hash loaded directly from the node.
 	LoadTransaction(hash *common.Hash) (*types.Transaction, error)
@@ -158,6 +276,12 @@ type Repository interface {
 	// Transactions returns list of transaction hashes at Opera blockchain.
 	Transactions(*string, int32) (*types.TransactionList, error)
 
+	// FinalizedTransactions returns list of final transactions at Opera blockchain.
+	FinalizedTransactions(*string, int32) (*types.TransactionList, error)
+
+	// TransactionsFiltered returns list of transactions at Opera blockchain matching the given filter.
+	TransactionsFiltered(*types.TransactionFilter, *string, int32) (*types.TransactionList, error)
+
 	// TransactionsCount returns total number of transactions in the block chain.
 	TransactionsCount() (uint64, error)
 
@@ -215,6 +339,10 @@ type Repository interface {
 	// RetrieveStakerInfo gets staker information from in-memory if available.
 	RetrieveStakerInfo(*hexutil.Big) *types.StakerInfo
 
+	// RetrieveStakerInfos gets information of a list of stakers from in-memory if available.
+	// The result is keyed by the staker id.
+	RetrieveStakerInfos([]hexutil.Big) map[string]*types.StakerInfo
+
 	// IsDelegating returns if the given address is an SFC delegator.
 	IsDelegating(*common.Address) (bool, error)
 
@@ -232,13 +360,13 @@ type Repository interface {
 	DelegationAmountStaked(*common.Address, *hexutil.Big) (*big.Int, error)
 
 	// DelegationsByAddress returns a list of all delegations of a given delegator address.
-	DelegationsByAddress(*common.Address, *string, int32) (*types.DelegationList, error)
+	DelegationsByAddress(*common.Address, *string, int32, *types.ListSort) (*types.DelegationList, error)
 
 	// DelegationsByAddressAll returns a list of all delegations of the given address un-paged.
 	DelegationsByAddressAll(addr *common.Address) ([]*types.Delegation, error)
 
 	// DelegationsOfValidator extracts a list of delegations for a validator by its ID.
-	DelegationsOfValidator(*hexutil.Big, *string, int32) (*types.DelegationList, error)
+	DelegationsOfValidator(*hexutil.Big, *string, int32, *types.ListSort) (*types.DelegationList, error)
 
 	// DelegationLock returns delegation lock information using SFC contract binding.
 	DelegationLock(*common.Address, *hexutil.Big) (*types.DelegationLock, error)
@@ -293,7 +421,7 @@ type Repository interface {
 	RewardsClaimed(adr *common.Address, valId *big.Int, since *int64, until *int64) (*big.Int, error)
 
 	// RewardClaims provides list of reward claims for the given criteria.
-	RewardClaims(*common.Address, *big.Int, *string, int32) (*types.RewardClaimsList, error)
+	RewardClaims(*common.Address, *big.Int, *string, int32, *types.ListSort) (*types.RewardClaimsList, error)
 
 	// Price returns a price information for the given target symbol.
 	Price(sym string) (types.Price, error)
@@ -447,6 +575,9 @@ type Repository interface {
 	// Erc20Token returns an ERC20 token for the given address, if available.
 	Erc20Token(*common.Address) (*types.Erc20Token, error)
 
+	// EvictErcToken removes the ERC token contract of the given address from the in-memory cache.
+	EvictErcToken(*common.Address)
+
 	// Erc20TokensList returns a list of known ERC20 tokens ordered by their activity.
 	Erc20TokensList(int32) ([]common.Address, error)
 
@@ -605,8 +736,8 @@ type Repository interface {
 	// StoreFtmBurn stores the given native FTM burn per block record into the persistent storage.
 	StoreFtmBurn(burn *types.FtmBurn) error
 
-	// FtmBurnTotal provides the total amount of burned native FTM.
-	FtmBurnTotal() (int64, error)
+	// FtmBurnTotal provides the total amount of burned native FTM in WEI.
+	FtmBurnTotal() (*big.Int, error)
 
 	// FtmBurnList provides list of per-block burned native FTM tokens.
 	FtmBurnList(count int64) ([]*types.FtmBurn, error)
@@ -617,8 +748,8 @@ type Repository interface {
 	// BurnTreasuryStashShareByTimeStamp finds treasury/burn share for the given time stamp.
 	BurnTreasuryStashShareByTimeStamp(ts int64) *BurnTreasuryShare
 
-	// FtmTreasuryTotal provides the total amount of native FTM sent into the treasury.
-	FtmTreasuryTotal() (int64, error)
+	// FtmTreasuryTotal provides the total amount of native FTM in WEI sent into the treasury.
+	FtmTreasuryTotal() (*big.Int, error)
 
 	// FeeFlow provides a list of fee flow aggregates for the given date range.
 	FeeFlow(from, to time.Time) ([]*types.FtmDailyBurn, error)
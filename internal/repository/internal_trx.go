@@ -0,0 +1,44 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// TraceInternalTransactions traces the given transaction on the node and provides the list
+// of internal transactions made by contracts during its execution.
+func (p *proxy) TraceInternalTransactions(trx *types.Transaction) ([]*types.InternalTransaction, error) {
+	cf, err := p.rpc.TraceTransaction(&trx.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return types.InternalTransactions(trx, cf), nil
+}
+
+// StoreInternalTransactions stores internal transactions of the given parent transaction.
+func (p *proxy) StoreInternalTransactions(trx *common.Hash, list []*types.InternalTransaction) error {
+	return p.db.AddInternalTransactions(trx, list)
+}
+
+// InternalTransactions provides the list of internal transactions of the given parent transaction.
+func (p *proxy) InternalTransactions(trx *common.Hash) ([]*types.InternalTransaction, error) {
+	return p.db.InternalTransactionsOf(trx)
+}
+
+// AccountInternalTransactions returns list of internal transactions of an account
+// either sent, or received by the account. The default order is used if the sort is not given.
+func (p *proxy) AccountInternalTransactions(adr *common.Address, cursor *string, count int32, sort *types.ListSort) (*types.InternalTransactionList, error) {
+	return p.db.InternalTransactions(cursor, count, &bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: types.FiInternalTrxFrom, Value: adr.String()}},
+		bson.D{{Key: types.FiInternalTrxTo, Value: adr.String()}},
+	}}}, sort)
+}
@@ -16,7 +16,10 @@ import (
 	"fantom-api-graphql/internal/repository/geoip"
 	"fantom-api-graphql/internal/repository/p2p"
 	"fantom-api-graphql/internal/repository/rpc"
+	"fantom-api-graphql/internal/types"
 	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"golang.org/x/sync/singleflight"
 	"sync"
 )
@@ -69,6 +72,9 @@ type proxy struct {
 	// transaction estimator counter
 	txCount uint64
 
+	// the most recent block observed on the chain
+	head uint64
+
 	// we need a Group to use single flight to control price pulls
 	apiRequestGroup singleflight.Group
 
@@ -77,6 +83,18 @@ type proxy struct {
 
 	// smart contract compilers
 	solCompiler string
+
+	// slots of contract compilations allowed to run at once
+	solSlots chan struct{}
+
+	// built-in function signatures keyed by their selector
+	fnSignatures map[types.TrxSelector][]*types.TrxMethod
+
+	// built-in event signatures keyed by their topic
+	evtSignatures map[common.Hash][]*abi.Event
+
+	// parsed ABI of verified contracts keyed by the contract address
+	contractAbis sync.Map
 }
 
 // newRepository creates new instance of Repository implementation, namely proxy structure.
@@ -112,6 +130,13 @@ func newRepository() Repository {
 
 		// keep reference to the SOL compiler
 		solCompiler: cfg.Compiler.DefaultSolCompilerPath,
+		solSlots:    make(chan struct{}, compilationSlots(cfg.Compiler.MaxCompilations)),
+
+		// load the built-in function signatures
+		fnSignatures: functionSignaturesMap(),
+
+		// load the built-in event signatures
+		evtSignatures: eventSignaturesMap(),
 	}
 
 	// return the proxy
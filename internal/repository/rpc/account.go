@@ -48,3 +48,14 @@ func (ftm *FtmBridge) AccountNonce(addr *common.Address) (*hexutil.Uint64, error
 	}
 	return &nonce, nil
 }
+
+// AccountCode returns the deployed byte code of the given contract account from Opera node.
+func (ftm *FtmBridge) AccountCode(addr *common.Address) (hexutil.Bytes, error) {
+	var code hexutil.Bytes
+	err := ftm.rpc.Call(&code, "ftm_getCode", addr.Hex(), "latest")
+	if err != nil {
+		ftm.log.Errorf("can not get code of account [%s]", addr.Hex())
+		return nil, err
+	}
+	return code, nil
+}
@@ -17,6 +17,7 @@ import (
 	"fantom-api-graphql/internal/types"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ftm "github.com/ethereum/go-ethereum/rpc"
 	"math/big"
 	"time"
 )
@@ -82,6 +83,45 @@ func (ftm *FtmBridge) Block(numTag *string) (*types.Block, error) {
 	return &block, nil
 }
 
+// Blocks returns information about a list of blockchain blocks by their numbers in a single batch request.
+// Blocks not found in the blockchain are represented by nil in the result.
+func (ftm *FtmBridge) Blocks(nums []hexutil.Uint64) ([]*types.Block, error) {
+	// keep track of the operation
+	ftm.log.Debugf("loading details of %d blocks", len(nums))
+
+	// call for data
+	list := make([]types.Block, len(nums))
+	elems := blockBatch(nums, list)
+	if err := ftm.rpc.BatchCall(elems); err != nil {
+		ftm.log.Error("blocks could not be extracted")
+		return nil, err
+	}
+
+	res := make([]*types.Block, len(nums))
+	for i := range elems {
+		// detect block not found situation; block number is zero and the hash is also zero
+		if elems[i].Error != nil || (uint64(list[i].Number) == 0 && list[i].Hash.Big().Cmp(big.NewInt(0)) == 0) {
+			ftm.log.Debugf("block [%s] not found", nums[i].String())
+			continue
+		}
+		res[i] = &list[i]
+	}
+	return res, nil
+}
+
+// blockBatch prepares the batch of block requests decoding into the given list of blocks.
+func blockBatch(nums []hexutil.Uint64, list []types.Block) []ftm.BatchElem {
+	elems := make([]ftm.BatchElem, len(nums))
+	for i := range nums {
+		elems[i] = ftm.BatchElem{
+			Method: "ftm_getBlockByNumber",
+			Args:   []interface{}{nums[i].String(), false},
+			Result: &list[i],
+		}
+	}
+	return elems
+}
+
 // BlockByHash returns information about a blockchain block by hash.
 func (ftm *FtmBridge) BlockByHash(hash *string) (*types.Block, error) {
 	// keep track of the operation
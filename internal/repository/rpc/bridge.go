@@ -18,8 +18,10 @@ import (
 	"fantom-api-graphql/internal/config"
 	"fantom-api-graphql/internal/logger"
 	"fantom-api-graphql/internal/repository/rpc/contracts"
+	"fantom-api-graphql/internal/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	etc "github.com/ethereum/go-ethereum/core/types"
 	eth "github.com/ethereum/go-ethereum/ethclient"
 	ftm "github.com/ethereum/go-ethereum/rpc"
@@ -31,13 +33,19 @@ import (
 // rpcHeadProxyChannelCapacity represents the capacity of the new received blocks proxy channel.
 const rpcHeadProxyChannelCapacity = 10000
 
+// rpcPendingProxyChannelCapacity represents the capacity of the new pending transactions proxy channel.
+const rpcPendingProxyChannelCapacity = 10000
+
 // FtmBridge represents Opera RPC abstraction layer.
 type FtmBridge struct {
-	rpc *ftm.Client
-	eth *eth.Client
+	rpc *rpcClient
+	eth *ethClient
 	log logger.Logger
 	cg  *singleflight.Group
 
+	// transport represents the upstream pool, recording, or replaying node transport; nil for a single live node
+	transport *pipeTransport
+
 	// fMintCfg represents the configuration of the fMint protocol
 	sigConfig     *config.ServerSignature
 	sfcConfig     *config.Staking
@@ -56,22 +64,25 @@ type FtmBridge struct {
 	wg       *sync.WaitGroup
 	sigClose chan bool
 	headers  chan *etc.Header
+	pending  chan common.Hash
 }
 
 // New creates new Opera RPC connection bridge.
 func New(cfg *config.Config, log logger.Logger) (*FtmBridge, error) {
-	cli, con, err := connect(cfg, log)
+	cli, con, tr, err := connect(cfg, log)
 	if err != nil {
 		log.Criticalf("can not open connection; %s", err.Error())
 		return nil, err
 	}
 
 	// build the bridge structure using the con we have
+	rc := &rpcClient{Client: cli}
 	br := &FtmBridge{
-		rpc: cli,
-		eth: con,
-		log: log,
-		cg:  new(singleflight.Group),
+		rpc:       rc,
+		eth:       &ethClient{Client: con, batch: newCallBatcher(&cfg.Opera, rc, log)},
+		log:       log,
+		cg:        new(singleflight.Group),
+		transport: tr,
 
 		// special configuration options below this line
 		sigConfig:     &cfg.Signature,
@@ -89,6 +100,7 @@ func New(cfg *config.Config, log logger.Logger) (*FtmBridge, error) {
 		wg:       new(sync.WaitGroup),
 		sigClose: make(chan bool, 1),
 		headers:  make(chan *etc.Header, rpcHeadProxyChannelCapacity),
+		pending:  make(chan common.Hash, rpcPendingProxyChannelCapacity),
 	}
 
 	// inform about the local address of the API node
@@ -101,38 +113,46 @@ func New(cfg *config.Config, log logger.Logger) (*FtmBridge, error) {
 }
 
 // connect opens connections we need to communicate with the blockchain node.
-func connect(cfg *config.Config, log logger.Logger) (*ftm.Client, *eth.Client, error) {
+// The upstream pool, recording and replaying transports share a single client for both connections.
+func connect(cfg *config.Config, log logger.Logger) (*ftm.Client, *eth.Client, *pipeTransport, error) {
 	// log what we do
 	log.Debugf("connecting blockchain node at %s", cfg.Opera.ApiNodeUrl)
 
 	// try to establish a connection
-	client, err := ftm.Dial(cfg.Opera.ApiNodeUrl)
+	client, tr, err := dialTransport(&cfg.Opera, log)
 	if err != nil {
 		log.Critical(err)
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+
+	// use the same connection for the smart contract interaction on a transport
+	if tr != nil {
+		log.Notice("node transport open")
+		return client, eth.NewClient(client), tr, nil
 	}
 
 	// try to establish a for smart contract interaction
 	con, err := eth.Dial(cfg.Opera.ApiNodeUrl)
 	if err != nil {
 		log.Critical(err)
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// log
 	log.Notice("node connection open")
-	return client, con, nil
+	return client, con, nil, nil
 }
 
 // run starts the bridge threads required to collect blockchain data.
 func (ftm *FtmBridge) run() {
-	ftm.wg.Add(1)
+	ftm.wg.Add(2)
 	go ftm.observeBlocks()
+	go ftm.observePending()
 }
 
 // terminate kills the bridge threads to end the bridge gracefully.
 func (ftm *FtmBridge) terminate() {
-	ftm.sigClose <- true
+	close(ftm.sigClose)
 	ftm.wg.Wait()
 	ftm.log.Noticef("rpc threads terminated")
 }
@@ -142,6 +162,13 @@ func (ftm *FtmBridge) Close() {
 	// terminate threads before we close connections
 	ftm.terminate()
 
+	// close the node transport; the client waits for it to close
+	if ftm.transport != nil {
+		if err := ftm.transport.Close(); err != nil {
+			ftm.log.Errorf("can not close node transport; %s", err.Error())
+		}
+	}
+
 	// do we have a connection?
 	if ftm.rpc != nil {
 		ftm.rpc.Close()
@@ -150,9 +177,19 @@ func (ftm *FtmBridge) Close() {
 	}
 }
 
+// Upstreams provides the status of the upstream nodes of the node pool; empty if the pool is not used.
+func (ftm *FtmBridge) Upstreams() []types.UpstreamStatus {
+	if ftm.transport != nil {
+		if pool, ok := ftm.transport.handler.(*upstreamPool); ok {
+			return pool.status()
+		}
+	}
+	return make([]types.UpstreamStatus, 0)
+}
+
 // Connection returns open Opera connection.
 func (ftm *FtmBridge) Connection() *ftm.Client {
-	return ftm.rpc
+	return ftm.rpc.Client
 }
 
 // DefaultCallOpts creates a default record for call options.
@@ -202,3 +239,9 @@ func (ftm *FtmBridge) SfcAbi() *abi.ABI {
 func (ftm *FtmBridge) ObservedBlockProxy() chan *etc.Header {
 	return ftm.headers
 }
+
+// ObservedPendingProxy provides a channel fed with hashes of new pending transactions
+// observed by the connected blockchain node.
+func (ftm *FtmBridge) ObservedPendingProxy() chan common.Hash {
+	return ftm.pending
+}
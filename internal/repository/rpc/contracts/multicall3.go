@@ -0,0 +1,246 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Multicall3Call3 is an auto generated low-level Go binding around an user-defined struct.
+type Multicall3Call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// Multicall3Result is an auto generated low-level Go binding around an user-defined struct.
+type Multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// MulticallThreeMetaData contains all meta data concerning the MulticallThree contract.
+var MulticallThreeMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"allowFailure\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"callData\",\"type\":\"bytes\"}],\"internalType\":\"structMulticall3.Call3[]\",\"name\":\"calls\",\"type\":\"tuple[]\"}],\"name\":\"aggregate3\",\"outputs\":[{\"components\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"returnData\",\"type\":\"bytes\"}],\"internalType\":\"structMulticall3.Result[]\",\"name\":\"returnData\",\"type\":\"tuple[]\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// MulticallThreeABI is the input ABI used to generate the binding from.
+// Deprecated: Use MulticallThreeMetaData.ABI instead.
+var MulticallThreeABI = MulticallThreeMetaData.ABI
+
+// MulticallThree is an auto generated Go binding around an Ethereum contract.
+type MulticallThree struct {
+	MulticallThreeCaller     // Read-only binding to the contract
+	MulticallThreeTransactor // Write-only binding to the contract
+	MulticallThreeFilterer   // Log filterer for contract events
+}
+
+// MulticallThreeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MulticallThreeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MulticallThreeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MulticallThreeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MulticallThreeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MulticallThreeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MulticallThreeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MulticallThreeSession struct {
+	Contract     *MulticallThree   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MulticallThreeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MulticallThreeCallerSession struct {
+	Contract *MulticallThreeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// MulticallThreeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MulticallThreeTransactorSession struct {
+	Contract     *MulticallThreeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// MulticallThreeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MulticallThreeRaw struct {
+	Contract *MulticallThree // Generic contract binding to access the raw methods on
+}
+
+// MulticallThreeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MulticallThreeCallerRaw struct {
+	Contract *MulticallThreeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MulticallThreeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MulticallThreeTransactorRaw struct {
+	Contract *MulticallThreeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMulticallThree creates a new instance of MulticallThree, bound to a specific deployed contract.
+func NewMulticallThree(address common.Address, backend bind.ContractBackend) (*MulticallThree, error) {
+	contract, err := bindMulticallThree(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MulticallThree{MulticallThreeCaller: MulticallThreeCaller{contract: contract}, MulticallThreeTransactor: MulticallThreeTransactor{contract: contract}, MulticallThreeFilterer: MulticallThreeFilterer{contract: contract}}, nil
+}
+
+// NewMulticallThreeCaller creates a new read-only instance of MulticallThree, bound to a specific deployed contract.
+func NewMulticallThreeCaller(address common.Address, caller bind.ContractCaller) (*MulticallThreeCaller, error) {
+	contract, err := bindMulticallThree(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MulticallThreeCaller{contract: contract}, nil
+}
+
+// NewMulticallThreeTransactor creates a new write-only instance of MulticallThree, bound to a specific deployed contract.
+func NewMulticallThreeTransactor(address common.Address, transactor bind.ContractTransactor) (*MulticallThreeTransactor, error) {
+	contract, err := bindMulticallThree(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MulticallThreeTransactor{contract: contract}, nil
+}
+
+// NewMulticallThreeFilterer creates a new log filterer instance of MulticallThree, bound to a specific deployed contract.
+func NewMulticallThreeFilterer(address common.Address, filterer bind.ContractFilterer) (*MulticallThreeFilterer, error) {
+	contract, err := bindMulticallThree(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MulticallThreeFilterer{contract: contract}, nil
+}
+
+// bindMulticallThree binds a generic wrapper to an already deployed contract.
+func bindMulticallThree(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MulticallThreeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MulticallThree *MulticallThreeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MulticallThree.Contract.MulticallThreeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MulticallThree *MulticallThreeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MulticallThree.Contract.MulticallThreeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MulticallThree *MulticallThreeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MulticallThree.Contract.MulticallThreeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MulticallThree *MulticallThreeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MulticallThree.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MulticallThree *MulticallThreeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MulticallThree.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MulticallThree *MulticallThreeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MulticallThree.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetBlockNumber is a free data retrieval call binding the contract method 0x42cbb15c.
+//
+// Solidity: function getBlockNumber() view returns(uint256 blockNumber)
+func (_MulticallThree *MulticallThreeCaller) GetBlockNumber(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MulticallThree.contract.Call(opts, &out, "getBlockNumber")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBlockNumber is a free data retrieval call binding the contract method 0x42cbb15c.
+//
+// Solidity: function getBlockNumber() view returns(uint256 blockNumber)
+func (_MulticallThree *MulticallThreeSession) GetBlockNumber() (*big.Int, error) {
+	return _MulticallThree.Contract.GetBlockNumber(&_MulticallThree.CallOpts)
+}
+
+// GetBlockNumber is a free data retrieval call binding the contract method 0x42cbb15c.
+//
+// Solidity: function getBlockNumber() view returns(uint256 blockNumber)
+func (_MulticallThree *MulticallThreeCallerSession) GetBlockNumber() (*big.Int, error) {
+	return _MulticallThree.Contract.GetBlockNumber(&_MulticallThree.CallOpts)
+}
+
+// Aggregate3 is a paid mutator transaction binding the contract method 0x82ad56cb.
+//
+// Solidity: function aggregate3((address,bool,bytes)[] calls) payable returns((bool,bytes)[] returnData)
+func (_MulticallThree *MulticallThreeTransactor) Aggregate3(opts *bind.TransactOpts, calls []Multicall3Call3) (*types.Transaction, error) {
+	return _MulticallThree.contract.Transact(opts, "aggregate3", calls)
+}
+
+// Aggregate3 is a paid mutator transaction binding the contract method 0x82ad56cb.
+//
+// Solidity: function aggregate3((address,bool,bytes)[] calls) payable returns((bool,bytes)[] returnData)
+func (_MulticallThree *MulticallThreeSession) Aggregate3(calls []Multicall3Call3) (*types.Transaction, error) {
+	return _MulticallThree.Contract.Aggregate3(&_MulticallThree.TransactOpts, calls)
+}
+
+// Aggregate3 is a paid mutator transaction binding the contract method 0x82ad56cb.
+//
+// Solidity: function aggregate3((address,bool,bytes)[] calls) payable returns((bool,bytes)[] returnData)
+func (_MulticallThree *MulticallThreeTransactorSession) Aggregate3(calls []Multicall3Call3) (*types.Transaction, error) {
+	return _MulticallThree.Contract.Aggregate3(&_MulticallThree.TransactOpts, calls)
+}
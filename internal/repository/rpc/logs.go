@@ -0,0 +1,40 @@
+/*
+Package rpc implements bridge to Opera full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Opera node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Opera RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Opera RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Opera RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	retypes "github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FilterLogs loads logs of the given block range matching any of the given
+// event topics using eth_getLogs call.
+func (ftm *FtmBridge) FilterLogs(from uint64, to uint64, topics []common.Hash) ([]retypes.Log, error) {
+	// keep track of the operation
+	ftm.log.Debugf("loading logs of %d topics in <#%d, #%d>", len(topics), from, to)
+
+	list, err := ftm.eth.FilterLogs(context.Background(), ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Topics:    [][]common.Hash{topics},
+	})
+	if err != nil {
+		ftm.log.Errorf("logs of <#%d, #%d> not available; %s", from, to, err.Error())
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,91 @@
+/*
+Package rpc implements bridge to Opera full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Opera node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Opera RPC interface for remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Opera RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Opera RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"context"
+	"fantom-api-graphql/internal/metrics"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	etc "github.com/ethereum/go-ethereum/core/types"
+	eth "github.com/ethereum/go-ethereum/ethclient"
+	ftm "github.com/ethereum/go-ethereum/rpc"
+	"math/big"
+	"time"
+)
+
+// rpcClient wraps the node RPC client to collect latencies and errors of the calls by method.
+type rpcClient struct {
+	*ftm.Client
+}
+
+// Call performs a JSON-RPC call with the given arguments and unmarshal the result into the given container.
+func (c *rpcClient) Call(result interface{}, method string, args ...interface{}) error {
+	return c.CallContext(context.Background(), result, method, args...)
+}
+
+// CallContext performs a JSON-RPC call with the given arguments and context.
+func (c *rpcClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	start := time.Now()
+	err := c.Client.CallContext(ctx, result, method, args...)
+	metrics.ObserveRpcCall(method, start, err)
+	return err
+}
+
+// BatchCall sends all given requests as a single batch and waits for the node to respond.
+func (c *rpcClient) BatchCall(b []ftm.BatchElem) error {
+	start := time.Now()
+	err := c.Client.BatchCall(b)
+	metrics.ObserveRpcCall("batch", start, err)
+	return err
+}
+
+// ethClient wraps the node Ethereum API client used by the contract bindings
+// to collect latencies and errors of the contract calls.
+// Contract reads on the latest state are batched if the batcher is available.
+type ethClient struct {
+	*eth.Client
+	batch *callBatcher
+}
+
+// CallContract executes a message call transaction on the node.
+func (c *ethClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	start := time.Now()
+
+	var res []byte
+	var err error
+	if c.batch != nil && c.batch.accepts(&msg, blockNumber) {
+		res, err = c.batch.call(ctx, msg)
+	} else {
+		res, err = c.Client.CallContract(ctx, msg, blockNumber)
+	}
+	metrics.ObserveRpcCall("eth_call", start, err)
+	return res, err
+}
+
+// CodeAt returns the contract code of the given account.
+func (c *ethClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	start := time.Now()
+	res, err := c.Client.CodeAt(ctx, account, blockNumber)
+	metrics.ObserveRpcCall("eth_getCode", start, err)
+	return res, err
+}
+
+// FilterLogs executes a filter query on the node.
+func (c *ethClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]etc.Log, error) {
+	start := time.Now()
+	res, err := c.Client.FilterLogs(ctx, q)
+	metrics.ObserveRpcCall("eth_getLogs", start, err)
+	return res, err
+}
@@ -0,0 +1,237 @@
+/*
+Package rpc implements bridge to Opera full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Opera node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Opera RPC interface for remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Opera RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Opera RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/logger"
+	"fantom-api-graphql/internal/metrics"
+	"fantom-api-graphql/internal/repository/rpc/contracts"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ftm "github.com/ethereum/go-ethereum/rpc"
+	"math/big"
+	"sync"
+	"time"
+)
+
+//go:generate tools/abigen.sh --abi ./contracts/abi/multicall3.abi --pkg contracts --type MulticallThree --out ./contracts/multicall3.go
+
+// errCallReverted represents the error of a batched contract call reverted by the contract.
+var errCallReverted = errors.New("execution reverted")
+
+// callBatcher coalesces concurrent contract reads on the latest state into batches.
+// A batch is sent as a single Multicall3 aggregate call if the multicall contract is configured,
+// or as a JSON-RPC batch request otherwise. Calls aggregated by the multicall contract
+// are executed with the contract as the sender, the original sender is not preserved.
+type callBatcher struct {
+	rpc       *rpcClient
+	multicall *common.Address
+	abi       *abi.ABI
+	log       logger.Logger
+	window    time.Duration
+	size      int
+
+	mu    sync.Mutex
+	queue []*batchedCall
+	timer *time.Timer
+}
+
+// batchedCall represents a contract read waiting in a batch.
+type batchedCall struct {
+	msg  ethereum.CallMsg
+	res  []byte
+	err  error
+	done chan struct{}
+}
+
+// newCallBatcher creates a new contract calls batcher; nil if the batching is disabled.
+func newCallBatcher(cfg *config.OperaNode, rpc *rpcClient, log logger.Logger) *callBatcher {
+	if cfg.BatchWindow <= 0 || cfg.BatchSize <= 1 {
+		log.Notice("contract calls batching disabled")
+		return nil
+	}
+
+	cb := callBatcher{
+		rpc:    rpc,
+		log:    log,
+		window: cfg.BatchWindow,
+		size:   cfg.BatchSize,
+	}
+
+	// the multicall contract is optional, JSON-RPC batch is used without it
+	if cfg.Multicall != (common.Address{}) {
+		ab, err := contracts.MulticallThreeMetaData.GetAbi()
+		if err != nil {
+			log.Criticalf("multicall ABI not available; %s", err.Error())
+			return nil
+		}
+
+		cb.abi = ab
+		cb.multicall = &cfg.Multicall
+		log.Noticef("contract calls aggregated by multicall %s", cfg.Multicall.String())
+	}
+	return &cb
+}
+
+// accepts checks if the given contract call can be batched.
+// Only plain reads on the latest state are batched; the multicall contract would be
+// the sender of an aggregated call, so calls with an explicit sender are not batched.
+func (cb *callBatcher) accepts(msg *ethereum.CallMsg, blockNumber *big.Int) bool {
+	return blockNumber == nil && msg.To != nil && msg.From == (common.Address{}) && msg.Gas == 0 &&
+		(msg.Value == nil || msg.Value.Sign() == 0) &&
+		msg.GasPrice == nil && msg.GasFeeCap == nil && msg.GasTipCap == nil && msg.AccessList == nil
+}
+
+// call adds the given contract call to the pending batch and waits for its result.
+func (cb *callBatcher) call(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	bc := batchedCall{msg: msg, done: make(chan struct{})}
+
+	cb.mu.Lock()
+	cb.queue = append(cb.queue, &bc)
+	if len(cb.queue) >= cb.size {
+		go cb.execute(cb.take())
+	} else if cb.timer == nil {
+		cb.timer = time.AfterFunc(cb.window, cb.flush)
+	}
+	cb.mu.Unlock()
+
+	select {
+	case <-bc.done:
+		return bc.res, bc.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// take removes the pending batch from the queue; the caller is expected to hold the lock.
+func (cb *callBatcher) take() []*batchedCall {
+	q := cb.queue
+	cb.queue = nil
+
+	if cb.timer != nil {
+		cb.timer.Stop()
+		cb.timer = nil
+	}
+	return q
+}
+
+// flush executes the pending batch once the batching window elapsed.
+func (cb *callBatcher) flush() {
+	cb.mu.Lock()
+	q := cb.take()
+	cb.mu.Unlock()
+
+	if len(q) > 0 {
+		cb.execute(q)
+	}
+}
+
+// execute sends the given batch to the node and resolves all its calls.
+func (cb *callBatcher) execute(q []*batchedCall) {
+	defer func() {
+		for _, bc := range q {
+			close(bc.done)
+		}
+	}()
+
+	// the multicall contract may not be available; fall back to the JSON-RPC batch
+	if cb.multicall != nil && len(q) > 1 {
+		err := cb.aggregate(q)
+		if err == nil {
+			return
+		}
+		cb.log.Errorf("multicall of %d calls failed; %s", len(q), err.Error())
+	}
+	cb.batch(q)
+}
+
+// aggregate executes the given batch as a single Multicall3 aggregate call.
+func (cb *callBatcher) aggregate(q []*batchedCall) error {
+	calls := make([]contracts.Multicall3Call3, len(q))
+	for i, bc := range q {
+		calls[i] = contracts.Multicall3Call3{Target: *bc.msg.To, AllowFailure: true, CallData: bc.msg.Data}
+	}
+
+	data, err := cb.abi.Pack("aggregate3", calls)
+	if err != nil {
+		return err
+	}
+
+	var out hexutil.Bytes
+	start := time.Now()
+	err = cb.rpc.Client.CallContext(context.Background(), &out, "eth_call", ethCallArg(ethereum.CallMsg{To: cb.multicall, Data: data}), "latest")
+	metrics.ObserveRpcCall("multicall", start, err)
+	if err != nil {
+		return err
+	}
+
+	res, err := cb.abi.Unpack("aggregate3", out)
+	if err != nil {
+		return err
+	}
+
+	list := *abi.ConvertType(res[0], new([]contracts.Multicall3Result)).(*[]contracts.Multicall3Result)
+	if len(list) != len(q) {
+		return fmt.Errorf("multicall responded %d results for %d calls", len(list), len(q))
+	}
+
+	for i, bc := range q {
+		bc.res = list[i].ReturnData
+		if !list[i].Success {
+			bc.res, bc.err = nil, errCallReverted
+		}
+	}
+	return nil
+}
+
+// batch executes the given batch as a JSON-RPC batch request.
+func (cb *callBatcher) batch(q []*batchedCall) {
+	elems := make([]ftm.BatchElem, len(q))
+	for i, bc := range q {
+		elems[i] = ftm.BatchElem{
+			Method: "eth_call",
+			Args:   []interface{}{ethCallArg(bc.msg), "latest"},
+			Result: new(hexutil.Bytes),
+		}
+	}
+
+	if err := cb.rpc.BatchCall(elems); err != nil {
+		for _, bc := range q {
+			bc.err = err
+		}
+		return
+	}
+
+	for i, bc := range q {
+		bc.res, bc.err = *elems[i].Result.(*hexutil.Bytes), elems[i].Error
+	}
+}
+
+// ethCallArg builds the eth_call arguments object for the given contract call.
+func ethCallArg(msg ethereum.CallMsg) map[string]interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	return arg
+}
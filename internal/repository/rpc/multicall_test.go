@@ -0,0 +1,145 @@
+package rpc
+
+import (
+	"context"
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/logger"
+	"fantom-api-graphql/internal/repository/rpc/contracts"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ftm "github.com/ethereum/go-ethereum/rpc"
+	"github.com/onsi/gomega"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testMulticall represents the address of the mock multicall contract.
+var testMulticall = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// testRevertTarget represents the address of a mock contract reverting all calls.
+var testRevertTarget = common.HexToAddress("0xdead")
+
+// testCallService mocks contract calls responding the call data back prefixed with the target address.
+type testCallService struct {
+	calls      int32
+	multicalls int32
+}
+
+// testCallArgs represents the eth_call arguments of the mock contract calls.
+type testCallArgs struct {
+	To   common.Address `json:"to"`
+	Data hexutil.Bytes  `json:"data"`
+}
+
+func (s *testCallService) Call(args testCallArgs, _ string) (hexutil.Bytes, error) {
+	if args.To != testMulticall {
+		atomic.AddInt32(&s.calls, 1)
+		if args.To == testRevertTarget {
+			return nil, errCallReverted
+		}
+		return append(args.To.Bytes(), args.Data...), nil
+	}
+
+	atomic.AddInt32(&s.multicalls, 1)
+	ab, err := contracts.MulticallThreeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	in, err := ab.Methods["aggregate3"].Inputs.Unpack(args.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	calls := *abi.ConvertType(in[0], new([]contracts.Multicall3Call3)).(*[]contracts.Multicall3Call3)
+	res := make([]contracts.Multicall3Result, len(calls))
+	for i, c := range calls {
+		res[i] = contracts.Multicall3Result{Success: c.Target != testRevertTarget, ReturnData: append(c.Target.Bytes(), c.CallData...)}
+		if !res[i].Success {
+			res[i].ReturnData = []byte{}
+		}
+	}
+	return ab.Methods["aggregate3"].Outputs.Pack(res)
+}
+
+// newTestBatcher creates an eth client batching calls to a mock node.
+func newTestBatcher(g *gomega.WithT, multicall common.Address) (*ethClient, *testCallService, func()) {
+	svc := new(testCallService)
+	srv := ftm.NewServer()
+	g.Expect(srv.RegisterName("eth", svc)).To(gomega.Succeed())
+	node := httptest.NewServer(srv.WebsocketHandler([]string{"*"}))
+
+	cli, err := ftm.Dial("ws://" + node.Listener.Addr().String())
+	g.Expect(err).To(gomega.BeNil())
+
+	log := logger.New(&config.Config{Log: config.Log{Level: "CRITICAL", Format: "%{message}"}})
+	cfg := config.OperaNode{Multicall: multicall, BatchWindow: 100 * time.Millisecond, BatchSize: 100}
+	ec := &ethClient{batch: newCallBatcher(&cfg, &rpcClient{Client: cli}, log)}
+
+	return ec, svc, func() {
+		cli.Close()
+		node.Close()
+	}
+}
+
+// runBatchedCalls executes concurrent contract calls and checks their results.
+func runBatchedCalls(g *gomega.WithT, ec *ethClient, count int) {
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			to := common.BigToAddress(common.Big1)
+			if i%10 == 0 {
+				to = testRevertTarget
+			}
+
+			res, err := ec.CallContract(context.Background(), ethereum.CallMsg{To: &to, Data: []byte{byte(i)}}, nil)
+			if to == testRevertTarget {
+				g.Expect(err).NotTo(gomega.BeNil())
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(res).To(gomega.Equal(append(to.Bytes(), byte(i))))
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestCallBatcherMulticall(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ec, svc, done := newTestBatcher(g, testMulticall)
+	defer done()
+
+	// all the concurrent calls fit into a single aggregate call
+	runBatchedCalls(g, ec, 50)
+	g.Expect(atomic.LoadInt32(&svc.multicalls)).To(gomega.Equal(int32(1)))
+	g.Expect(atomic.LoadInt32(&svc.calls)).To(gomega.Equal(int32(0)))
+
+	// the batch size limit splits the calls into several aggregate calls
+	runBatchedCalls(g, ec, 250)
+	g.Expect(atomic.LoadInt32(&svc.multicalls)).To(gomega.BeNumerically(">=", 4))
+}
+
+func TestCallBatcherJsonRpc(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ec, svc, done := newTestBatcher(g, common.Address{})
+	defer done()
+
+	runBatchedCalls(g, ec, 50)
+	g.Expect(atomic.LoadInt32(&svc.multicalls)).To(gomega.Equal(int32(0)))
+	g.Expect(atomic.LoadInt32(&svc.calls)).To(gomega.Equal(int32(50)))
+
+	// calls on a historical state are not batched
+	g.Expect(ec.batch.accepts(&ethereum.CallMsg{To: &testRevertTarget}, common.Big1)).To(gomega.BeFalse())
+
+	// calls with an explicit sender are not batched
+	g.Expect(ec.batch.accepts(&ethereum.CallMsg{To: &testRevertTarget}, nil)).To(gomega.BeTrue())
+	g.Expect(ec.batch.accepts(&ethereum.CallMsg{From: common.HexToAddress("0x01"), To: &testRevertTarget}, nil)).To(gomega.BeFalse())
+}
@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
+	"testing"
+	"time"
+)
+
+// The recorded chain fixture contains blocks #1000 and #1001, and the head notification of #1001.
+// Block #1000 contains an FTM transfer and an ERC20 transfer emitting a Transfer event.
+const (
+	fixtureChain     = "testdata/chain"
+	fixtureBlockHash = "0xacdb9e0be6bc1f6bb95457d360976978cddb8f5204b936dae6e8e55038ffca79"
+	fixtureTrxNative = "0x0e66b9fd3df870f7e10401e67163e52c44d37faab22be02feb8dd1961c05f30b"
+	fixtureTrxToken  = "0x87c1b27ba59cff9810fb7e174e4e23101c27439593ea83a3b718894b90b95cf8"
+	fixtureToken     = "0x04068da6c83afcfa0e13ba15a6696662335d5b75"
+	fixtureTransfer  = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+)
+
+// newReplayBridge opens the node bridge replaying the recorded chain fixture.
+func newReplayBridge(g *gomega.WithT) *FtmBridge {
+	log := logger.New(&config.Config{Log: config.Log{Level: "ERROR", Format: "%{message}"}})
+	br, err := New(&config.Config{Opera: config.OperaNode{
+		Transport: transportReplay,
+		Fixtures:  fixtureChain,
+	}}, log)
+	g.Expect(err).To(gomega.BeNil())
+	return br
+}
+
+func TestReplayIngestion(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	br := newReplayBridge(g)
+	defer br.Close()
+
+	// the head is observed as if sent by the node
+	select {
+	case hdr := <-br.ObservedBlockProxy():
+		g.Expect(hdr.Number.Uint64()).To(gomega.Equal(uint64(1001)))
+		g.Expect(hdr.ParentHash.String()).To(gomega.Equal(fixtureBlockHash))
+	case <-time.After(5 * time.Second):
+		t.Fatal("head notification not replayed")
+	}
+
+	bh, err := br.BlockHeight()
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(bh.ToInt().Uint64()).To(gomega.Equal(uint64(1001)))
+
+	// blocks are loaded by the scanner in batches
+	list, err := br.Blocks([]hexutil.Uint64{1000, 1001})
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(list).To(gomega.HaveLen(2))
+	g.Expect(list[0].Hash.String()).To(gomega.Equal(fixtureBlockHash))
+	g.Expect(list[0].Txs).To(gomega.HaveLen(2))
+	g.Expect(list[1].ParentHash.String()).To(gomega.Equal(fixtureBlockHash))
+	g.Expect(list[1].Txs).To(gomega.BeEmpty())
+
+	tag := "0x3e8"
+	blk, err := br.Block(&tag)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(uint64(blk.Number)).To(gomega.Equal(uint64(1000)))
+
+	// transactions are loaded with their receipts
+	th := common.HexToHash(fixtureTrxNative)
+	trx, err := br.Transaction(&th)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(uint64(*trx.Status)).To(gomega.Equal(uint64(1)))
+	g.Expect(uint64(*trx.GasUsed)).To(gomega.Equal(uint64(21000)))
+	g.Expect(trx.Value.ToInt().String()).To(gomega.Equal("1000000000000000000"))
+	g.Expect(trx.Logs).To(gomega.BeEmpty())
+
+	th = common.HexToHash(fixtureTrxToken)
+	trx, err = br.Transaction(&th)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(trx.To.String()).To(gomega.Equal(common.HexToAddress(fixtureToken).String()))
+	g.Expect(trx.Logs).To(gomega.HaveLen(1))
+	g.Expect(trx.Logs[0].Topics[0].String()).To(gomega.Equal(fixtureTransfer))
+	g.Expect(trx.Logs[0].TxHash).To(gomega.Equal(th))
+
+	// unknown transactions are not found
+	th = common.HexToHash(fixtureBlockHash)
+	_, err = br.Transaction(&th)
+	g.Expect(err).NotTo(gomega.BeNil())
+
+	// logs are filtered by the back-fill
+	logs, err := br.FilterLogs(1000, 1001, []common.Hash{common.HexToHash(fixtureTransfer)})
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(logs).To(gomega.HaveLen(1))
+	g.Expect(logs[0].BlockNumber).To(gomega.Equal(uint64(1000)))
+	g.Expect(logs[0].Address.String()).To(gomega.Equal(common.HexToAddress(fixtureToken).String()))
+}
@@ -0,0 +1,37 @@
+/*
+Package rpc implements bridge to Opera full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Opera node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Opera RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Opera RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Opera RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// callTracer represents the name of the node tracer providing the call tree of a transaction.
+const callTracer = "callTracer"
+
+// TraceTransaction provides the call tree of the given transaction using the call tracer of the node.
+// The node must support tracing API and keep the archive state of the traced block.
+func (ftm *FtmBridge) TraceTransaction(hash *common.Hash) (*types.CallFrame, error) {
+	// keep track of the operation
+	ftm.log.Debugf("tracing transaction %s", hash.String())
+
+	var cf types.CallFrame
+	err := ftm.rpc.Call(&cf, "debug_traceTransaction", hash, map[string]interface{}{"tracer": callTracer})
+	if err != nil {
+		ftm.log.Errorf("transaction %s could not be traced; %s", hash.String(), err.Error())
+		return nil, err
+	}
+	return &cf, nil
+}
@@ -0,0 +1,272 @@
+/*
+Package rpc implements bridge to Opera full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Opera node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Opera RPC interface for remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Opera RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Opera RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/logger"
+	"fmt"
+	ftm "github.com/ethereum/go-ethereum/rpc"
+	"io"
+	"strings"
+	"sync"
+)
+
+const (
+	// transportLive represents the node transport connected to a live node.
+	transportLive = "live"
+
+	// transportRecord represents the node transport connected to a live node
+	// with all the communication recorded into fixture files.
+	transportRecord = "record"
+
+	// transportReplay represents the node transport serving the recorded
+	// fixture files instead of a live node.
+	transportReplay = "replay"
+
+	// rpcSubscribeSuffix is the suffix of subscription methods.
+	rpcSubscribeSuffix = "_subscribe"
+
+	// rpcUnsubscribeSuffix is the suffix of subscription cancel methods.
+	rpcUnsubscribeSuffix = "_unsubscribe"
+
+	// rpcNotificationSuffix is the suffix of subscription notification methods.
+	rpcNotificationSuffix = "_subscription"
+
+	// rpcTransportErrorCode is the error code of a call failed inside the transport.
+	rpcTransportErrorCode = -32000
+)
+
+// rpcMessage represents a JSON-RPC message passed between the node client and a transport.
+type rpcMessage struct {
+	Version string          `json:"jsonrpc,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+}
+
+// rpcError represents an error response of a JSON-RPC call.
+type rpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// rpcNotification represents the parameters of a subscription notification message.
+type rpcNotification struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result"`
+}
+
+// rpcHandler represents a transport backend handling JSON-RPC requests of the node client.
+// The response, and any following subscription notifications, are passed to the send callback.
+type rpcHandler interface {
+	handle(req *rpcMessage, send func(*rpcMessage))
+	close() error
+}
+
+// pipeTransport implements an in-process JSON-RPC connection of the node client
+// with requests served by the given handler.
+type pipeTransport struct {
+	handler rpcHandler
+	log     logger.Logger
+	mu      sync.Mutex
+	reqIn   *io.PipeReader
+	reqOut  *io.PipeWriter
+	resIn   *io.PipeReader
+	resOut  *io.PipeWriter
+	enc     *json.Encoder
+}
+
+// dialTransport opens the node client connection using the transport configured.
+// The live transport does not need a closer, the transport is closed with the client.
+// Other transports must be closed before the client, the client waits for the transport to end.
+func dialTransport(cfg *config.OperaNode, log logger.Logger) (*ftm.Client, *pipeTransport, error) {
+	var h rpcHandler
+	var err error
+
+	switch cfg.Transport {
+	case transportLive, "":
+		if len(cfg.Upstreams) == 0 {
+			cli, err := ftm.Dial(cfg.ApiNodeUrl)
+			return cli, nil, err
+		}
+		h, err = newUpstreamPool(cfg, log)
+	case transportRecord:
+		h, err = newRecorder(cfg, log)
+	case transportReplay:
+		h, err = newReplayer(cfg, log)
+	default:
+		return nil, nil, fmt.Errorf("unknown node transport %s", cfg.Transport)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Noticef("using %s node transport", cfg.Transport)
+	pt := newPipeTransport(h, log)
+	cli, err := ftm.DialIO(context.Background(), pt.resIn, pt.reqOut)
+	if err != nil {
+		_ = pt.Close()
+		return nil, nil, err
+	}
+	return cli, pt, nil
+}
+
+// newPipeTransport creates a new in-process transport served by the given handler.
+func newPipeTransport(h rpcHandler, log logger.Logger) *pipeTransport {
+	pt := pipeTransport{handler: h, log: log}
+	pt.reqIn, pt.reqOut = io.Pipe()
+	pt.resIn, pt.resOut = io.Pipe()
+	pt.enc = json.NewEncoder(pt.resOut)
+
+	go pt.serve()
+	return &pt
+}
+
+// serve reads the requests of the client and passes them to the handler.
+func (pt *pipeTransport) serve() {
+	dec := json.NewDecoder(pt.reqIn)
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			if err != io.EOF && err != io.ErrClosedPipe {
+				pt.log.Errorf("node transport failed; %s", err.Error())
+			}
+			return
+		}
+
+		// batch calls are responded at once
+		raw = bytes.TrimSpace(raw)
+		if len(raw) > 0 && raw[0] == '[' {
+			go pt.batch(raw)
+			continue
+		}
+
+		var req rpcMessage
+		if err := json.Unmarshal(raw, &req); err != nil {
+			pt.log.Errorf("invalid node request; %s", err.Error())
+			continue
+		}
+		go pt.handler.handle(&req, pt.send)
+	}
+}
+
+// batch handles a batch of requests and sends all the responses together.
+func (pt *pipeTransport) batch(raw json.RawMessage) {
+	var list []*rpcMessage
+	if err := json.Unmarshal(raw, &list); err != nil {
+		pt.log.Errorf("invalid node batch request; %s", err.Error())
+		return
+	}
+
+	// subscriptions are not available in batches, only the response is expected
+	res := make([]*rpcMessage, len(list))
+	for i, req := range list {
+		var once sync.Once
+		pt.handler.handle(req, func(msg *rpcMessage) {
+			once.Do(func() { res[i] = msg })
+		})
+	}
+	pt.write(res)
+}
+
+// send writes the given message to the client.
+func (pt *pipeTransport) send(msg *rpcMessage) {
+	pt.write(msg)
+}
+
+// write encodes the given value into the client stream.
+func (pt *pipeTransport) write(v interface{}) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	if err := pt.enc.Encode(v); err != nil && err != io.ErrClosedPipe {
+		pt.log.Errorf("can not write node response; %s", err.Error())
+	}
+}
+
+// Close terminates the transport and the handler.
+func (pt *pipeTransport) Close() error {
+	_ = pt.reqIn.Close()
+	_ = pt.resOut.Close()
+	return pt.handler.close()
+}
+
+// response creates a response message to the given request.
+func response(req *rpcMessage, result json.RawMessage, err *rpcError) *rpcMessage {
+	// the client expects an explicit null result
+	if result == nil && err == nil {
+		result = json.RawMessage("null")
+	}
+	return &rpcMessage{Version: "2.0", ID: req.ID, Result: result, Error: err}
+}
+
+// notification creates a subscription notification message.
+func notification(namespace string, id string, result json.RawMessage) *rpcMessage {
+	params, err := json.Marshal(rpcNotification{Subscription: id, Result: result})
+	if err != nil {
+		return nil
+	}
+	return &rpcMessage{Version: "2.0", Method: namespace + rpcNotificationSuffix, Params: params}
+}
+
+// callArgs decodes the params of the given request into a list of call arguments.
+func callArgs(req *rpcMessage) ([]interface{}, error) {
+	if len(req.Params) == 0 || string(req.Params) == "null" {
+		return nil, nil
+	}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal(req.Params, &list); err != nil {
+		return nil, err
+	}
+
+	args := make([]interface{}, len(list))
+	for i := range list {
+		args[i] = list[i]
+	}
+	return args, nil
+}
+
+// paramsKey provides a canonical form of the given request params used to match fixtures.
+func paramsKey(params json.RawMessage) string {
+	if len(params) == 0 {
+		return "null"
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, params); err != nil {
+		return string(params)
+	}
+	return buf.String()
+}
+
+// isSubscribe checks if the given method opens a subscription; it returns the namespace of the subscription.
+func isSubscribe(method string) (string, bool) {
+	if strings.HasSuffix(method, rpcSubscribeSuffix) {
+		return strings.TrimSuffix(method, rpcSubscribeSuffix), true
+	}
+	return "", false
+}
+
+// isUnsubscribe checks if the given method cancels a subscription.
+func isUnsubscribe(method string) bool {
+	return strings.HasSuffix(method, rpcUnsubscribeSuffix)
+}
@@ -0,0 +1,174 @@
+/*
+Package rpc implements bridge to Opera full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Opera node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Opera RPC interface for remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Opera RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Opera RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// fixtureFileExt represents the extension of the fixture files.
+// Each fixture file contains recorded calls of a single method, one JSON record per line.
+const fixtureFileExt = ".jsonl"
+
+// fixtureMaxLineSize represents the max size of a single fixture record.
+const fixtureMaxLineSize = 64 * 1024 * 1024
+
+// fixture represents a recorded JSON-RPC call, or a subscription notification.
+// The params of a subscription notification are the params of the subscription call.
+type fixture struct {
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *rpcError       `json:"error,omitempty"`
+}
+
+// fixtureWriter appends recorded fixtures into fixture files of the given directory.
+type fixtureWriter struct {
+	dir   string
+	mu    sync.Mutex
+	files map[string]*os.File
+}
+
+// newFixtureWriter creates a new fixture writer of the given directory.
+func newFixtureWriter(dir string) (*fixtureWriter, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("fixtures directory not configured")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &fixtureWriter{dir: dir, files: make(map[string]*os.File)}, nil
+}
+
+// write appends the given fixture to the file of the given method.
+func (fw *fixtureWriter) write(method string, fx *fixture) error {
+	data, err := json.Marshal(fx)
+	if err != nil {
+		return err
+	}
+
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	f, ok := fw.files[method]
+	if !ok {
+		f, err = os.OpenFile(fixturePath(fw.dir, method), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return err
+		}
+		fw.files[method] = f
+	}
+
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// close closes all the open fixture files.
+func (fw *fixtureWriter) close() error {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	var err error
+	for m, f := range fw.files {
+		if cErr := f.Close(); cErr != nil {
+			err = cErr
+		}
+		delete(fw.files, m)
+	}
+	return err
+}
+
+// fixtureQueue represents the recorded fixtures of a method with the same params in the order of recording.
+type fixtureQueue struct {
+	list []*fixture
+	next int
+}
+
+// pop provides the next fixture in the queue. The last fixture is repeated once the queue is exhausted.
+func (fq *fixtureQueue) pop() *fixture {
+	fx := fq.list[fq.next]
+	if fq.next < len(fq.list)-1 {
+		fq.next++
+	}
+	return fx
+}
+
+// loadFixtures loads all the fixtures of the given directory
+// into a map of method name to a map of canonical params to the fixtures queue.
+func loadFixtures(dir string) (map[string]map[string]*fixtureQueue, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("fixtures directory not configured")
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+fixtureFileExt))
+	if err != nil {
+		return nil, err
+	}
+
+	all := make(map[string]map[string]*fixtureQueue)
+	for _, path := range paths {
+		method := strings.TrimSuffix(filepath.Base(path), fixtureFileExt)
+		list, err := loadFixtureFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("can not load %s; %s", path, err.Error())
+		}
+
+		byParams := make(map[string]*fixtureQueue)
+		for _, fx := range list {
+			key := paramsKey(fx.Params)
+			if _, ok := byParams[key]; !ok {
+				byParams[key] = &fixtureQueue{list: make([]*fixture, 0)}
+			}
+			byParams[key].list = append(byParams[key].list, fx)
+		}
+		all[method] = byParams
+	}
+	return all, nil
+}
+
+// loadFixtureFile loads all the fixtures of the given file.
+func loadFixtureFile(path string) ([]*fixture, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	list := make([]*fixture, 0)
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), fixtureMaxLineSize)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+
+		var fx fixture
+		if err := json.Unmarshal([]byte(line), &fx); err != nil {
+			return nil, err
+		}
+		list = append(list, &fx)
+	}
+	return list, sc.Err()
+}
+
+// fixturePath provides the path of the fixture file of the given method.
+func fixturePath(dir string, method string) string {
+	return filepath.Join(dir, method+fixtureFileExt)
+}
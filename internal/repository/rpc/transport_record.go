@@ -0,0 +1,185 @@
+/*
+Package rpc implements bridge to Opera full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Opera node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Opera RPC interface for remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Opera RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Opera RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/logger"
+	"fmt"
+	ftm "github.com/ethereum/go-ethereum/rpc"
+	"sync"
+	"sync/atomic"
+)
+
+// recorderSubscriptionCapacity represents the capacity of a recorded subscription notifications channel.
+const recorderSubscriptionCapacity = 1000
+
+// recorder implements a transport handler passing requests to a live node
+// and recording the responses and subscription notifications into fixture files.
+type recorder struct {
+	upstream *ftm.Client
+	out      *fixtureWriter
+	log      logger.Logger
+	mu       sync.Mutex
+	subs     map[string]*ftm.ClientSubscription
+	subId    uint64
+}
+
+// newRecorder creates a new recording transport handler connected to the configured node.
+func newRecorder(cfg *config.OperaNode, log logger.Logger) (*recorder, error) {
+	out, err := newFixtureWriter(cfg.Fixtures)
+	if err != nil {
+		return nil, err
+	}
+
+	up, err := ftm.Dial(cfg.ApiNodeUrl)
+	if err != nil {
+		_ = out.close()
+		return nil, err
+	}
+
+	return &recorder{
+		upstream: up,
+		out:      out,
+		log:      log,
+		subs:     make(map[string]*ftm.ClientSubscription),
+	}, nil
+}
+
+// handle passes the given request to the live node and records the response.
+func (rec *recorder) handle(req *rpcMessage, send func(*rpcMessage)) {
+	if ns, ok := isSubscribe(req.Method); ok {
+		rec.subscribe(ns, req, send)
+		return
+	}
+	if isUnsubscribe(req.Method) {
+		send(rec.unsubscribe(req))
+		return
+	}
+
+	args, err := callArgs(req)
+	if err != nil {
+		send(response(req, nil, &rpcError{Code: rpcTransportErrorCode, Message: err.Error()}))
+		return
+	}
+
+	var res json.RawMessage
+	err = rec.upstream.CallContext(context.Background(), &res, req.Method, args...)
+
+	// transport errors are not recorded, the node did not respond
+	fx := fixture{Params: req.Params, Result: res, Error: nodeError(err)}
+	var ec ftm.Error
+	if err == nil || errors.As(err, &ec) {
+		if wErr := rec.out.write(req.Method, &fx); wErr != nil {
+			rec.log.Errorf("can not record %s; %s", req.Method, wErr.Error())
+		}
+	}
+	send(response(req, fx.Result, fx.Error))
+}
+
+// subscribe opens a subscription on the live node and records all the received notifications.
+func (rec *recorder) subscribe(ns string, req *rpcMessage, send func(*rpcMessage)) {
+	args, err := callArgs(req)
+	if err != nil {
+		send(response(req, nil, &rpcError{Code: rpcTransportErrorCode, Message: err.Error()}))
+		return
+	}
+
+	ch := make(chan json.RawMessage, recorderSubscriptionCapacity)
+	sub, err := rec.upstream.Subscribe(context.Background(), ns, ch, args...)
+	if err != nil {
+		send(response(req, nil, nodeError(err)))
+		return
+	}
+
+	id := fmt.Sprintf("0x%x", atomic.AddUint64(&rec.subId, 1))
+	rec.mu.Lock()
+	rec.subs[id] = sub
+	rec.mu.Unlock()
+
+	// respond with the subscription id, the notifications follow
+	sid, _ := json.Marshal(id)
+	send(response(req, sid, nil))
+
+	for {
+		select {
+		case err := <-sub.Err():
+			if err != nil {
+				rec.log.Errorf("recorded subscription %s failed; %s", ns, err.Error())
+			}
+			return
+		case data := <-ch:
+			if wErr := rec.out.write(ns+rpcNotificationSuffix, &fixture{Params: req.Params, Result: data}); wErr != nil {
+				rec.log.Errorf("can not record %s notification; %s", ns, wErr.Error())
+			}
+			if msg := notification(ns, id, data); msg != nil {
+				send(msg)
+			}
+		}
+	}
+}
+
+// unsubscribe cancels a recorded subscription.
+func (rec *recorder) unsubscribe(req *rpcMessage) *rpcMessage {
+	var ids []string
+	if err := json.Unmarshal(req.Params, &ids); err != nil || len(ids) == 0 {
+		return response(req, json.RawMessage("false"), nil)
+	}
+
+	rec.mu.Lock()
+	sub, ok := rec.subs[ids[0]]
+	delete(rec.subs, ids[0])
+	rec.mu.Unlock()
+
+	if !ok {
+		return response(req, json.RawMessage("false"), nil)
+	}
+	sub.Unsubscribe()
+	return response(req, json.RawMessage("true"), nil)
+}
+
+// close terminates the live node connection and closes the fixture files.
+func (rec *recorder) close() error {
+	rec.mu.Lock()
+	for id, sub := range rec.subs {
+		sub.Unsubscribe()
+		delete(rec.subs, id)
+	}
+	rec.mu.Unlock()
+
+	rec.upstream.Close()
+	return rec.out.close()
+}
+
+// nodeError converts the given call error into a JSON-RPC error response.
+func nodeError(err error) *rpcError {
+	if err == nil {
+		return nil
+	}
+
+	re := rpcError{Code: rpcTransportErrorCode, Message: err.Error()}
+	var ec ftm.Error
+	if errors.As(err, &ec) {
+		re.Code = ec.ErrorCode()
+	}
+
+	var de ftm.DataError
+	if errors.As(err, &de) {
+		re.Data = de.ErrorData()
+	}
+	return &re
+}
@@ -0,0 +1,113 @@
+/*
+Package rpc implements bridge to Opera full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Opera node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Opera RPC interface for remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Opera RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Opera RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"encoding/json"
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/logger"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// replayer implements a transport handler serving recorded fixtures instead of a live node.
+// Calls of the same method with the same params are responded in the order they were recorded,
+// the last recorded response is repeated once all of them were served. Recorded subscription
+// notifications are sent right after the subscription is opened, each of them only once.
+type replayer struct {
+	log      logger.Logger
+	mu       sync.Mutex
+	fixtures map[string]map[string]*fixtureQueue
+	sent     map[string]bool
+	subId    uint64
+}
+
+// newReplayer creates a new replaying transport handler with the configured fixtures.
+func newReplayer(cfg *config.OperaNode, log logger.Logger) (*replayer, error) {
+	fx, err := loadFixtures(cfg.Fixtures)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replayer{
+		log:      log,
+		fixtures: fx,
+		sent:     make(map[string]bool),
+	}, nil
+}
+
+// handle responds the given request from the recorded fixtures.
+func (rep *replayer) handle(req *rpcMessage, send func(*rpcMessage)) {
+	if ns, ok := isSubscribe(req.Method); ok {
+		rep.subscribe(ns, req, send)
+		return
+	}
+	if isUnsubscribe(req.Method) {
+		send(response(req, json.RawMessage("true"), nil))
+		return
+	}
+
+	fx := rep.next(req.Method, req.Params)
+	if fx == nil {
+		rep.log.Warningf("no fixture for %s %s", req.Method, paramsKey(req.Params))
+		send(response(req, nil, &rpcError{
+			Code:    rpcTransportErrorCode,
+			Message: fmt.Sprintf("no fixture for %s", req.Method),
+		}))
+		return
+	}
+	send(response(req, fx.Result, fx.Error))
+}
+
+// subscribe opens a replayed subscription and sends all the recorded notifications for it.
+func (rep *replayer) subscribe(ns string, req *rpcMessage, send func(*rpcMessage)) {
+	id := fmt.Sprintf("0x%x", atomic.AddUint64(&rep.subId, 1))
+	sid, _ := json.Marshal(id)
+	send(response(req, sid, nil))
+
+	// the notifications are replayed only once, re-subscriptions do not get them again
+	method, key := ns+rpcNotificationSuffix, paramsKey(req.Params)
+	rep.mu.Lock()
+	fq, ok := rep.fixtures[method][key]
+	if !ok || rep.sent[method+key] {
+		rep.mu.Unlock()
+		return
+	}
+	rep.sent[method+key] = true
+	rep.mu.Unlock()
+
+	for _, fx := range fq.list {
+		if msg := notification(ns, id, fx.Result); msg != nil {
+			send(msg)
+		}
+	}
+}
+
+// next provides the next recorded fixture of the given method and params; nil if not available.
+func (rep *replayer) next(method string, params json.RawMessage) *fixture {
+	rep.mu.Lock()
+	defer rep.mu.Unlock()
+
+	fq, ok := rep.fixtures[method][paramsKey(params)]
+	if !ok {
+		return nil
+	}
+	return fq.pop()
+}
+
+// close terminates the replayer.
+func (rep *replayer) close() error {
+	return nil
+}
@@ -0,0 +1,183 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/logger"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ftm "github.com/ethereum/go-ethereum/rpc"
+	"github.com/onsi/gomega"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testNodeService mocks a node API with a call and a subscription.
+type testNodeService struct{}
+
+func (s *testNodeService) Echo(v string) string {
+	return "echo:" + v
+}
+
+func (s *testNodeService) Fail() error {
+	return errors.New("failed on purpose")
+}
+
+func (s *testNodeService) Ticks(ctx context.Context, count int) (*ftm.Subscription, error) {
+	ntf, ok := ftm.NotifierFromContext(ctx)
+	if !ok {
+		return nil, ftm.ErrNotificationsUnsupported
+	}
+
+	sub := ntf.CreateSubscription()
+	go func() {
+		for i := 0; i < count; i++ {
+			_ = ntf.Notify(sub.ID, i)
+		}
+	}()
+	return sub, nil
+}
+
+// testEthService mocks the block height of a node.
+type testEthService struct {
+	height uint64
+}
+
+func (s *testEthService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.height)
+}
+
+// newTestNode starts a mock node of the given block height.
+func newTestNode(g *gomega.WithT, height uint64) (*ftm.Server, *httptest.Server) {
+	srv := ftm.NewServer()
+	g.Expect(srv.RegisterName("test", new(testNodeService))).To(gomega.Succeed())
+	g.Expect(srv.RegisterName("eth", &testEthService{height: height})).To(gomega.Succeed())
+	return srv, httptest.NewServer(srv.WebsocketHandler([]string{"*"}))
+}
+
+func TestTransportRecordReplay(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// mock-up a live node
+	_, node := newTestNode(g, 100)
+	defer node.Close()
+
+	log := logger.New(&config.Config{Log: config.Log{Level: "ERROR", Format: "%{message}"}})
+	cfg := config.OperaNode{
+		ApiNodeUrl: "ws" + strings.TrimPrefix(node.URL, "http"),
+		Transport:  transportRecord,
+		Fixtures:   t.TempDir(),
+	}
+
+	// record calls and a subscription
+	cli, tr, err := dialTransport(&cfg, log)
+	g.Expect(err).To(gomega.BeNil())
+	runTransportCalls(g, cli)
+	g.Expect(tr.Close()).To(gomega.Succeed())
+	cli.Close()
+
+	// replay them with the live node gone
+	node.Close()
+	cfg.Transport = transportReplay
+	cli, tr, err = dialTransport(&cfg, log)
+	g.Expect(err).To(gomega.BeNil())
+	runTransportCalls(g, cli)
+
+	// unknown calls are refused
+	var res string
+	g.Expect(cli.Call(&res, "test_echo", "unknown")).NotTo(gomega.Succeed())
+
+	g.Expect(tr.Close()).To(gomega.Succeed())
+	cli.Close()
+}
+
+func TestUpstreamPoolFailover(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// mock-up the pool nodes; the third node is lagging behind
+	srvA, nodeA := newTestNode(g, 100)
+	defer nodeA.Close()
+	_, nodeB := newTestNode(g, 95)
+	defer nodeB.Close()
+	_, nodeC := newTestNode(g, 50)
+	defer nodeC.Close()
+
+	log := logger.New(&config.Config{Log: config.Log{Level: "CRITICAL", Format: "%{message}"}})
+	cfg := config.OperaNode{
+		Transport: transportLive,
+		Upstreams: []config.Upstream{
+			{Name: "a", Url: "ws" + strings.TrimPrefix(nodeA.URL, "http"), Weight: 1000},
+			{Name: "b", Url: "ws" + strings.TrimPrefix(nodeB.URL, "http"), Weight: 1},
+			{Name: "c", Url: "ws" + strings.TrimPrefix(nodeC.URL, "http"), Weight: 1, Archive: true},
+		},
+		MaxLag:      10,
+		HealthCheck: time.Hour,
+		CallTimeout: 5 * time.Second,
+	}
+
+	cli, tr, err := dialTransport(&cfg, log)
+	g.Expect(err).To(gomega.BeNil())
+	defer func() {
+		g.Expect(tr.Close()).To(gomega.Succeed())
+		cli.Close()
+	}()
+
+	pool := tr.handler.(*upstreamPool)
+	st := pool.status()
+	g.Expect(st).To(gomega.HaveLen(3))
+	g.Expect(st[0].Healthy).To(gomega.BeTrue())
+	g.Expect(st[1].Healthy).To(gomega.BeTrue())
+	g.Expect(st[2].Healthy).To(gomega.BeFalse())
+	g.Expect(st[2].Lag).To(gomega.Equal(uint64(50)))
+
+	// the first node goes down, calls are served by the other healthy node
+	srvA.Stop()
+	for i := 0; i < 5; i++ {
+		var res string
+		g.Expect(cli.Call(&res, "test_echo", "x")).To(gomega.Succeed())
+		g.Expect(res).To(gomega.Equal("echo:x"))
+	}
+	g.Expect(pool.status()[0].Healthy).To(gomega.BeFalse())
+
+	// calls on an old state go to archive nodes
+	g.Expect(pool.isArchiveCall("eth_getBalance", []interface{}{json.RawMessage(`"0x0"`), json.RawMessage(`"0x1"`)})).To(gomega.BeTrue())
+	g.Expect(pool.isArchiveCall("eth_getBalance", []interface{}{json.RawMessage(`"0x0"`), json.RawMessage(`"0x60"`)})).To(gomega.BeFalse())
+	g.Expect(pool.isArchiveCall("eth_getBalance", []interface{}{json.RawMessage(`"0x0"`), json.RawMessage(`"latest"`)})).To(gomega.BeFalse())
+	g.Expect(pool.isArchiveCall("trace_block", []interface{}{json.RawMessage(`"0x60"`)})).To(gomega.BeTrue())
+}
+
+// runTransportCalls executes the calls and the subscription of the mock node on the given client.
+func runTransportCalls(g *gomega.WithT, cli *ftm.Client) {
+	var res string
+	g.Expect(cli.Call(&res, "test_echo", "hello")).To(gomega.Succeed())
+	g.Expect(res).To(gomega.Equal("echo:hello"))
+
+	err := cli.Call(&res, "test_fail")
+	g.Expect(err).NotTo(gomega.BeNil())
+	g.Expect(err.Error()).To(gomega.Equal("failed on purpose"))
+
+	batch := []ftm.BatchElem{
+		{Method: "test_echo", Args: []interface{}{"a"}, Result: new(string)},
+		{Method: "test_echo", Args: []interface{}{"b"}, Result: new(string)},
+	}
+	g.Expect(cli.BatchCall(batch)).To(gomega.Succeed())
+	g.Expect(*batch[0].Result.(*string)).To(gomega.Equal("echo:a"))
+	g.Expect(*batch[1].Result.(*string)).To(gomega.Equal("echo:b"))
+
+	ch := make(chan int, 10)
+	sub, err := cli.Subscribe(context.Background(), "test", ch, "ticks", 3)
+	g.Expect(err).To(gomega.BeNil())
+	defer sub.Unsubscribe()
+
+	for i := 0; i < 3; i++ {
+		select {
+		case v := <-ch:
+			g.Expect(v).To(gomega.Equal(i))
+		case <-time.After(5 * time.Second):
+			g.Expect(i).To(gomega.Equal(3), "notification not received")
+		}
+	}
+}
@@ -0,0 +1,579 @@
+/*
+Package rpc implements bridge to Opera full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Opera node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Opera RPC interface for remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Opera RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Opera RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/logger"
+	"fantom-api-graphql/internal/metrics"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ftm "github.com/ethereum/go-ethereum/rpc"
+	"math/rand"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// upstreamRecentStateDepth represents the number of blocks below the pool head
+// with the state available on any node; calls on older state are routed to archive nodes.
+const upstreamRecentStateDepth = 64
+
+// upstreamSubscriptionCapacity represents the capacity of a pool subscription notifications channel.
+const upstreamSubscriptionCapacity = 1000
+
+// upstreamStateMethods represents the methods working with a state of a block
+// mapped to the position of the block parameter.
+var upstreamStateMethods = map[string]int{
+	"eth_call":                1,
+	"eth_estimateGas":         1,
+	"eth_getBalance":          1,
+	"eth_getCode":             1,
+	"eth_getStorageAt":        2,
+	"eth_getTransactionCount": 1,
+	"eth_getProof":            2,
+}
+
+// upstreamNode represents a node of the upstream pool.
+type upstreamNode struct {
+	name    string
+	url     string
+	weight  int
+	archive bool
+
+	mu       sync.Mutex
+	cli      *ftm.Client
+	height   uint64
+	lag      uint64
+	healthy  bool
+	checked  time.Time
+	lastErr  string
+	failures uint64
+}
+
+// upstreamPool implements a transport handler routing requests to a pool of upstream nodes.
+// Nodes are picked by weight from the healthy ones, calls failed on a node are repeated
+// on another node and subscriptions are re-opened on another node if their node fails.
+type upstreamPool struct {
+	log      logger.Logger
+	nodes    []*upstreamNode
+	maxLag   uint64
+	tick     time.Duration
+	timeout  time.Duration
+	head     uint64
+	sigClose chan struct{}
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	subs     map[string]*poolSubscription
+	subId    uint64
+}
+
+// poolSubscription represents a subscription of the client kept open on the upstream pool.
+type poolSubscription struct {
+	id      string
+	ns      string
+	req     *rpcMessage
+	args    []interface{}
+	send    func(*rpcMessage)
+	sigStop chan struct{}
+}
+
+// newUpstreamPool creates a new upstream pool handler for the configured nodes.
+func newUpstreamPool(cfg *config.OperaNode, log logger.Logger) (*upstreamPool, error) {
+	pool := upstreamPool{
+		log:      log,
+		nodes:    make([]*upstreamNode, 0, len(cfg.Upstreams)),
+		maxLag:   uint64(cfg.MaxLag),
+		tick:     cfg.HealthCheck,
+		timeout:  cfg.CallTimeout,
+		sigClose: make(chan struct{}),
+		subs:     make(map[string]*poolSubscription),
+	}
+
+	for _, up := range cfg.Upstreams {
+		if up.Url == "" {
+			return nil, fmt.Errorf("upstream node url missing")
+		}
+
+		un := upstreamNode{
+			name:    up.Name,
+			url:     up.Url,
+			weight:  up.Weight,
+			archive: up.Archive,
+		}
+		if un.name == "" {
+			un.name = upstreamName(up.Url)
+		}
+		if un.weight <= 0 {
+			un.weight = 1
+		}
+		pool.nodes = append(pool.nodes, &un)
+	}
+
+	// check the nodes so we know where to go
+	pool.check()
+	if len(pool.candidates(false)) == 0 {
+		log.Errorf("no healthy upstream node available")
+	}
+
+	pool.wg.Add(1)
+	go pool.observe()
+	return &pool, nil
+}
+
+// handle routes the given request to the upstream pool.
+func (pool *upstreamPool) handle(req *rpcMessage, send func(*rpcMessage)) {
+	if ns, ok := isSubscribe(req.Method); ok {
+		pool.subscribe(ns, req, send)
+		return
+	}
+	if isUnsubscribe(req.Method) {
+		send(pool.unsubscribe(req))
+		return
+	}
+
+	args, err := callArgs(req)
+	if err != nil {
+		send(response(req, nil, &rpcError{Code: rpcTransportErrorCode, Message: err.Error()}))
+		return
+	}
+
+	// try the candidate nodes until one of them responds
+	err = fmt.Errorf("no upstream node available")
+	for _, un := range pool.candidates(pool.isArchiveCall(req.Method, args)) {
+		var res json.RawMessage
+		if err = pool.call(un, &res, req.Method, args...); err == nil {
+			send(response(req, res, nil))
+			return
+		}
+
+		// the node responded with an error; no need to try another one
+		var ec ftm.Error
+		if errors.As(err, &ec) {
+			break
+		}
+		metrics.ObserveRpcFailover(un.name)
+	}
+	send(response(req, nil, nodeError(err)))
+}
+
+// call executes the given call on the given node. The node is marked unhealthy
+// if the call fails without the node responding.
+func (pool *upstreamPool) call(un *upstreamNode, res interface{}, method string, args ...interface{}) error {
+	cli := un.client()
+	if cli == nil {
+		return fmt.Errorf("upstream %s not connected", un.name)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pool.timeout)
+	defer cancel()
+
+	err := cli.CallContext(ctx, res, method, args...)
+	if err == nil {
+		return nil
+	}
+
+	var ec ftm.Error
+	if !errors.As(err, &ec) {
+		pool.log.Errorf("upstream %s failed on %s; %s", un.name, method, err.Error())
+		un.fail(err)
+	}
+	return err
+}
+
+// subscribe opens a subscription on a healthy upstream node and keeps it open until cancelled.
+func (pool *upstreamPool) subscribe(ns string, req *rpcMessage, send func(*rpcMessage)) {
+	args, err := callArgs(req)
+	if err != nil {
+		send(response(req, nil, &rpcError{Code: rpcTransportErrorCode, Message: err.Error()}))
+		return
+	}
+
+	ps := poolSubscription{
+		id:      fmt.Sprintf("0x%x", atomic.AddUint64(&pool.subId, 1)),
+		ns:      ns,
+		req:     req,
+		args:    args,
+		send:    send,
+		sigStop: make(chan struct{}),
+	}
+
+	ch := make(chan json.RawMessage, upstreamSubscriptionCapacity)
+	sub, un, err := pool.open(&ps, ch)
+	if err != nil {
+		send(response(req, nil, nodeError(err)))
+		return
+	}
+
+	pool.mu.Lock()
+	pool.subs[ps.id] = &ps
+	pool.mu.Unlock()
+
+	// respond with the subscription id, the notifications follow
+	sid, _ := json.Marshal(ps.id)
+	send(response(req, sid, nil))
+	pool.keep(&ps, ch, sub, un)
+}
+
+// open opens the given subscription on the first candidate node accepting it.
+func (pool *upstreamPool) open(ps *poolSubscription, ch chan json.RawMessage) (*ftm.ClientSubscription, *upstreamNode, error) {
+	err := fmt.Errorf("no upstream node available")
+	for _, un := range pool.candidates(false) {
+		cli := un.client()
+		if cli == nil {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), pool.timeout)
+		var sub *ftm.ClientSubscription
+		sub, err = cli.Subscribe(ctx, ps.ns, ch, ps.args...)
+		cancel()
+		if err == nil {
+			pool.log.Noticef("%s subscription open on upstream %s", ps.ns, un.name)
+			return sub, un, nil
+		}
+
+		pool.log.Errorf("upstream %s can not open %s subscription; %s", un.name, ps.ns, err.Error())
+		metrics.ObserveRpcFailover(un.name)
+	}
+	return nil, nil, err
+}
+
+// keep forwards notifications of the given subscription to the client. The subscription
+// is re-opened on another node if it fails, or if its node becomes unhealthy.
+func (pool *upstreamPool) keep(ps *poolSubscription, ch chan json.RawMessage, sub *ftm.ClientSubscription, un *upstreamNode) {
+	tick := time.NewTicker(pool.tick)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-ps.sigStop:
+			sub.Unsubscribe()
+			return
+		case <-pool.sigClose:
+			sub.Unsubscribe()
+			return
+		case data := <-ch:
+			if msg := notification(ps.ns, ps.id, data); msg != nil {
+				ps.send(msg)
+			}
+			continue
+		case err := <-sub.Err():
+			if err != nil {
+				pool.log.Errorf("%s subscription on upstream %s failed; %s", ps.ns, un.name, err.Error())
+				un.fail(err)
+			}
+		case <-tick.C:
+			if un.isHealthy() {
+				continue
+			}
+			pool.log.Warningf("upstream %s not healthy, moving %s subscription", un.name, ps.ns)
+			sub.Unsubscribe()
+		}
+
+		// re-open the subscription elsewhere
+		metrics.ObserveRpcFailover(un.name)
+		for {
+			var err error
+			sub, un, err = pool.open(ps, ch)
+			if err == nil {
+				break
+			}
+
+			select {
+			case <-ps.sigStop:
+				return
+			case <-pool.sigClose:
+				return
+			case <-tick.C:
+			}
+		}
+	}
+}
+
+// unsubscribe cancels a pool subscription.
+func (pool *upstreamPool) unsubscribe(req *rpcMessage) *rpcMessage {
+	var ids []string
+	if err := json.Unmarshal(req.Params, &ids); err != nil || len(ids) == 0 {
+		return response(req, json.RawMessage("false"), nil)
+	}
+
+	pool.mu.Lock()
+	ps, ok := pool.subs[ids[0]]
+	delete(pool.subs, ids[0])
+	pool.mu.Unlock()
+
+	if !ok {
+		return response(req, json.RawMessage("false"), nil)
+	}
+	close(ps.sigStop)
+	return response(req, json.RawMessage("true"), nil)
+}
+
+// candidates provides the healthy nodes able to serve a call in the order they should be tried.
+// The nodes of the preferred kind go first, ordered randomly by their weight.
+func (pool *upstreamPool) candidates(archive bool) []*upstreamNode {
+	primary := make([]*upstreamNode, 0, len(pool.nodes))
+	secondary := make([]*upstreamNode, 0, len(pool.nodes))
+
+	for _, un := range pool.nodes {
+		if !un.isHealthy() {
+			continue
+		}
+		if un.archive == archive {
+			primary = append(primary, un)
+		} else {
+			secondary = append(secondary, un)
+		}
+	}
+	return append(weightedShuffle(primary), weightedShuffle(secondary)...)
+}
+
+// isArchiveCall checks if the given call works with a historical state of the chain.
+func (pool *upstreamPool) isArchiveCall(method string, args []interface{}) bool {
+	if strings.HasPrefix(method, "trace_") || strings.HasPrefix(method, "debug_trace") {
+		return true
+	}
+
+	pos, ok := upstreamStateMethods[method]
+	if !ok || len(args) <= pos {
+		return false
+	}
+
+	var blk string
+	if err := json.Unmarshal(args[pos].(json.RawMessage), &blk); err != nil {
+		// block hash reference
+		return true
+	}
+
+	switch blk {
+	case "latest", "pending", "safe", "finalized":
+		return false
+	case "earliest":
+		return true
+	}
+
+	num, err := hexutil.DecodeUint64(blk)
+	if err != nil {
+		return false
+	}
+	return num+upstreamRecentStateDepth < atomic.LoadUint64(&pool.head)
+}
+
+// observe runs the health check of the upstream nodes periodically.
+func (pool *upstreamPool) observe() {
+	tick := time.NewTicker(pool.tick)
+	defer func() {
+		tick.Stop()
+		pool.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-pool.sigClose:
+			return
+		case <-tick.C:
+			pool.check()
+		}
+	}
+}
+
+// check updates the health of all the upstream nodes. A node is healthy if it responds
+// and its block height is not lagging behind the highest block of the pool too much.
+func (pool *upstreamPool) check() {
+	var wg sync.WaitGroup
+	for _, un := range pool.nodes {
+		wg.Add(1)
+		go func(un *upstreamNode) {
+			defer wg.Done()
+			pool.checkHeight(un)
+		}(un)
+	}
+	wg.Wait()
+
+	var head uint64
+	for _, un := range pool.nodes {
+		if h := un.currentHeight(); h > head {
+			head = h
+		}
+	}
+	atomic.StoreUint64(&pool.head, head)
+
+	for _, un := range pool.nodes {
+		was := un.isHealthy()
+		is := un.evaluate(head, pool.maxLag)
+		if was != is {
+			pool.log.Noticef("upstream %s healthy: %t, height %d, pool head %d", un.name, is, un.currentHeight(), head)
+		}
+
+		st := un.status()
+		metrics.ObserveRpcUpstream(un.name, st.Height, st.Lag, st.Healthy)
+	}
+}
+
+// checkHeight loads the current block height of the given node, connecting it if needed.
+func (pool *upstreamPool) checkHeight(un *upstreamNode) {
+	if un.client() == nil {
+		if err := un.connect(); err != nil {
+			un.fail(err)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pool.tick)
+	defer cancel()
+
+	var h hexutil.Uint64
+	if err := un.client().CallContext(ctx, &h, "eth_blockNumber"); err != nil {
+		un.fail(err)
+		return
+	}
+	un.reached(uint64(h))
+}
+
+// status provides the status of all the upstream nodes.
+func (pool *upstreamPool) status() []types.UpstreamStatus {
+	list := make([]types.UpstreamStatus, len(pool.nodes))
+	for i, un := range pool.nodes {
+		list[i] = un.status()
+	}
+	return list
+}
+
+// close terminates the pool and disconnects all the upstream nodes.
+func (pool *upstreamPool) close() error {
+	close(pool.sigClose)
+	pool.wg.Wait()
+
+	for _, un := range pool.nodes {
+		if cli := un.client(); cli != nil {
+			cli.Close()
+		}
+	}
+	return nil
+}
+
+// connect opens the connection of the upstream node.
+func (un *upstreamNode) connect() error {
+	cli, err := ftm.Dial(un.url)
+	if err != nil {
+		return err
+	}
+
+	un.mu.Lock()
+	un.cli = cli
+	un.mu.Unlock()
+	return nil
+}
+
+// client provides the client of the node; nil if not connected.
+func (un *upstreamNode) client() *ftm.Client {
+	un.mu.Lock()
+	defer un.mu.Unlock()
+	return un.cli
+}
+
+// fail marks the node as not healthy until the next health check.
+func (un *upstreamNode) fail(err error) {
+	un.mu.Lock()
+	defer un.mu.Unlock()
+
+	un.healthy = false
+	un.lastErr = err.Error()
+	un.failures++
+	un.checked = time.Now()
+}
+
+// reached updates the block height of the node.
+func (un *upstreamNode) reached(height uint64) {
+	un.mu.Lock()
+	defer un.mu.Unlock()
+
+	un.height = height
+	un.lastErr = ""
+	un.checked = time.Now()
+}
+
+// evaluate decides the health of the node against the given pool head.
+func (un *upstreamNode) evaluate(head uint64, maxLag uint64) bool {
+	un.mu.Lock()
+	defer un.mu.Unlock()
+
+	un.lag = head - un.height
+	un.healthy = un.lastErr == "" && un.lag <= maxLag
+	return un.healthy
+}
+
+// isHealthy checks if the node can be used.
+func (un *upstreamNode) isHealthy() bool {
+	un.mu.Lock()
+	defer un.mu.Unlock()
+	return un.healthy
+}
+
+// currentHeight provides the last known block height of the node.
+func (un *upstreamNode) currentHeight() uint64 {
+	un.mu.Lock()
+	defer un.mu.Unlock()
+	return un.height
+}
+
+// status provides the status of the node.
+func (un *upstreamNode) status() types.UpstreamStatus {
+	un.mu.Lock()
+	defer un.mu.Unlock()
+
+	return types.UpstreamStatus{
+		Name:     un.name,
+		Weight:   un.weight,
+		Archive:  un.archive,
+		Healthy:  un.healthy,
+		Height:   un.height,
+		Lag:      un.lag,
+		Failures: un.failures,
+		Checked:  un.checked,
+		Error:    un.lastErr,
+	}
+}
+
+// weightedShuffle orders the given nodes randomly with the nodes of higher weight more likely going first.
+func weightedShuffle(list []*upstreamNode) []*upstreamNode {
+	keys := make(map[*upstreamNode]float64, len(list))
+	for _, un := range list {
+		// exponential race; the lowest key wins
+		keys[un] = rand.ExpFloat64() / float64(un.weight)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return keys[list[i]] < keys[list[j]]
+	})
+	return list
+}
+
+// upstreamName provides a name of the upstream node of the given url safe to be exposed;
+// only the host is used since the credentials may be included in the url.
+func upstreamName(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+	return u.Host
+}
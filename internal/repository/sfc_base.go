@@ -181,12 +181,12 @@ func (p *proxy) Epochs(cursor *string, count int32) (*types.EpochList, error) {
 	return p.db.Epochs(cursor, count)
 }
 
-// FtmTreasuryTotal provides the total amount of native FTM sent into treasury.
-func (p *proxy) FtmTreasuryTotal() (int64, error) {
+// FtmTreasuryTotal provides the total amount of native FTM in WEI sent into treasury.
+func (p *proxy) FtmTreasuryTotal() (*big.Int, error) {
 	// pull the database value
 	val, err := p.db.TreasuryTotal()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	// add correction for the sponsored amount
@@ -119,9 +119,10 @@ func (p *proxy) DelegationAmountStaked(addr *common.Address, valID *hexutil.Big)
 }
 
 // DelegationsByAddress returns a list of all delegations of a given delegator address.
-func (p *proxy) DelegationsByAddress(addr *common.Address, cursor *string, count int32) (*types.DelegationList, error) {
+// The default order is used if the sort is not given.
+func (p *proxy) DelegationsByAddress(addr *common.Address, cursor *string, count int32, sort *types.ListSort) (*types.DelegationList, error) {
 	p.log.Debugf("loading delegations of %s", addr.String())
-	return p.db.Delegations(cursor, count, &bson.D{{Key: types.FiDelegationAddress, Value: addr.String()}})
+	return p.db.Delegations(cursor, count, &bson.D{{Key: types.FiDelegationAddress, Value: addr.String()}}, sort)
 }
 
 // DelegationsByAddressAll returns a list of all delegations of the given address un-paged.
@@ -131,9 +132,10 @@ func (p *proxy) DelegationsByAddressAll(addr *common.Address) ([]*types.Delegati
 }
 
 // DelegationsOfValidator extract a list of delegations for a given validator.
-func (p *proxy) DelegationsOfValidator(valID *hexutil.Big, cursor *string, count int32) (*types.DelegationList, error) {
+// The default order is used if the sort is not given.
+func (p *proxy) DelegationsOfValidator(valID *hexutil.Big, cursor *string, count int32, sort *types.ListSort) (*types.DelegationList, error) {
 	p.log.Debugf("loading delegations of #%d", valID.ToInt().Uint64())
-	return p.db.Delegations(cursor, count, &bson.D{{Key: types.FiDelegationToValidator, Value: valID.String()}})
+	return p.db.Delegations(cursor, count, &bson.D{{Key: types.FiDelegationToValidator, Value: valID.String()}}, sort)
 }
 
 // DelegationLock returns delegation lock information using SFC contract binding.
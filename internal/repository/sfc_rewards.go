@@ -23,7 +23,8 @@ func (p *proxy) StoreRewardClaim(rc *types.RewardClaim) error {
 }
 
 // RewardClaims provides a list of reward claims for the given delegation and/or filter.
-func (p *proxy) RewardClaims(adr *common.Address, valID *big.Int, cursor *string, count int32) (*types.RewardClaimsList, error) {
+// The default order is used if the sort is not given.
+func (p *proxy) RewardClaims(adr *common.Address, valID *big.Int, cursor *string, count int32, sort *types.ListSort) (*types.RewardClaimsList, error) {
 	// prep the filter
 	fi := bson.D{}
 
@@ -42,7 +43,7 @@ func (p *proxy) RewardClaims(adr *common.Address, valID *big.Int, cursor *string
 			Value: (*hexutil.Big)(valID).String(),
 		})
 	}
-	return p.db.RewardClaims(cursor, count, &fi)
+	return p.db.RewardClaims(cursor, count, &fi, sort)
 }
 
 // RewardsClaimed returns sum of all claimed rewards for the given delegator address and validator ID.
@@ -0,0 +1,80 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/repository/db"
+	"fmt"
+	"os"
+)
+
+// ExportSnapshot writes a snapshot archive of the indexed database into the file of the given path.
+// The snapshot is written into a temporary file first, the target file is replaced only on success.
+// Only the persistent storage is connected, the repository does not need to be running.
+func ExportSnapshot(path string) (*db.SnapshotManifest, error) {
+	dbBridge, err := commandDb()
+	if err != nil {
+		return nil, err
+	}
+	defer dbBridge.Close()
+
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	man, err := dbBridge.ExportSnapshot(f)
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return nil, err
+	}
+	return man, os.Rename(tmp, path)
+}
+
+// ImportSnapshot restores the snapshot archive of the given path into an empty database.
+// Only the persistent storage is connected, the repository does not need to be running.
+func ImportSnapshot(path string) (*db.SnapshotManifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Errorf("can not close snapshot file; %s", err.Error())
+		}
+	}()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	dbBridge, err := commandDb()
+	if err != nil {
+		return nil, err
+	}
+	defer dbBridge.Close()
+
+	return dbBridge.ImportSnapshot(f, fi.Size())
+}
+
+// commandDb connects the persistent storage for the commands executed instead of the server.
+func commandDb() (*db.MongoDbBridge, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("missing configuration")
+	}
+	if log == nil {
+		return nil, fmt.Errorf("missing logger")
+	}
+	return db.New(cfg, log)
+}
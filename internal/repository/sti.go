@@ -36,6 +36,12 @@ func (p *proxy) RetrieveStakerInfo(id *hexutil.Big) *types.StakerInfo {
 	return p.cache.PullStakerInfo(id)
 }
 
+// RetrieveStakerInfos gets information of the given stakers from in-memory if available.
+// The result is keyed by the staker id; stakers without information are not included.
+func (p *proxy) RetrieveStakerInfos(ids []hexutil.Big) map[string]*types.StakerInfo {
+	return p.cache.PullStakerInfos(ids)
+}
+
 // IsStiContract returns true if the given address points to the STI contract.
 func (p *proxy) IsStiContract(addr *common.Address) bool {
 	return bytes.Equal(addr.Bytes(), p.cfg.Staking.StiContract.Bytes())
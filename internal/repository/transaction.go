@@ -68,6 +68,12 @@ func (p *proxy) LoadTransaction(hash *common.Hash) (*types.Transaction, error) {
 	return p.rpc.Transaction(hash)
 }
 
+// ObservedPendingTransactions provides a channel fed with hashes of new pending transactions
+// observed by the connected blockchain node.
+func (p *proxy) ObservedPendingTransactions() chan common.Hash {
+	return p.rpc.ObservedPendingProxy()
+}
+
 // SendTransaction sends raw signed and RLP encoded transaction to the block chain.
 func (p *proxy) SendTransaction(tx hexutil.Bytes) (*types.Transaction, error) {
 	p.log.Debugf("announcing trx %s", tx.String())
@@ -140,6 +146,12 @@ func (p *proxy) Transactions(cursor *string, count int32) (*types.TransactionLis
 	return p.db.Transactions(cursor, count, nil)
 }
 
+// TransactionsFiltered pulls list of transactions matching the given filter starting on the specified cursor.
+// The list is always sorted from newer to older, the same way the full list is.
+func (p *proxy) TransactionsFiltered(tf *types.TransactionFilter, cursor *string, count int32) (*types.TransactionList, error) {
+	return p.db.TransactionsFiltered(tf, cursor, count)
+}
+
 // StoreGasPricePeriod stores the given gas price period data in the persistent storage
 func (p *proxy) StoreGasPricePeriod(gp *types.GasPricePeriod) error {
 	return p.db.AddGasPricePeriod(gp)
@@ -0,0 +1,103 @@
+// Package solidity implements Solidity processor used to analyze
+// and verify Solidity based contracts.
+package solidity
+
+import (
+	"bytes"
+)
+
+// metadataKeys represents the keys of the CBOR map the compiler encodes the contract metadata into.
+var metadataKeys = []string{"ipfs", "bzzr0", "bzzr1", "solc", "experimental"}
+
+// pushAddressOpCode represents the PUSH20 op code used by libraries to guard against direct calls.
+const pushAddressOpCode = 0x73
+
+// Matches checks if the runtime byte code of the compiled contract matches the given deployed byte code.
+// The metadata hashes appended by the compiler to the contract and to the creation code of contracts
+// it deploys, immutable values and linked library addresses are not compared
+// since they are not defined by the code itself.
+func (c *Contract) Matches(deployed []byte) bool {
+	if len(c.Code) == 0 || len(c.Code) != len(deployed) {
+		return false
+	}
+
+	// mask the ranges filled on deployment and linking
+	code := make([]byte, len(deployed))
+	copy(code, deployed)
+	for _, list := range [][]CodeRange{c.Immutables, c.Libraries} {
+		for _, r := range list {
+			if !maskRange(code, r) {
+				return false
+			}
+		}
+	}
+
+	// libraries start with their own address pushed into the stack
+	if isLibraryCode(c.Code) {
+		maskRange(code, CodeRange{Start: 1, Length: 20})
+	}
+
+	// mask the metadata segments on both sides
+	compiled := make([]byte, len(c.Code))
+	copy(compiled, c.Code)
+	for _, r := range MetadataRanges(c.Code) {
+		maskRange(compiled, r)
+		maskRange(code, r)
+	}
+	return bytes.Equal(compiled, code)
+}
+
+// MetadataRanges locates the CBOR encoded metadata segments in the given byte code.
+// The compiler appends a segment to the runtime code of each contract, so the code of a contract
+// deploying other contracts carries the segments of their creation code, too.
+// Each segment is a CBOR map of 1 to 5 items keyed by well known names,
+// followed by two bytes of the map length.
+func MetadataRanges(code []byte) []CodeRange {
+	list := make([]CodeRange, 0)
+	for i := 0; i+1 < len(code); i++ {
+		size := int(code[i])<<8 | int(code[i+1])
+		start := i - size
+		if size < 2 || start < 0 {
+			continue
+		}
+		if code[start] < 0xa1 || code[start] > 0xa5 || !isMetadataKey(code[start+1:i]) {
+			continue
+		}
+		list = append(list, CodeRange{Start: start, Length: size + 2})
+	}
+	return list
+}
+
+// isMetadataKey checks if the given CBOR data start with a text key of a metadata map.
+func isMetadataKey(data []byte) bool {
+	for _, key := range metadataKeys {
+		if len(data) > len(key) && int(data[0]) == 0x60+len(key) && string(data[1:len(key)+1]) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// isLibraryCode checks if the given runtime byte code is the code of a library.
+func isLibraryCode(code []byte) bool {
+	if len(code) < 21 || code[0] != pushAddressOpCode {
+		return false
+	}
+	for _, b := range code[1:21] {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// maskRange zeroes the given range of the byte code.
+func maskRange(code []byte, r CodeRange) bool {
+	if r.Start < 0 || r.Length < 0 || r.Start+r.Length > len(code) {
+		return false
+	}
+	for i := r.Start; i < r.Start+r.Length; i++ {
+		code[i] = 0
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package solidity
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
+	"strings"
+	"testing"
+)
+
+func TestMetadataRanges(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// runtime code followed by the ipfs hash and solc version metadata
+	code := hexutil.MustDecode("0x6080604052600080fd" +
+		"a2646970667358221220" + "1111111111111111111111111111111111111111111111111111111111111111" +
+		"64736f6c63430007050033")
+	g.Expect(MetadataRanges(code)).To(gomega.Equal([]CodeRange{{Start: 9, Length: 53}}))
+
+	// the old swarm hash metadata
+	code = hexutil.MustDecode("0x6080604052600080fd" +
+		"a165627a7a72305820" + "1111111111111111111111111111111111111111111111111111111111111111" +
+		"0029")
+	g.Expect(MetadataRanges(code)).To(gomega.Equal([]CodeRange{{Start: 9, Length: 43}}))
+
+	// code without metadata
+	code = hexutil.MustDecode("0x6080604052600080fd")
+	g.Expect(MetadataRanges(code)).To(gomega.BeEmpty())
+}
+
+func TestContractMatches(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	meta := func(h string) string {
+		return "a2646970667358221220" + h + "64736f6c63430007050033"
+	}
+	sc := Contract{
+		Code:       hexutil.MustDecode("0x7f" + "0000000000000000000000000000000000000000000000000000000000000000" + "600080fd" + meta("1111111111111111111111111111111111111111111111111111111111111111")),
+		Immutables: []CodeRange{{Start: 1, Length: 32}},
+	}
+
+	// immutable value and metadata hash differ
+	deployed := hexutil.MustDecode("0x7f" + "00000000000000000000000000000000000000000000000000000000000000fa" + "600080fd" + meta("2222222222222222222222222222222222222222222222222222222222222222"))
+	g.Expect(sc.Matches(deployed)).To(gomega.BeTrue())
+
+	// the code itself differs
+	deployed = hexutil.MustDecode("0x7f" + "00000000000000000000000000000000000000000000000000000000000000fa" + "600180fd" + meta("2222222222222222222222222222222222222222222222222222222222222222"))
+	g.Expect(sc.Matches(deployed)).To(gomega.BeFalse())
+
+	// the immutable is not masked without the reference
+	sc.Immutables = nil
+	deployed = hexutil.MustDecode("0x7f" + "00000000000000000000000000000000000000000000000000000000000000fa" + "600080fd" + meta("2222222222222222222222222222222222222222222222222222222222222222"))
+	g.Expect(sc.Matches(deployed)).To(gomega.BeFalse())
+}
+
+func TestContractMatchesEmbedded(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	meta := func(h string) string {
+		return "a2646970667358221220" + strings.Repeat(h, 64) + "64736f6c63430007050033"
+	}
+	factory := func(child string, parent string) []byte {
+		// the runtime code of the factory embeds the creation code of the child contract
+		return hexutil.MustDecode("0x6080604052600080fd" +
+			"6080604052348015600f57600080fd5b50603f80601d6000396000f3fe" + "6080604052600080fd" + meta(child) +
+			"5b600080fd" + meta(parent))
+	}
+
+	sc := Contract{Code: factory("1", "2")}
+	g.Expect(sc.Matches(factory("3", "4"))).To(gomega.BeTrue())
+	g.Expect(sc.Matches(factory("1", "2"))).To(gomega.BeTrue())
+
+	// the code around the segments is still compared
+	deployed := factory("3", "4")
+	deployed[0] = 0x60
+	deployed[1] = 0x81
+	g.Expect(sc.Matches(deployed)).To(gomega.BeFalse())
+}
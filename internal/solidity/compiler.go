@@ -3,3 +3,200 @@
 package solidity
 
 //go:generate sh ./tools/compile_releases.sh "../../../solidity"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// compilerTimeout represents the max time a single compiler run can take.
+const compilerTimeout = 2 * time.Minute
+
+// sourceFileName represents the name of the source unit the submitted source code is compiled as.
+const sourceFileName = "contract.sol"
+
+// versionRegexp represents a regular expression extracting the version of a compiler from its version info.
+var versionRegexp = regexp.MustCompile(`Version:\s*v?(\d+\.\d+\.\d+[^\s]*?\+commit\.[0-9a-f]+)`)
+
+// placeholderRegexp represents a regular expression matching library address placeholders of a hex encoded byte code.
+var placeholderRegexp = regexp.MustCompile(`__.{36}__`)
+
+// releaseRegexp represents a regular expression extracting the release number from a compiler version string.
+var releaseRegexp = regexp.MustCompile(`v?(\d+\.\d+\.\d+)`)
+
+// CodeRange represents a range of bytes inside a contract byte code.
+type CodeRange struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// Contract represents a single contract compiled from a Solidity source code.
+type Contract struct {
+	// Name is the name of the contract in the source code.
+	Name string
+
+	// Abi is the JSON encoded ABI definition of the contract.
+	Abi string
+
+	// Code is the runtime byte code of the contract with library addresses left zeroed.
+	Code []byte
+
+	// Immutables are the ranges of the runtime byte code filled by immutable values on deployment.
+	Immutables []CodeRange
+
+	// Libraries are the ranges of the runtime byte code filled by linked library addresses.
+	Libraries []CodeRange
+}
+
+// compilerOutput represents the relevant part of the standard JSON output of the compiler.
+type compilerOutput struct {
+	Errors []struct {
+		Severity         string `json:"severity"`
+		FormattedMessage string `json:"formattedMessage"`
+		Message          string `json:"message"`
+	} `json:"errors"`
+	Contracts map[string]map[string]struct {
+		Abi json.RawMessage `json:"abi"`
+		Evm struct {
+			DeployedBytecode struct {
+				Object              string                            `json:"object"`
+				ImmutableReferences map[string][]CodeRange            `json:"immutableReferences"`
+				LinkReferences      map[string]map[string][]CodeRange `json:"linkReferences"`
+			} `json:"deployedBytecode"`
+		} `json:"evm"`
+	} `json:"contracts"`
+}
+
+// Release extracts the release number, i.e. v0.7.5, from the given compiler version string.
+func Release(version string) (string, bool) {
+	m := releaseRegexp.FindStringSubmatch(version)
+	if m == nil {
+		return "", false
+	}
+	return "v" + m[1], true
+}
+
+// Version returns the full version string of the compiler binary on the given path,
+// i.e. v0.7.5+commit.eb77ed08.
+func Version(solc string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), compilerTimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, solc, "--version").Output()
+	if err != nil {
+		return "", fmt.Errorf("compiler %s not available; %s", solc, err.Error())
+	}
+
+	if m := versionRegexp.FindStringSubmatch(string(out)); m != nil {
+		return "v" + m[1], nil
+	}
+	return "", fmt.Errorf("unknown version of compiler %s", solc)
+}
+
+// Compile compiles the given source code with the compiler binary on the given path
+// and returns the contracts found in the source code sorted by their name.
+func Compile(solc string, src string, optimized bool, runs int32) ([]*Contract, error) {
+	in, err := compilerInput(src, optimized, runs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), compilerTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, solc, "--standard-json")
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("compiler %s failed; %s %s", solc, err.Error(), strings.TrimSpace(stderr.String()))
+	}
+
+	var out compilerOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return nil, fmt.Errorf("invalid compiler output; %s", err.Error())
+	}
+	return compiledContracts(&out)
+}
+
+// compilerInput builds the standard JSON input of the compiler for the given source code.
+func compilerInput(src string, optimized bool, runs int32) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"language": "Solidity",
+		"sources": map[string]interface{}{
+			sourceFileName: map[string]string{"content": src},
+		},
+		"settings": map[string]interface{}{
+			"optimizer": map[string]interface{}{
+				"enabled": optimized,
+				"runs":    runs,
+			},
+			"outputSelection": map[string]interface{}{
+				"*": map[string][]string{
+					"*": {
+						"abi",
+						"evm.deployedBytecode.object",
+						"evm.deployedBytecode.immutableReferences",
+						"evm.deployedBytecode.linkReferences",
+					},
+				},
+			},
+		},
+	})
+}
+
+// compiledContracts extracts the list of compiled contracts from the compiler output.
+func compiledContracts(out *compilerOutput) ([]*Contract, error) {
+	for _, e := range out.Errors {
+		if strings.EqualFold(e.Severity, "error") {
+			msg := e.FormattedMessage
+			if msg == "" {
+				msg = e.Message
+			}
+			return nil, fmt.Errorf("compilation failed; %s", strings.TrimSpace(msg))
+		}
+	}
+
+	list := make([]*Contract, 0)
+	for _, unit := range out.Contracts {
+		for name, c := range unit {
+			// interfaces and abstract contracts don't have any code
+			if c.Evm.DeployedBytecode.Object == "" {
+				continue
+			}
+
+			// link placeholders are replaced by zero addresses
+			code, err := hexutil.Decode("0x" + placeholderRegexp.ReplaceAllStringFunc(c.Evm.DeployedBytecode.Object, func(s string) string {
+				return strings.Repeat("0", len(s))
+			}))
+			if err != nil {
+				return nil, fmt.Errorf("invalid byte code of %s; %s", name, err.Error())
+			}
+
+			sc := Contract{Name: name, Abi: string(c.Abi), Code: code}
+			for _, ir := range c.Evm.DeployedBytecode.ImmutableReferences {
+				sc.Immutables = append(sc.Immutables, ir...)
+			}
+			for _, file := range c.Evm.DeployedBytecode.LinkReferences {
+				for _, lr := range file {
+					sc.Libraries = append(sc.Libraries, lr...)
+				}
+			}
+			list = append(list, &sc)
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list, nil
+}
@@ -0,0 +1,271 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"sync"
+	"time"
+)
+
+// dlrRetryTickDuration represents the frequency of the failed processing retries.
+const dlrRetryTickDuration = 30 * time.Second
+
+// dlrRetryBatchSize represents the max number of failed records retried on a single tick.
+const dlrRetryBatchSize = 100
+
+// dlrRetryBaseDelay represents the delay before the first retry of a failed record.
+// The delay doubles with each subsequent failure of the record up to dlrRetryMaxDelay.
+const dlrRetryBaseDelay = 30 * time.Second
+
+// dlrRetryMaxDelay represents the longest delay between retries of a failed record.
+const dlrRetryMaxDelay = 6 * time.Hour
+
+// names of the handlers recording failed processing
+const (
+	dlhInternalTrx      = "internal transactions"
+	dlhContractEvent    = "contract event"
+	dlhTokenTrx         = "token transaction"
+	dlhDelegation       = "delegation"
+	dlhWithdrawRequest  = "withdraw request"
+	dlhRewardClaim      = "reward claim"
+	dlhLockedDelegation = "locked delegation"
+	dlhUniswap          = "uniswap"
+	dlhFMint            = "fmint"
+)
+
+// deadLetterRetrier implements a service retrying failed processing of transactions and logs
+// recorded in the dead letters' collection. The failed records are retried with an exponential back-off
+// until they are processed successfully, or discarded.
+type deadLetterRetrier struct {
+	service
+	handlers map[common.Hash]func(*types.LogRecord)
+	events   contractEventIndex
+
+	// replays are held while orphaned blocks are reverted
+	replays sync.RWMutex
+}
+
+// errDeadLetterOrphaned represents a failed record of a block orphaned by a chain reorganization.
+var errDeadLetterOrphaned = fmt.Errorf("transaction not in the canonical block")
+
+// name returns the name of the service used by orchestrator.
+func (dlr *deadLetterRetrier) name() string {
+	return "dead letter retrier"
+}
+
+// init prepares the dead letter retrier; the log handlers of the log dispatcher are used.
+func (dlr *deadLetterRetrier) init() {
+	dlr.sigStop = make(chan struct{})
+	dlr.handlers = dlr.mgr.lgd.knownTopics
+	dlr.events = dlr.mgr.lgd.contractEvents
+}
+
+// run starts the dead letter retrier.
+func (dlr *deadLetterRetrier) run() {
+	// make sure we are orchestrated
+	if dlr.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", dlr.name()))
+	}
+
+	// signal orchestrator we started and go
+	dlr.mgr.started(dlr)
+	go dlr.execute()
+}
+
+// execute retries failed records due for the retry.
+func (dlr *deadLetterRetrier) execute() {
+	tick := time.NewTicker(dlrRetryTickDuration)
+	defer func() {
+		tick.Stop()
+		dlr.mgr.finished(dlr)
+	}()
+
+	for {
+		select {
+		case <-dlr.sigStop:
+			return
+		case <-tick.C:
+			dlr.retry()
+		}
+	}
+}
+
+// retry replays a batch of the failed records due for the retry.
+func (dlr *deadLetterRetrier) retry() {
+	now := time.Now()
+	list, err := repo.DeadLetters(&now, dlrRetryBatchSize)
+	if err != nil {
+		log.Errorf("can not load failed records to retry; %s", err.Error())
+		return
+	}
+
+	var done int
+	for _, dl := range list {
+		select {
+		case <-dlr.sigStop:
+			return
+		default:
+		}
+
+		if dlr.replay(dl) {
+			done++
+		}
+	}
+
+	if len(list) > 0 {
+		log.Noticef("%d failed records retried, %d resolved", len(list), done)
+		dlr.mgr.progress(dlr)
+	}
+}
+
+// replay processes the given failed record again. The record is removed
+// if the processing did not fail again. It returns TRUE if the record has been resolved.
+func (dlr *deadLetterRetrier) replay(dl *types.DeadLetter) bool {
+	// the failure time is kept in milliseconds precision
+	start := time.Now().Truncate(time.Millisecond)
+
+	dlr.replays.RLock()
+	defer dlr.replays.RUnlock()
+
+	err := dlr.process(dl)
+	if err == errDeadLetterOrphaned {
+		log.Noticef("failed %s %s of %s discarded; %s", dl.Handler, dl.Type, dl.Transaction.String(), err.Error())
+		if _, err := repo.DiscardDeadLetter(dl.ID); err != nil {
+			log.Errorf("can not discard failed %s %s of %s; %s", dl.Handler, dl.Type, dl.Transaction.String(), err.Error())
+		}
+		return false
+	}
+	if err != nil {
+		log.Errorf("can not replay failed %s %s of %s; %s", dl.Handler, dl.Type, dl.Transaction.String(), err.Error())
+		re := *dl
+		re.Error = err.Error()
+		deadLetter(&re)
+		return false
+	}
+
+	ok, err := repo.ResolveDeadLetter(dl.ID, start)
+	if err != nil {
+		return false
+	}
+	if ok {
+		log.Noticef("failed %s %s of %s resolved", dl.Handler, dl.Type, dl.Transaction.String())
+	}
+	return ok
+}
+
+// process executes the handler of the given failed record.
+func (dlr *deadLetterRetrier) process(dl *types.DeadLetter) error {
+	blk, err := repo.BlockByNumber(&dl.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("block #%d not available; %s", dl.BlockNumber, err.Error())
+	}
+
+	trx, err := repo.Transaction(&dl.Transaction)
+	if err != nil {
+		return fmt.Errorf("transaction not available; %s", err.Error())
+	}
+	if trx.BlockHash == nil || *trx.BlockHash != blk.Hash {
+		return errDeadLetterOrphaned
+	}
+	trx.TimeStamp = time.Unix(int64(blk.TimeStamp), 0)
+
+	switch dl.Type {
+	case types.DeadLetterTypeLog:
+		return dlr.processLog(dl, blk, trx)
+	case types.DeadLetterTypeTrx:
+		return dlr.processTrx(dl, blk, trx)
+	}
+	return fmt.Errorf("unknown record type %s", dl.Type)
+}
+
+// processLog passes the failed log to its handler.
+func (dlr *deadLetterRetrier) processLog(dl *types.DeadLetter, blk *types.Block, trx *types.Transaction) error {
+	if dl.LogIndex == nil {
+		return fmt.Errorf("log index not available")
+	}
+
+	for _, lg := range trx.Logs {
+		if lg.Index != uint(*dl.LogIndex) || len(lg.Topics) == 0 {
+			continue
+		}
+
+		lr := types.LogRecord{
+			WatchDog: new(sync.WaitGroup),
+			Block:    blk,
+			Trx:      trx,
+			Log:      lg,
+		}
+
+		// custom indexed contract events have their own handler
+		if dl.Handler == dlhContractEvent {
+			dlr.events.handle(&lr)
+			return nil
+		}
+
+		handler, ok := dlr.handlers[lg.Topics[0]]
+		if !ok {
+			return fmt.Errorf("unknown log topic %s", lg.Topics[0].String())
+		}
+		handler(&lr)
+		return nil
+	}
+	return fmt.Errorf("log #%d not found", uint(*dl.LogIndex))
+}
+
+// processTrx passes the failed transaction to its handler.
+func (dlr *deadLetterRetrier) processTrx(dl *types.DeadLetter, blk *types.Block, trx *types.Transaction) error {
+	switch dl.Handler {
+	case dlhInternalTrx:
+		list, err := repo.TraceInternalTransactions(trx)
+		if err != nil {
+			return err
+		}
+		return repo.StoreInternalTransactions(&trx.Hash, list)
+	}
+	return fmt.Errorf("unknown transaction handler %s", dl.Handler)
+}
+
+// deadLetterLog records a failed processing of the given log by the given handler, so it can be retried later.
+func deadLetterLog(lr *types.LogRecord, handler string, err error) {
+	lix := hexutil.Uint(lr.Index)
+	deadLetter(&types.DeadLetter{
+		Type:        types.DeadLetterTypeLog,
+		Handler:     handler,
+		BlockNumber: hexutil.Uint64(lr.BlockNumber),
+		Transaction: lr.TxHash,
+		LogIndex:    &lix,
+		Error:       err.Error(),
+	})
+}
+
+// deadLetterTrx records a failed processing of the given transaction by the given handler, so it can be retried later.
+func deadLetterTrx(blk *types.Block, trx *types.Transaction, handler string, err error) {
+	deadLetter(&types.DeadLetter{
+		Type:        types.DeadLetterTypeTrx,
+		Handler:     handler,
+		BlockNumber: blk.Number,
+		Transaction: trx.Hash,
+		Error:       err.Error(),
+	})
+}
+
+// deadLetter stores the given failed processing record and schedules its retry.
+func deadLetter(dl *types.DeadLetter) {
+	dl.ID = dl.Pk()
+	attempts, err := repo.StoreDeadLetter(dl)
+	if err != nil {
+		log.Criticalf("failed %s %s of %s can not be recorded; %s", dl.Handler, dl.Type, dl.Transaction.String(), err.Error())
+		return
+	}
+
+	delay := dlrRetryBaseDelay << uint(attempts-1)
+	if delay > dlrRetryMaxDelay || delay <= 0 {
+		delay = dlrRetryMaxDelay
+	}
+	if err := repo.RetryDeadLetterAt(dl.ID, time.Now().Add(delay)); err != nil {
+		log.Criticalf("failed %s %s of %s can not be scheduled; %s", dl.Handler, dl.Type, dl.Transaction.String(), err.Error())
+	}
+}
@@ -72,6 +72,7 @@ func (acd *accDispatcher) execute() {
 
 			// signal this account has been processed
 			acc.watchDog.Done()
+			acd.mgr.progress(acd)
 		}
 	}
 }
@@ -84,7 +85,7 @@ func (acd *accDispatcher) process(acc *eventAcc) error {
 
 	// check if the account is new; if we already know it, we are done
 	if repo.AccountIsKnown(acc.addr) {
-		return repo.AccountMarkActivity(acc.addr, uint64(acc.blk.TimeStamp))
+		return acc.batch.AccountMarkActivity(acc.addr, uint64(acc.blk.TimeStamp))
 	}
 
 	// is this a simple wallet/account?
@@ -106,8 +107,8 @@ func (acd *accDispatcher) wallet(acc *eventAcc) error {
 	// check if the target address is not an SFC contract
 	acd.checkSfc(acc)
 
-	// add the account into the database
-	err := repo.StoreAccount(&types.Account{
+	// add the account into the block batch
+	err := acc.batch.StoreAccount(&types.Account{
 		Address:      *acc.addr,
 		ContractTx:   acc.deploy,
 		Type:         acc.act,
@@ -5,6 +5,7 @@ import (
 	"fantom-api-graphql/internal/types"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
+	"sync"
 	"time"
 )
 
@@ -14,8 +15,9 @@ const trxBufferCapacity = 50000
 // eventTrx represents a packed transaction event
 // sent between block dispatcher and transaction dispatcher
 type eventTrx struct {
-	blk *types.Block
-	trx *types.Transaction
+	blk   *types.Block
+	trx   *types.Transaction
+	batch *blockBatch
 }
 
 // blockDispatcher implements a service responsible for processing new blocks on the blockchain.
@@ -25,6 +27,9 @@ type blockDispatcher struct {
 	inBlock        chan *types.Block
 	outTransaction chan *eventTrx
 	outDispatched  chan uint64
+	outBatch       chan *blockBatch
+	last           *types.Block
+	uncommitted    *sync.WaitGroup
 }
 
 // name returns the name of the service used by orchestrator.
@@ -37,6 +42,9 @@ func (bld *blockDispatcher) init() {
 	bld.sigStop = make(chan struct{})
 	bld.outTransaction = make(chan *eventTrx, trxBufferCapacity)
 	bld.outDispatched = make(chan uint64, blsBlockBufferCapacity)
+	bld.outBatch = make(chan *blockBatch, blcBatchQueueCapacity)
+	bld.uncommitted = new(sync.WaitGroup)
+	bld.last = nil
 }
 
 // run starts the block dispatcher
@@ -59,6 +67,7 @@ func (bld *blockDispatcher) execute() {
 		// close our channels
 		close(bld.outTransaction)
 		close(bld.outDispatched)
+		close(bld.outBatch)
 
 		// signal we are done
 		bld.mgr.finished(bld)
@@ -77,27 +86,50 @@ func (bld *blockDispatcher) execute() {
 				return
 			}
 
-			// process the new block
+			// make sure the block extends the chain we know
 			log.Debugf("block #%d arrived", uint64(blk.Number))
-			if !bld.process(blk) {
+			blk = bld.reconcile(blk)
+			if blk == nil {
 				continue
 			}
 
-			// broadcast the block event
-			select {
-			case bld.onBlock <- blk:
-			case <-time.After(200 * time.Millisecond):
-			}
-
-			// add the block to the ring
-			repo.CacheBlock(blk)
+			// process the new block
+			bld.dispatch(blk)
 		}
 	}
 }
 
+// dispatch processes the given block, sends its batch of writes to the committer
+// and broadcasts the block to the block subscribers.
+func (bld *blockDispatcher) dispatch(blk *types.Block) bool {
+	bb := newBlockBatch(blk, bld.uncommitted)
+	if !bld.process(blk, bb) {
+		return false
+	}
+
+	// the batch is committed once all the block transactions are processed
+	select {
+	case bld.outBatch <- bb:
+	case <-bld.sigStop:
+		return false
+	}
+	bld.last = blk
+	bld.mgr.progress(bld)
+
+	// broadcast the block event
+	select {
+	case bld.onBlock <- blk:
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	// add the block to the ring; the blocks' registry is updated by the commit
+	repo.CacheBlock(blk)
+	return true
+}
+
 // process the given block by loading its content and sending block transactions
 // into the trx dispatcher. Observe terminate signal.
-func (bld *blockDispatcher) process(blk *types.Block) bool {
+func (bld *blockDispatcher) process(blk *types.Block, bb *blockBatch) bool {
 	// dispatched block number is used by the block scanner
 	// to keep track of the work done vs. work pending
 	select {
@@ -112,7 +144,7 @@ func (bld *blockDispatcher) process(blk *types.Block) bool {
 	}
 
 	log.Debugf("%d transaction found in block #%d", len(blk.Txs), blk.Number)
-	if !bld.processTxs(blk) {
+	if !bld.processTxs(blk, bb) {
 		return false
 	}
 
@@ -122,16 +154,20 @@ func (bld *blockDispatcher) process(blk *types.Block) bool {
 
 // processTxs loops all the transactions in the block and pushes them
 // into the transaction dispatcher queue observing the term signal.
-func (bld *blockDispatcher) processTxs(blk *types.Block) bool {
+func (bld *blockDispatcher) processTxs(blk *types.Block, bb *blockBatch) bool {
 	for i, th := range blk.Txs {
 		log.Debugf("loading trx #%d from block #%d", i, blk.Number)
 		trx := bld.load(blk, th)
 		if trx != nil {
+			// the transaction is processed by the trx and the burn dispatchers
+			bb.watchDog.Add(2)
+
 			// queue and broadcast the transaction
 			select {
 			case bld.outTransaction <- &eventTrx{
-				blk: blk,
-				trx: trx,
+				blk:   blk,
+				trx:   trx,
+				batch: bb,
 			}:
 			case <-bld.sigStop:
 				return false
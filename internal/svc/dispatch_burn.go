@@ -44,7 +44,6 @@ func (bud *burnDispatcher) execute() {
 		bud.mgr.finished(bud)
 	}()
 
-	var current *types.FtmBurn
 	for {
 		select {
 		case <-bud.sigStop:
@@ -53,18 +52,22 @@ func (bud *burnDispatcher) execute() {
 			if !ok {
 				return
 			}
-			current = bud.process(tx, current)
+			bud.process(tx)
+			tx.batch.watchDog.Done()
+			bud.mgr.progress(bud)
 		}
 	}
 }
 
 // process incoming transaction event to extract the burn information.
-func (bud *burnDispatcher) process(tx *eventTrx, burn *types.FtmBurn) *types.FtmBurn {
+// The burn of the block is collected in the block batch and stored with the block commit.
+func (bud *burnDispatcher) process(tx *eventTrx) {
 	txFee, txTreasury, txBurn, txReward := bud.burnedFee(tx.trx)
 
-	// no previous burn to check against? make a new record
+	// no previous burn in the block? make a new record
+	burn := tx.batch.FtmBurn()
 	if burn == nil {
-		return &types.FtmBurn{
+		burn = &types.FtmBurn{
 			BlockNumber:    tx.blk.Number,
 			BlkTimeStamp:   time.Unix(int64(tx.blk.TimeStamp), 0),
 			BurnAmount:     hexutil.Big(*txBurn),
@@ -73,37 +76,20 @@ func (bud *burnDispatcher) process(tx *eventTrx, burn *types.FtmBurn) *types.Ftm
 			RewardsAmount:  hexutil.Big(*txReward),
 			TxList:         append(make([]common.Hash, 0), tx.trx.Hash),
 		}
+	} else {
+		// just add this fee to the existing
+		burn.BurnAmount = hexutil.Big(*new(big.Int).Add((*big.Int)(&burn.BurnAmount), txBurn))
+		burn.FeeAmount = hexutil.Big(*new(big.Int).Add((*big.Int)(&burn.FeeAmount), txFee))
+		burn.TreasuryAmount = hexutil.Big(*new(big.Int).Add((*big.Int)(&burn.TreasuryAmount), txTreasury))
+		burn.RewardsAmount = hexutil.Big(*new(big.Int).Add((*big.Int)(&burn.RewardsAmount), txReward))
+
+		// remember the transaction ref
+		burn.TxList = append(burn.TxList, tx.trx.Hash)
 	}
 
-	// a new block may have been received
-	if tx.blk.Number != burn.BlockNumber {
-		val := float64(new(big.Int).Div((*big.Int)(&burn.BurnAmount), types.BurnDecimalsCorrection).Int64()) / 1_000_000
-		log.Debugf("collected block burn of %.4f FTM at #%d", val, burn.BlockNumber)
-
-		if err := repo.StoreFtmBurn(burn); err != nil {
-			log.Warningf("could not store previous burn; %s", err.Error())
-		}
-
-		return &types.FtmBurn{
-			BlockNumber:    tx.blk.Number,
-			BlkTimeStamp:   time.Unix(int64(tx.blk.TimeStamp), 0),
-			BurnAmount:     hexutil.Big(*txBurn),
-			FeeAmount:      hexutil.Big(*txFee),
-			TreasuryAmount: hexutil.Big(*txTreasury),
-			RewardsAmount:  hexutil.Big(*txReward),
-			TxList:         append(make([]common.Hash, 0), tx.trx.Hash),
-		}
+	if err := tx.batch.StoreFtmBurn(burn); err != nil {
+		log.Warningf("could not store burn of block #%d; %s", uint64(tx.blk.Number), err.Error())
 	}
-
-	// just add this fee to the existing
-	burn.BurnAmount = hexutil.Big(*new(big.Int).Add((*big.Int)(&burn.BurnAmount), txBurn))
-	burn.FeeAmount = hexutil.Big(*new(big.Int).Add((*big.Int)(&burn.FeeAmount), txFee))
-	burn.TreasuryAmount = hexutil.Big(*new(big.Int).Add((*big.Int)(&burn.TreasuryAmount), txTreasury))
-	burn.RewardsAmount = hexutil.Big(*new(big.Int).Add((*big.Int)(&burn.RewardsAmount), txReward))
-
-	// remember the transaction ref
-	burn.TxList = append(burn.TxList, tx.trx.Hash)
-	return burn
 }
 
 // burnedFee calculates the amount of burned FTMs from the transaction fee.
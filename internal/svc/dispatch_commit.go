@@ -0,0 +1,131 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// blcBatchQueueCapacity is the number of processed blocks waiting to be committed.
+const blcBatchQueueCapacity = 500
+
+// blcRetryBaseDelay represents the delay before a failed block commit is repeated.
+// The delay doubles with each subsequent failure of the commit up to blcRetryMaxDelay.
+const blcRetryBaseDelay = time.Second
+
+// blcRetryMaxDelay represents the longest delay between repeated block commits.
+const blcRetryMaxDelay = 30 * time.Second
+
+// blockBatch represents the database writes of a block being processed.
+// The watchDog is released by the dispatchers once they are done with the block transactions,
+// the committed group is released once the batch is written.
+type blockBatch struct {
+	*repository.BlockBatch
+	blk       *types.Block
+	watchDog  sync.WaitGroup
+	committed *sync.WaitGroup
+}
+
+// newBlockBatch creates a new batch of writes for the given block.
+func newBlockBatch(blk *types.Block, committed *sync.WaitGroup) *blockBatch {
+	committed.Add(1)
+	return &blockBatch{
+		BlockBatch: repo.NewBlockBatch(blk),
+		blk:        blk,
+		committed:  committed,
+	}
+}
+
+// blockCommitter implements a service writing the processed blocks into the database.
+// The blocks are committed in the order they were dispatched and the last known block
+// is advanced with each commit, so the progress never passes a block which has not been stored.
+type blockCommitter struct {
+	service
+	inBatch chan *blockBatch
+}
+
+// name returns the name of the service used by orchestrator.
+func (blc *blockCommitter) name() string {
+	return "block committer"
+}
+
+// run starts the block committer.
+func (blc *blockCommitter) run() {
+	// make sure we are orchestrated
+	if blc.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", blc.name()))
+	}
+
+	// signal orchestrator we started and go
+	blc.mgr.started(blc)
+	go blc.execute()
+}
+
+// execute waits for the processed blocks and commits them.
+func (blc *blockCommitter) execute() {
+	defer func() {
+		blc.mgr.finished(blc)
+	}()
+
+	for {
+		select {
+		case <-blc.sigStop:
+			return
+		case bb, ok := <-blc.inBatch:
+			if !ok {
+				log.Noticef("batch channel closed, terminating %s", blc.name())
+				return
+			}
+
+			if !blc.wait(bb) || !blc.commit(bb) {
+				return
+			}
+			blc.mgr.progress(blc)
+		}
+	}
+}
+
+// wait for the dispatchers to finish processing of the given block observing the terminate signal.
+// It returns FALSE if the service has been terminated.
+func (blc *blockCommitter) wait(bb *blockBatch) bool {
+	done := make(chan struct{})
+	go func() {
+		bb.watchDog.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-blc.sigStop:
+		return false
+	}
+}
+
+// commit writes the given block batch into the database. A failed commit is repeated
+// with a back-off delay until it succeeds, or the service is terminated.
+func (blc *blockCommitter) commit(bb *blockBatch) bool {
+	delay := blcRetryBaseDelay
+	for {
+		err := bb.Commit()
+		if err == nil {
+			log.Debugf("block #%d committed", uint64(bb.blk.Number))
+			bb.committed.Done()
+			return true
+		}
+
+		log.Errorf("block #%d commit failed, retrying in %s; %s", uint64(bb.blk.Number), delay.String(), err.Error())
+		select {
+		case <-blc.sigStop:
+			return false
+		case <-time.After(delay):
+		}
+
+		if delay *= 2; delay > blcRetryMaxDelay {
+			delay = blcRetryMaxDelay
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// reconcile checks if the given block extends the chain already processed.
+// If a chain reorganization is detected, the orphaned blocks are reverted and the canonical
+// blocks are re-ingested. The block to be processed is returned; nil if the process
+// has been terminated.
+func (bld *blockDispatcher) reconcile(blk *types.Block) *types.Block {
+	num := uint64(blk.Number)
+	if num == 0 {
+		return blk
+	}
+
+	// compare the parent with the block we know at the parent height
+	known, err := bld.knownHash(num - 1)
+	if err != nil {
+		log.Errorf("can not check parent of block #%d; %s", num, err.Error())
+		return blk
+	}
+	if known == nil || *known == blk.ParentHash {
+		return blk
+	}
+
+	log.Warningf("block #%d parent %s does not match known block %s; chain reorganization detected",
+		num, blk.ParentHash.String(), known.String())
+
+	// all the in-flight work of the dispatched blocks must be committed before we can revert them
+	if !bld.flush() {
+		return nil
+	}
+
+	// find the last block we share with the canonical chain
+	fork, err := bld.forkPoint(num - 1)
+	if err != nil {
+		log.Criticalf("can not resolve chain reorganization at #%d; %s", num, err.Error())
+		return blk
+	}
+
+	// the confirmation depth should be deeper than any expected reorganization
+	if num-1-fork > cfg.RepoCommand.Confirmations {
+		log.Criticalf("chain reorganization of %d blocks exceeds confirmation depth of %d blocks; final data reverted",
+			num-1-fork, cfg.RepoCommand.Confirmations)
+	}
+
+	// revert orphaned blocks; failed records of the blocks must not be replayed meanwhile
+	bld.mgr.dlr.replays.Lock()
+	err = repo.RevertBlocks(fork + 1)
+	bld.mgr.dlr.replays.Unlock()
+	if err != nil {
+		log.Criticalf("can not revert blocks from #%d; %s", fork+1, err.Error())
+		return blk
+	}
+	bld.last = nil
+	if err := repo.UpdateLastKnownBlock((*hexutil.Uint64)(&fork)); err != nil {
+		log.Errorf("can not update last known block; %s", err.Error())
+	}
+
+	// re-ingest the canonical chain up to the block
+	for i := fork + 1; i < num; i++ {
+		cb, err := repo.LoadBlock((*hexutil.Uint64)(&i))
+		if err != nil {
+			log.Errorf("canonical block #%d not available; %s", i, err.Error())
+			return blk
+		}
+		if !bld.dispatch(cb) {
+			return nil
+		}
+	}
+
+	// the block itself may be stale too
+	cb, err := repo.LoadBlock(&blk.Number)
+	if err != nil {
+		log.Errorf("canonical block #%d not available; %s", num, err.Error())
+		return blk
+	}
+
+	log.Noticef("chain reorganization resolved, %d blocks replaced since #%d", num-fork-1, fork)
+	return cb
+}
+
+// knownHash provides the hash of the processed block at the given height.
+// The last dispatched block may not be committed yet, so it's checked first.
+func (bld *blockDispatcher) knownHash(num uint64) (*common.Hash, error) {
+	if bld.last != nil && uint64(bld.last.Number) == num {
+		return &bld.last.Hash, nil
+	}
+	return repo.KnownBlockHash(num)
+}
+
+// flush waits for the dispatched blocks to be committed observing the terminate signal.
+// It returns FALSE if the dispatcher has been terminated.
+func (bld *blockDispatcher) flush() bool {
+	done := make(chan struct{})
+	go func() {
+		bld.uncommitted.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-bld.sigStop:
+		return false
+	}
+}
+
+// forkPoint walks the processed blocks down from the given height
+// and finds the last one matching the canonical chain.
+func (bld *blockDispatcher) forkPoint(top uint64) (uint64, error) {
+	for num := top; top-num < cfg.RepoCommand.ReorgDepth; num-- {
+		known, err := repo.KnownBlockHash(num)
+		if err != nil {
+			return 0, err
+		}
+
+		// nothing known at this height; there is nothing to revert below
+		if known == nil {
+			return num, nil
+		}
+
+		cb, err := repo.LoadBlock((*hexutil.Uint64)(&num))
+		if err != nil {
+			return 0, err
+		}
+		if cb.Hash == *known {
+			return num, nil
+		}
+
+		if num == 0 {
+			break
+		}
+	}
+	return 0, fmt.Errorf("reorganization deeper than %d blocks", cfg.RepoCommand.ReorgDepth)
+}
@@ -5,9 +5,7 @@ import (
 	"fantom-api-graphql/internal/types"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	retypes "github.com/ethereum/go-ethereum/core/types"
-	"go.uber.org/atomic"
 	"sync"
 	"time"
 )
@@ -18,9 +16,6 @@ const trxAddressQueueCapacity = 1000
 // trxLogQueueCapacity is the number of transaction logs kept in the dispatch buffer.
 const trxLogQueueCapacity = 5000
 
-// trxDispatchBlockUpdateTicker represents the period of block registry updater.
-const trxDispatchBlockUpdateTicker = 15 * time.Second
-
 // eventAcc represents a structure of a mentioned account.
 type eventAcc struct {
 	watchDog *sync.WaitGroup
@@ -28,6 +23,7 @@ type eventAcc struct {
 	act      string
 	blk      *types.Block
 	trx      *types.Transaction
+	batch    *blockBatch
 	deploy   *common.Hash
 }
 
@@ -35,8 +31,6 @@ type eventAcc struct {
 type trxDispatcher struct {
 	service
 	onTransaction  chan *types.Transaction
-	bot            *time.Ticker
-	blkObserver    *atomic.Uint64
 	inTransaction  chan *eventTrx
 	outTransaction chan *eventTrx
 	outAccount     chan *eventAcc
@@ -51,7 +45,6 @@ func (trd *trxDispatcher) name() string {
 // init prepares the transaction dispatcher to perform its function.
 func (trd *trxDispatcher) init() {
 	trd.sigStop = make(chan struct{})
-	trd.blkObserver = atomic.NewUint64(1)
 	trd.outAccount = make(chan *eventAcc, trxAddressQueueCapacity)
 	trd.outLog = make(chan *types.LogRecord, trxLogQueueCapacity)
 	trd.outTransaction = make(chan *eventTrx, trxLogQueueCapacity)
@@ -64,24 +57,11 @@ func (trd *trxDispatcher) run() {
 		panic(fmt.Errorf("no svc manager set on %s", trd.name()))
 	}
 
-	// start the block observer ticker
-	trd.bot = time.NewTicker(trxDispatchBlockUpdateTicker)
-
 	// signal orchestrator we started and go
 	trd.mgr.started(trd)
 	go trd.execute()
 }
 
-// close terminates the block dispatcher.
-func (trd *trxDispatcher) close() {
-	if trd.bot != nil {
-		trd.bot.Stop()
-	}
-	if trd.sigStop != nil {
-		close(trd.sigStop)
-	}
-}
-
 // execute implements the dispatcher reader and router routine.
 func (trd *trxDispatcher) execute() {
 	// don't forget to sign off after we are done
@@ -99,8 +79,6 @@ func (trd *trxDispatcher) execute() {
 		select {
 		case <-trd.sigStop:
 			return
-		case <-trd.bot.C:
-			trd.updateLastSeenBlock()
 		case evt, ok := <-trd.inTransaction:
 			// is the channel even available for reading
 			if !ok {
@@ -113,26 +91,16 @@ func (trd *trxDispatcher) execute() {
 				continue
 			}
 			trd.process(evt)
+			trd.mgr.progress(trd)
 		}
 	}
 }
 
-// updateLastSeenBlock updates the information about last known block
-// in the persistent database.
-func (trd *trxDispatcher) updateLastSeenBlock() {
-	// get the current value
-	lsb := trd.blkObserver.Load()
-	log.Noticef("last seen block is #%d", lsb)
-
-	// make the change in the database so the progress persists
-	err := repo.UpdateLastKnownBlock((*hexutil.Uint64)(&lsb))
-	if err != nil {
-		log.Errorf("could not update last seen block; %s", err.Error())
-	}
-}
-
 // process the given transaction event into the required targets.
 func (trd *trxDispatcher) process(evt *eventTrx) {
+	// the transaction is not pending anymore
+	trd.mgr.ptp.mined(&evt.trx.Hash)
+
 	// send the transaction out for burns processing
 	select {
 	case trd.outTransaction <- evt:
@@ -148,12 +116,18 @@ func (trd *trxDispatcher) process(evt *eventTrx) {
 
 	// process transaction logs; exit if terminated
 	for _, lg := range evt.trx.Logs {
-		if !trd.pushLog(lg, evt.blk, evt.trx, &wg) {
+		if !trd.pushLog(lg, evt, &wg) {
 			return
 		}
 	}
 
-	// store the transaction into the database once the processing is done
+	// collect internal transactions, if enabled
+	if cfg.Repository.TraceInternal {
+		wg.Add(1)
+		go trd.traceInternal(evt, &wg)
+	}
+
+	// queue the transaction into the block batch once the processing is done
 	// we spawn a lot of go-routines here, so we should test the optimal queue length above
 	go trd.waitAndStore(evt, &wg)
 
@@ -165,28 +139,42 @@ func (trd *trxDispatcher) process(evt *eventTrx) {
 	}
 }
 
-// waitAndStore waits for the transaction processing to finish and stores the transaction into db.
+// waitAndStore waits for the transaction processing to finish and queues the transaction
+// into the batch of its block. The transaction is written to the database with the block commit,
+// a failed commit is repeated by the block committer.
 func (trd *trxDispatcher) waitAndStore(evt *eventTrx, wg *sync.WaitGroup) {
 	// wait until all the sub-processors finish their job
 	wg.Wait()
-	if err := repo.StoreTransaction(evt.blk, evt.trx); err != nil {
-		log.Errorf("can not store trx %s from block #%d", evt.trx.Hash.String(), evt.blk.Number)
+	evt.batch.StoreTransaction(evt.trx)
+	evt.batch.watchDog.Done()
+}
+
+// traceInternal traces the transaction to collect and store its internal transactions.
+func (trd *trxDispatcher) traceInternal(evt *eventTrx, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	list, err := repo.TraceInternalTransactions(evt.trx)
+	if err != nil {
+		log.Errorf("can not trace trx %s from block #%d; %s", evt.trx.Hash.String(), evt.blk.Number, err.Error())
+		deadLetterTrx(evt.blk, evt.trx, dlhInternalTrx, err)
+		return
 	}
 
-	repo.IncTrxCountEstimate(1)
-	repo.CacheTransaction(evt.trx)
-	trd.blkObserver.Store(uint64(evt.blk.Number))
+	if err := repo.StoreInternalTransactions(&evt.trx.Hash, list); err != nil {
+		log.Errorf("can not store internal transactions of trx %s; %s", evt.trx.Hash.String(), err.Error())
+		deadLetterTrx(evt.blk, evt.trx, dlhInternalTrx, err)
+	}
 }
 
 // pushAccounts pushes given transaction accounts on both sides observing terminate signal on process.
 func (trd *trxDispatcher) pushAccounts(evt *eventTrx, wg *sync.WaitGroup) bool {
 	// the sender is always present
-	if !trd.pushAccount(types.AccountTypeWallet, &evt.trx.From, evt.blk, evt.trx, wg) {
+	if !trd.pushAccount(types.AccountTypeWallet, &evt.trx.From, evt, wg) {
 		return false
 	}
 
 	// do we have a recipient?
-	if evt.trx.To != nil && !trd.pushAccount(types.AccountTypeWallet, evt.trx.To, evt.blk, evt.trx, wg) {
+	if evt.trx.To != nil && !trd.pushAccount(types.AccountTypeWallet, evt.trx.To, evt, wg) {
 		return false
 	}
 
@@ -197,19 +185,20 @@ func (trd *trxDispatcher) pushAccounts(evt *eventTrx, wg *sync.WaitGroup) bool {
 
 	// queue the new contract to be processed as well
 	log.Debugf("contract %s found at trx %s", evt.trx.ContractAddress.String(), evt.trx.Hash.String())
-	return trd.pushAccount(types.AccountTypeContract, evt.trx.ContractAddress, evt.blk, evt.trx, wg)
+	return trd.pushAccount(types.AccountTypeContract, evt.trx.ContractAddress, evt, wg)
 }
 
 // pushAccount pushes given account event to output queue observing terminate signal.
-func (trd *trxDispatcher) pushAccount(at string, adr *common.Address, blk *types.Block, trx *types.Transaction, wg *sync.WaitGroup) bool {
+func (trd *trxDispatcher) pushAccount(at string, adr *common.Address, evt *eventTrx, wg *sync.WaitGroup) bool {
 	wg.Add(1)
 	select {
 	case trd.outAccount <- &eventAcc{
 		watchDog: wg,
 		addr:     adr,
 		act:      at,
-		blk:      blk,
-		trx:      trx,
+		blk:      evt.blk,
+		trx:      evt.trx,
+		batch:    evt.batch,
 		deploy:   nil,
 	}:
 	case <-trd.sigStop:
@@ -219,13 +208,14 @@ func (trd *trxDispatcher) pushAccount(at string, adr *common.Address, blk *types
 }
 
 // pushLog pushes specified log record into a processing queue observing terminate signal.
-func (trd *trxDispatcher) pushLog(lg retypes.Log, blk *types.Block, trx *types.Transaction, wg *sync.WaitGroup) bool {
+func (trd *trxDispatcher) pushLog(lg retypes.Log, evt *eventTrx, wg *sync.WaitGroup) bool {
 	wg.Add(1)
 	select {
 	case trd.outLog <- &types.LogRecord{
 		WatchDog: wg,
-		Block:    blk,
-		Trx:      trx,
+		Block:    evt.blk,
+		Trx:      evt.trx,
+		Batch:    evt.batch,
 		Log:      lg,
 	}:
 	case <-trd.sigStop:
@@ -0,0 +1,136 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"os"
+	"strings"
+	"time"
+)
+
+// contractEventsFamily represents the name of the log handler family of the custom indexed contract events.
+const contractEventsFamily = "contract_events"
+
+// contractEventDecoder represents a decoder of a custom indexed contract event.
+type contractEventDecoder struct {
+	address *common.Address
+	event   abi.Event
+}
+
+// contractEventIndex represents a map of event topics to decoders of the custom indexed contract events.
+type contractEventIndex map[common.Hash][]*contractEventDecoder
+
+// newContractEventIndex builds the index of custom contract events from the given configuration.
+func newContractEventIndex(list []config.IndexedContract) contractEventIndex {
+	cei := make(contractEventIndex)
+	for _, ic := range list {
+		ca, err := indexedContractAbi(&ic)
+		if err != nil {
+			log.Errorf("can not index events of %s; %s", ic.Address.String(), err.Error())
+			continue
+		}
+
+		// any address, or a specific one?
+		var adr *common.Address
+		if ic.Address != (common.Address{}) {
+			adr = new(common.Address)
+			*adr = ic.Address
+		}
+
+		for _, ev := range ca.Events {
+			if ev.Anonymous || !indexedContractEvent(&ic, ev.Name) {
+				continue
+			}
+
+			cei[ev.ID] = append(cei[ev.ID], &contractEventDecoder{address: adr, event: ev})
+			log.Noticef("indexing event %s of %s", ev.Sig, ic.Address.String())
+		}
+	}
+	return cei
+}
+
+// indexedContractAbi parses the ABI of the indexed contract.
+func indexedContractAbi(ic *config.IndexedContract) (*abi.ABI, error) {
+	data := ic.Abi
+	if data == "" && ic.AbiFile != "" {
+		raw, err := os.ReadFile(ic.AbiFile)
+		if err != nil {
+			return nil, err
+		}
+		data = string(raw)
+	}
+
+	ca, err := abi.JSON(strings.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return &ca, nil
+}
+
+// indexedContractEvent checks if the event of the given name should be indexed.
+func indexedContractEvent(ic *config.IndexedContract, name string) bool {
+	if len(ic.Events) == 0 {
+		return true
+	}
+	for _, n := range ic.Events {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// family provides the log handler family of the custom indexed contract events.
+func (cei contractEventIndex) family() logHandlerFamily {
+	fam := logHandlerFamily{
+		name:     contractEventsFamily,
+		handlers: make(map[common.Hash]func(*types.LogRecord), len(cei)),
+	}
+	for topic := range cei {
+		fam.handlers[topic] = cei.handle
+	}
+	return fam
+}
+
+// handle decodes the given log record, if it matches any of the custom indexed events,
+// and stores the decoded event.
+func (cei contractEventIndex) handle(lr *types.LogRecord) {
+	list, ok := cei[lr.Topics[0]]
+	if !ok {
+		return
+	}
+
+	for _, dec := range list {
+		if dec.address != nil && *dec.address != lr.Address {
+			continue
+		}
+
+		args, err := types.DecodeAbiArguments(dec.event.Inputs, lr.Topics[1:], lr.Data)
+		if err != nil {
+			// the same topic may be shared by events with different indexed arguments
+			log.Debugf("can not decode %s of %s; %s", dec.event.Sig, lr.Address.String(), err.Error())
+			continue
+		}
+
+		err = repo.StoreContractEvent(&types.ContractEvent{
+			Address:     lr.Address,
+			Event:       dec.event.Name,
+			Signature:   dec.event.Sig,
+			Topic:       lr.Topics[0],
+			BlockNumber: lr.Block.Number,
+			Transaction: lr.TxHash,
+			LogIndex:    (hexutil.Uint)(lr.Index),
+			TimeStamp:   time.Unix(int64(lr.Block.TimeStamp), 0),
+			Args:        args,
+		})
+		if err != nil {
+			log.Errorf("can not store event %s of %s; %s", dec.event.Sig, lr.Address.String(), err.Error())
+			deadLetterLog(lr, dlhContractEvent, err)
+		}
+		return
+	}
+}
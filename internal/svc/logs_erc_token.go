@@ -100,7 +100,7 @@ func tokenTrxType(trxType int32, from common.Address, to common.Address) int32 {
 
 // storeTokenTransaction handles general token (ERC20/ERC721/ERC1155) transaction.
 func storeTokenTransaction(lr *types.LogRecord, tokenType string, eventType int32, from common.Address, to common.Address, amount big.Int, tokenId big.Int, seq uint16) {
-	if err := repo.StoreTokenTransaction(&types.TokenTransaction{
+	if err := blockWriter(lr).StoreTokenTransaction(&types.TokenTransaction{
 		Transaction:  lr.TxHash,
 		TrxIndex:     hexutil.Uint64(uint64(lr.TxIndex)),
 		TokenAddress: lr.Address,
@@ -116,5 +116,6 @@ func storeTokenTransaction(lr *types.LogRecord, tokenType string, eventType int3
 		Seq:          seq, // sequence of erc transactions emitted by one log event - non-zero only for batch transfer events
 	}); err != nil {
 		log.Errorf("can not store token %s trx for call %s; %s", tokenType, lr.TxHash.String(), err.Error())
+		deadLetterLog(lr, dlhTokenTrx, err)
 	}
 }
@@ -99,6 +99,7 @@ func handleNewFMintRecord(lr *types.LogRecord, tp int32, user common.Address, to
 	})
 	if err != nil {
 		log.Errorf("can not register fMint trx %s; %s", lr.TxHash.String(), err.Error())
+		deadLetterLog(lr, dlhFMint, err)
 	}
 }
 
@@ -15,6 +15,7 @@ func handleNewDelegation(lr *types.LogRecord, stakerID *big.Int, addr common.Add
 	val, err := repo.ValidatorAddress((*hexutil.Big)(stakerID))
 	if err != nil {
 		log.Errorf("unknown validator #%d; %s", stakerID.Uint64(), err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 		return
 	}
 
@@ -22,6 +23,7 @@ func handleNewDelegation(lr *types.LogRecord, stakerID *big.Int, addr common.Add
 	staked, err := repo.DelegationAmountStaked(&addr, (*hexutil.Big)(stakerID))
 	if err != nil {
 		log.Errorf("delegation balance not available for %s to %d; %s", addr.String(), stakerID.Uint64(), err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 		return
 	}
 
@@ -39,6 +41,7 @@ func handleNewDelegation(lr *types.LogRecord, stakerID *big.Int, addr common.Add
 	// store the delegation
 	if err := repo.StoreDelegation(&dl); err != nil {
 		log.Errorf("failed to store delegation; %s", err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 	}
 }
 
@@ -68,6 +71,7 @@ func handleSfc1IncreasedDelegation(lr *types.LogRecord) {
 		return makeAdHocDelegation(lr, &addr, (*hexutil.Big)(valID), amo)
 	}); err != nil {
 		log.Errorf("failed to update delegation; %s", err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 	}
 }
 
@@ -118,6 +122,7 @@ func handleNewWithdrawRequest(wrt string, adr common.Address, valID *big.Int, re
 	// store the request
 	if err := repo.StoreWithdrawRequest(&wr); err != nil {
 		log.Errorf("failed to store new withdraw request; %s", err.Error())
+		deadLetterLog(lr, dlhWithdrawRequest, err)
 	}
 
 	// check active amount on the delegation
@@ -125,6 +130,7 @@ func handleNewWithdrawRequest(wrt string, adr common.Address, valID *big.Int, re
 		return makeAdHocDelegation(lr, &wr.Address, wr.StakerID, amo)
 	}); err != nil {
 		log.Errorf("failed to update delegation; %s", err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 	}
 }
 
@@ -137,6 +143,7 @@ func handleFinishedWithdrawRequest(adr common.Address, valID *big.Int, reqID *bi
 			return makeAdHocDelegation(lr, &adr, (*hexutil.Big)(valID), amo)
 		}); err != nil {
 			log.Errorf("failed to update delegation; %s", err.Error())
+			deadLetterLog(lr, dlhDelegation, err)
 		}
 	}()
 
@@ -152,6 +159,7 @@ func handleFinishedWithdrawRequest(adr common.Address, valID *big.Int, reqID *bi
 	req, err := repo.WithdrawRequest(&adr, (*hexutil.Big)(valID), (*hexutil.Big)(reqID))
 	if err != nil {
 		log.Errorf("can not load withdraw requests to finalise; %s", err.Error())
+		deadLetterLog(lr, dlhWithdrawRequest, err)
 		return
 	}
 
@@ -163,6 +171,7 @@ func handleFinishedWithdrawRequest(adr common.Address, valID *big.Int, reqID *bi
 	// store the updated request
 	if err := repo.UpdateWithdrawRequest(req); err != nil {
 		log.Errorf("failed to store finalized withdraw request; %s", err.Error())
+		deadLetterLog(lr, dlhWithdrawRequest, err)
 	}
 }
 
@@ -243,6 +252,7 @@ func handleSfc1UpdatedDelegation(lr *types.LogRecord) {
 		return makeAdHocDelegation(lr, &addr, valID, amo)
 	}); err != nil {
 		log.Errorf("failed to update delegation; %s", err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 	}
 
 	// this should have created a new delegation
@@ -337,6 +347,7 @@ func handleLockedUpStake(lr *types.LogRecord) {
 	err := repo.StoreLockedDelegation(&lock)
 	if err != nil {
 		log.Errorf("failed to store locked delegation; %s", err.Error())
+		deadLetterLog(lr, dlhLockedDelegation, err)
 	}
 }
 
@@ -356,5 +367,6 @@ func handleUnlockedStake(lr *types.LogRecord) {
 	)
 	if err != nil {
 		log.Errorf("failed to adjust locked delegation value; %s", err.Error())
+		deadLetterLog(lr, dlhLockedDelegation, err)
 	}
 }
@@ -14,7 +14,7 @@ func handleSfcRewardClaim(lr *types.LogRecord, addr common.Address, valID *hexut
 	log.Debugf("%s claimed %d in stake to #%d", addr.String(), amo.Uint64(), valID.ToInt().Uint64())
 
 	// add the rewards claim into the repository
-	if err := repo.StoreRewardClaim(&types.RewardClaim{
+	if err := blockWriter(lr).StoreRewardClaim(&types.RewardClaim{
 		Delegator:     addr,
 		ToValidatorId: *valID,
 		Claimed:       lr.Block.TimeStamp,
@@ -23,6 +23,7 @@ func handleSfcRewardClaim(lr *types.LogRecord, addr common.Address, valID *hexut
 		IsDelegated:   isRestake,
 	}); err != nil {
 		log.Criticalf("can not store rewards claim; %s", err.Error())
+		deadLetterLog(lr, dlhRewardClaim, err)
 		return
 	}
 
@@ -31,6 +32,7 @@ func handleSfcRewardClaim(lr *types.LogRecord, addr common.Address, valID *hexut
 		return makeAdHocDelegation(lr, &addr, valID, amo)
 	}); err != nil {
 		log.Errorf("failed to update delegation; %s", err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 	}
 }
 
@@ -78,6 +80,7 @@ func handleSfcRestakeRewards(lr *types.LogRecord) {
 	)
 	if err != nil {
 		log.Errorf("could not adjust locked delegation; %s", err.Error())
+		deadLetterLog(lr, dlhLockedDelegation, err)
 	}
 }
 
@@ -142,6 +145,7 @@ func handleSfc1ClaimedValidatorReward(lr *types.LogRecord) {
 	addr, err := repo.ValidatorAddress(valID)
 	if err != nil {
 		log.Errorf("validator #%d not found; %s", valID.ToInt().Uint64(), err.Error())
+		deadLetterLog(lr, dlhRewardClaim, err)
 		return
 	}
 
@@ -27,6 +27,7 @@ func handleSfc1IncreasedStake(lr *types.LogRecord) {
 	addr, err := repo.ValidatorAddress(valID)
 	if err != nil {
 		log.Errorf("unknown validator #%d; %s", valID.ToInt().Uint64(), err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 		return
 	}
 
@@ -35,6 +36,7 @@ func handleSfc1IncreasedStake(lr *types.LogRecord) {
 		return makeAdHocDelegation(lr, addr, valID, amo)
 	}); err != nil {
 		log.Errorf("failed to update delegation; %s", err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 	}
 }
 
@@ -52,6 +54,7 @@ func handleSfc1WithdrawnStake(lr *types.LogRecord) {
 	addr, err := repo.ValidatorAddress((*hexutil.Big)(valID))
 	if err != nil {
 		log.Errorf("unknown validator #%d; %s", valID.Uint64(), err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 		return
 	}
 
@@ -75,6 +78,7 @@ func handleSfc1DeactivatedStake(lr *types.LogRecord) {
 	addr, err := repo.ValidatorAddress((*hexutil.Big)(valID))
 	if err != nil {
 		log.Errorf("unknown validator #%d; %s", valID.Uint64(), err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 		return
 	}
 
@@ -99,6 +103,7 @@ func handleSfc1UpdatedStake(lr *types.LogRecord) {
 	addr, err := repo.ValidatorAddress(valID)
 	if err != nil {
 		log.Errorf("unknown validator #%d; %s", valID.ToInt().Uint64(), err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 		return
 	}
 
@@ -108,5 +113,6 @@ func handleSfc1UpdatedStake(lr *types.LogRecord) {
 		return makeAdHocDelegation(lr, addr, valID, amo)
 	}); err != nil {
 		log.Errorf("failed to update delegation; %s", err.Error())
+		deadLetterLog(lr, dlhDelegation, err)
 	}
 }
@@ -99,6 +99,7 @@ func handleUniswapSwap(lr *types.LogRecord) {
 	})
 	if err != nil {
 		log.Errorf("%s could not store uniswap event #%d; %s", lr.TxHash.String(), lr.Index, err.Error())
+		deadLetterLog(lr, dlhUniswap, err)
 	}
 }
 
@@ -154,6 +155,7 @@ func handleUniswapMint(lr *types.LogRecord) {
 	})
 	if err != nil {
 		log.Errorf("%s could not store uniswap event #%d; %s", lr.TxHash.String(), lr.Index, err.Error())
+		deadLetterLog(lr, dlhUniswap, err)
 	}
 }
 
@@ -209,6 +211,7 @@ func handleUniswapBurn(lr *types.LogRecord) {
 	})
 	if err != nil {
 		log.Errorf("%s could not store uniswap event #%d; %s", lr.TxHash.String(), lr.Index, err.Error())
+		deadLetterLog(lr, dlhUniswap, err)
 	}
 }
 
@@ -261,5 +264,6 @@ func handleUniswapSync(lr *types.LogRecord) {
 	})
 	if err != nil {
 		log.Errorf("%s could not store uniswap event #%d; %s", lr.TxHash.String(), lr.Index, err.Error())
+		deadLetterLog(lr, dlhUniswap, err)
 	}
 }
@@ -6,12 +6,15 @@ import (
 	"fantom-api-graphql/internal/repository"
 	"fantom-api-graphql/internal/types"
 	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"strings"
 	"sync"
 )
 
 // ServiceManager implements service manager.
 type ServiceManager struct {
-	wg *sync.WaitGroup
+	wg  *sync.WaitGroup
+	sup *supervisor
 
 	// special services with external dependency
 	ora *orchestrator
@@ -21,6 +24,9 @@ type ServiceManager struct {
 	lgd *logDispatcher
 	bls *blkScanner
 	bud *burnDispatcher
+	blc *blockCommitter
+	dlr *deadLetterRetrier
+	ptp *pendingPool
 
 	// collection of all the managed services
 	svc []Svc
@@ -39,6 +45,7 @@ func newServiceManager() *ServiceManager {
 	// create new orchestrator
 	sm := ServiceManager{
 		wg:  new(sync.WaitGroup),
+		sup: newSupervisor(),
 		svc: make([]Svc, 0, 15),
 	}
 
@@ -56,17 +63,24 @@ func (mgr *ServiceManager) Run() {
 	for _, s := range mgr.svc {
 		s.init()
 	}
+	mgr.registerMetrics()
 
 	// start services
 	for _, s := range mgr.svc {
 		s.run()
 	}
+
+	// watch the services and restart them on failure
+	mgr.sup.run()
 }
 
 // Close signals orchestrator to terminate all orchestrated services.
 func (mgr *ServiceManager) Close() {
 	log.Noticef("svc manager received a close signal")
 
+	// stop the supervision first, so the services are not restarted
+	mgr.sup.close()
+
 	// pass the signal to all the services
 	for _, s := range mgr.svc {
 		log.Noticef("closing %s", s.name())
@@ -91,6 +105,17 @@ func (mgr *ServiceManager) SetTrxChannel(ch chan *types.Transaction) {
 	mgr.trd.onTransaction = ch
 }
 
+// SetPendingTrxChannel registers a channel for notifying new pending transaction events.
+func (mgr *ServiceManager) SetPendingTrxChannel(ch chan *types.Transaction) {
+	mgr.ptp.onPending = ch
+}
+
+// PendingTransactions provides a list of pending transactions sent from, and/or to the given addresses.
+// The list is ordered from the newest pending transaction to the oldest one.
+func (mgr *ServiceManager) PendingTransactions(from *common.Address, to *common.Address, cursor *common.Hash, count int32) *types.TransactionList {
+	return mgr.ptp.transactions(from, to, cursor, count)
+}
+
 // Init the svc manager.
 func (mgr *ServiceManager) init() {
 	// make the block dispatcher
@@ -113,10 +138,25 @@ func (mgr *ServiceManager) init() {
 	mgr.bud = &burnDispatcher{service: service{mgr: mgr}}
 	mgr.svc = append(mgr.svc, mgr.bud)
 
+	// make block committer
+	mgr.blc = &blockCommitter{service: service{mgr: mgr}}
+	mgr.svc = append(mgr.svc, mgr.blc)
+
 	// make block scanner
 	mgr.bls = &blkScanner{service: service{mgr: mgr}, cfg: cfg.RepoCommand}
 	mgr.svc = append(mgr.svc, mgr.bls)
 
+	// make log back-fill
+	mgr.svc = append(mgr.svc, &logBackfill{service: service{mgr: mgr}})
+
+	// make dead letter retrier
+	mgr.dlr = &deadLetterRetrier{service: service{mgr: mgr}}
+	mgr.svc = append(mgr.svc, mgr.dlr)
+
+	// make pending pool observer
+	mgr.ptp = &pendingPool{service: service{mgr: mgr}}
+	mgr.svc = append(mgr.svc, mgr.ptp)
+
 	// make epoch scanner
 	mgr.svc = append(mgr.svc, &epochScanner{service: service{mgr: mgr}})
 
@@ -137,22 +177,135 @@ func (mgr *ServiceManager) init() {
 	// add orchestrator as the last service, so it can safely operate on all the other
 	mgr.ora = &orchestrator{service: service{mgr: mgr}}
 	mgr.svc = append(mgr.svc, mgr.ora)
+
+	// services of the processing pipeline are connected by channels and must be restarted together
+	mgr.sup.add(mgr.bld, mgr.trd, mgr.acd, mgr.lgd, mgr.bud, mgr.blc, mgr.bls, mgr.ora)
 }
 
 // started signals to the manager that the calling service
 // has been started and is functioning.
 func (mgr *ServiceManager) started(svc Svc) {
 	mgr.wg.Add(1)
+	mgr.sup.started(svc)
 	log.Noticef("%s is running", svc.name())
 }
 
 // finished signals to the manager that the calling service
 // has been terminated and is no longer running.
 func (mgr *ServiceManager) finished(svc Svc) {
+	mgr.sup.finished(svc)
 	mgr.wg.Done()
 	log.Noticef("%s terminated", svc.name())
 }
 
+// failed signals to the manager that the calling service
+// has encountered an error preventing it from functioning.
+// A service which could not start is restarted by the manager.
+func (mgr *ServiceManager) failed(svc Svc, err error) {
+	mgr.sup.failed(svc, err)
+	log.Errorf("%s failed; %s", svc.name(), err.Error())
+}
+
+// progress signals to the manager that the calling service made a progress.
+func (mgr *ServiceManager) progress(svc Svc) {
+	mgr.sup.progress(svc)
+}
+
+// idle signals to the manager that the calling service switched from, or to idle state.
+func (mgr *ServiceManager) idle(svc Svc, idle bool) {
+	mgr.sup.idle(svc, idle)
+}
+
+// Status provides the status of all the managed services.
+func (mgr *ServiceManager) Status() []ServiceStatus {
+	return mgr.sup.status(mgr.svc)
+}
+
+// Pause stops the service of the given name until it's resumed.
+// Services of the processing pipeline depend on each other, so the whole pipeline is paused
+// with any of them. It returns the names of all the services paused.
+func (mgr *ServiceManager) Pause(name string) ([]string, error) {
+	s := mgr.service(name)
+	if s == nil {
+		return nil, fmt.Errorf("unknown service %s", name)
+	}
+
+	group, err := mgr.sup.pause(s)
+	if err != nil {
+		return nil, err
+	}
+
+	names := serviceNames(group)
+	log.Noticef("%s paused", strings.Join(names, ", "))
+	return names, nil
+}
+
+// Resume starts the paused service of the given name again.
+// The services paused together are resumed together. It returns the names of all the services resumed.
+func (mgr *ServiceManager) Resume(name string) ([]string, error) {
+	s := mgr.service(name)
+	if s == nil {
+		return nil, fmt.Errorf("unknown service %s", name)
+	}
+
+	group, err := mgr.sup.resume(s)
+	if err != nil {
+		return nil, err
+	}
+
+	names := serviceNames(group)
+	log.Noticef("%s resumed", strings.Join(names, ", "))
+	return names, nil
+}
+
+// serviceNames provides the names of the given services.
+func serviceNames(list []Svc) []string {
+	names := make([]string, len(list))
+	for i, s := range list {
+		names[i] = s.name()
+	}
+	return names
+}
+
+// Rescan requests the block scanner to re-process blocks of the given range.
+// The scanner continues from its current position once the range is done.
+func (mgr *ServiceManager) Rescan(from, to uint64) error {
+	if from > to {
+		return fmt.Errorf("invalid block range <#%d, #%d>", from, to)
+	}
+	if !mgr.sup.active(mgr.bls) {
+		return fmt.Errorf("%s is not running", mgr.bls.name())
+	}
+
+	select {
+	case mgr.bls.inRescan <- blsRescan{from: from, to: to}:
+		log.Noticef("re-scan of blocks <#%d, #%d> requested", from, to)
+		return nil
+	default:
+		return fmt.Errorf("another re-scan request is pending")
+	}
+}
+
+// ReplayDeadLetter processes the failed record of the given identifier again.
+// It returns TRUE if the record has been resolved.
+func (mgr *ServiceManager) ReplayDeadLetter(id string) (bool, error) {
+	dl, err := repo.DeadLetter(id)
+	if err != nil {
+		return false, err
+	}
+	return mgr.dlr.replay(dl), nil
+}
+
+// service finds the managed service by its name.
+func (mgr *ServiceManager) service(name string) Svc {
+	for _, s := range mgr.svc {
+		if s.name() == name {
+			return s
+		}
+	}
+	return nil
+}
+
 // BlockHeight provides identifier of the top known block.
 func (mgr *ServiceManager) BlockHeight() uint64 {
 	if mgr.bls == nil {
@@ -0,0 +1,16 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import "fantom-api-graphql/internal/metrics"
+
+// registerMetrics registers depth gauges of the queues between the services.
+// The queues are expected to be empty, or close to empty most of the time;
+// a growing queue signals the consumer can not keep up with the producer.
+func (mgr *ServiceManager) registerMetrics() {
+	metrics.RegisterQueue("scanner.outBlock", func() int { return len(mgr.bls.outBlock) })
+	metrics.RegisterQueue("blocks.outTransaction", func() int { return len(mgr.bld.outTransaction) })
+	metrics.RegisterQueue("blocks.outBatch", func() int { return len(mgr.bld.outBatch) })
+	metrics.RegisterQueue("transactions.outTransaction", func() int { return len(mgr.trd.outTransaction) })
+	metrics.RegisterQueue("transactions.outLog", func() int { return len(mgr.trd.outLog) })
+	metrics.RegisterQueue("transactions.outAccount", func() int { return len(mgr.trd.outAccount) })
+}
@@ -38,6 +38,7 @@ func (or *orchestrator) init() {
 	or.mgr.bld.inBlock = or.mgr.bls.outBlock
 	or.mgr.bls.inDispatched = or.mgr.bld.outDispatched
 	or.mgr.bud.inTransaction = or.mgr.trd.outTransaction
+	or.mgr.blc.inBatch = or.mgr.bld.outBatch
 	or.inScanStateSwitch = or.mgr.bls.outStateSwitch
 
 	// read initial block scanner state
@@ -78,11 +79,14 @@ func (or *orchestrator) execute() {
 				or.handleNewHead(h)
 			}
 		case idle, ok := <-or.inScanStateSwitch:
-			if ok {
-				or.pushHeads = idle
-				if idle {
-					or.unloadCache()
-				}
+			if !ok {
+				// the scanner is gone; stop reading the closed channel
+				or.inScanStateSwitch = nil
+				continue
+			}
+			or.pushHeads = idle
+			if idle {
+				or.unloadCache()
 			}
 		}
 	}
@@ -92,8 +96,11 @@ func (or *orchestrator) execute() {
 // to the state of the block scanner by either pushing the corresponding block
 // to dispatcher queue, or by putting the block to the local ring cache for future use.
 func (or *orchestrator) handleNewHead(h *etc.Header) {
-	// get the block
+	// the head moves the finality of the processed blocks
 	bn := h.Number.Uint64()
+	repo.UpdateHeadBlock(bn)
+
+	// get the block
 	blk, err := repo.BlockByNumber((*hexutil.Uint64)(&bn))
 	if err != nil {
 		log.Errorf("block #%d not available; %s", bn, err.Error())
@@ -102,7 +109,11 @@ func (or *orchestrator) handleNewHead(h *etc.Header) {
 
 	// if the block scanner is on idle, push the block directly to processing queue
 	if or.pushHeads {
-		or.mgr.bld.inBlock <- blk
+		select {
+		case or.mgr.bld.inBlock <- blk:
+			or.mgr.progress(or)
+		case <-or.sigStop:
+		}
 		return
 	}
 
@@ -127,6 +138,10 @@ func (or *orchestrator) unloadCache() {
 	// push them all to dispatcher for processing
 	for _, blk := range l {
 		log.Infof("cached block #%d sent for processing", (*types.Block)(blk).Number)
-		or.mgr.bld.inBlock <- (*types.Block)(blk)
+		select {
+		case or.mgr.bld.inBlock <- (*types.Block)(blk):
+		case <-or.sigStop:
+			return
+		}
 	}
 }
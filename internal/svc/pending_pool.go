@@ -0,0 +1,245 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"container/list"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"sync"
+	"time"
+)
+
+const (
+	// ptpCapacity represents the max number of pending transactions kept in the pool.
+	// The oldest transactions are dropped from the pool if the capacity is reached.
+	ptpCapacity = 10000
+
+	// ptpPruneTickDuration represents the frequency of the pool pruning.
+	ptpPruneTickDuration = 30 * time.Second
+
+	// ptpPruneAge represents the age of a pending transaction before it's checked
+	// for being mined, or dropped by the node.
+	ptpPruneAge = time.Minute
+
+	// ptpPruneBatchSize represents the max number of pending transactions checked on a single prune.
+	ptpPruneBatchSize = 500
+)
+
+// pendingTrx represents a transaction in the pending pool.
+type pendingTrx struct {
+	trx  *types.Transaction
+	seen time.Time
+}
+
+// pendingPool implements a service observing pending transactions announced to the connected node.
+// The pending transactions are kept in a bounded in-memory pool until they are mined, or dropped.
+type pendingPool struct {
+	service
+	mu        sync.RWMutex
+	list      *list.List
+	index     map[common.Hash]*list.Element
+	onPending chan *types.Transaction
+}
+
+// name returns the name of the service used by orchestrator.
+func (ptp *pendingPool) name() string {
+	return "pending pool observer"
+}
+
+// init prepares the pending pool observer.
+func (ptp *pendingPool) init() {
+	ptp.sigStop = make(chan struct{})
+
+	ptp.mu.Lock()
+	ptp.list = list.New()
+	ptp.index = make(map[common.Hash]*list.Element)
+	ptp.mu.Unlock()
+}
+
+// run starts the pending pool observer.
+func (ptp *pendingPool) run() {
+	// make sure we are orchestrated
+	if ptp.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", ptp.name()))
+	}
+
+	// signal orchestrator we started and go
+	ptp.mgr.started(ptp)
+	go ptp.execute()
+}
+
+// execute collects announced pending transactions and prunes the pool periodically.
+func (ptp *pendingPool) execute() {
+	tick := time.NewTicker(ptpPruneTickDuration)
+	defer func() {
+		tick.Stop()
+		ptp.mgr.finished(ptp)
+	}()
+
+	pending := repo.ObservedPendingTransactions()
+	for {
+		select {
+		case <-ptp.sigStop:
+			return
+		case <-tick.C:
+			ptp.prune()
+		case hash, ok := <-pending:
+			if !ok {
+				log.Noticef("pending transactions channel closed, terminating %s", ptp.name())
+				return
+			}
+			ptp.add(hash)
+			ptp.mgr.progress(ptp)
+		}
+	}
+}
+
+// add loads the pending transaction of the given hash and adds it to the pool.
+func (ptp *pendingPool) add(hash common.Hash) {
+	ptp.mu.RLock()
+	_, known := ptp.index[hash]
+	ptp.mu.RUnlock()
+	if known {
+		return
+	}
+
+	trx, err := repo.LoadTransaction(&hash)
+	if err != nil {
+		log.Debugf("pending transaction %s not available; %s", hash.String(), err.Error())
+		return
+	}
+
+	// the transaction may have been mined, or dropped already
+	if trx.BlockNumber != nil || trx.Hash != hash {
+		return
+	}
+
+	ptp.mu.Lock()
+	ptp.index[hash] = ptp.list.PushFront(&pendingTrx{trx: trx, seen: time.Now()})
+	for ptp.list.Len() > ptpCapacity {
+		ptp.drop(ptp.list.Back())
+	}
+	ptp.mu.Unlock()
+
+	// broadcast the pending transaction; if it can not be broadcast quickly, skip
+	if ptp.onPending == nil {
+		return
+	}
+	select {
+	case ptp.onPending <- trx:
+	case <-time.After(200 * time.Millisecond):
+	case <-ptp.sigStop:
+	}
+}
+
+// mined removes the transaction of the given hash from the pool, if it's there.
+func (ptp *pendingPool) mined(hash *common.Hash) {
+	ptp.mu.Lock()
+	defer ptp.mu.Unlock()
+
+	if el, ok := ptp.index[*hash]; ok {
+		ptp.drop(el)
+	}
+}
+
+// drop removes the given element from the pool; the pool must be locked.
+func (ptp *pendingPool) drop(el *list.Element) {
+	delete(ptp.index, el.Value.(*pendingTrx).trx.Hash)
+	ptp.list.Remove(el)
+}
+
+// prune checks the oldest pending transactions against the node
+// and removes those mined, or dropped by the node.
+func (ptp *pendingPool) prune() {
+	ptp.mu.RLock()
+	check := make([]common.Hash, 0)
+	for el := ptp.list.Back(); el != nil && len(check) < ptpPruneBatchSize; el = el.Prev() {
+		pt := el.Value.(*pendingTrx)
+		if time.Since(pt.seen) < ptpPruneAge {
+			break
+		}
+		check = append(check, pt.trx.Hash)
+	}
+	ptp.mu.RUnlock()
+
+	var pruned int
+	for i := range check {
+		select {
+		case <-ptp.sigStop:
+			return
+		default:
+		}
+
+		trx, err := repo.LoadTransaction(&check[i])
+		if err != nil {
+			continue
+		}
+
+		// mined, or unknown to the node
+		if trx.BlockNumber != nil || trx.Hash != check[i] {
+			ptp.mined(&check[i])
+			pruned++
+		}
+	}
+
+	if pruned > 0 {
+		log.Debugf("%d transactions pruned from the pending pool", pruned)
+	}
+}
+
+// transactions provides a list of pending transactions sent from, and/or to the given addresses.
+// The list is ordered from the newest pending transaction to the oldest one. For positive count,
+// the list continues after the cursor, for negative count the list ends before the cursor.
+func (ptp *pendingPool) transactions(from *common.Address, to *common.Address, cursor *common.Hash, count int32) *types.TransactionList {
+	ptp.mu.RLock()
+	all := make([]*types.Transaction, 0)
+	for el := ptp.list.Front(); el != nil; el = el.Next() {
+		trx := el.Value.(*pendingTrx).trx
+		if from != nil && trx.From != *from {
+			continue
+		}
+		if to != nil && (trx.To == nil || *trx.To != *to) {
+			continue
+		}
+		all = append(all, trx)
+	}
+	ptp.mu.RUnlock()
+
+	// find the range of the list
+	lo, hi := 0, len(all)
+	if cursor != nil {
+		pos := -1
+		for i, trx := range all {
+			if trx.Hash == *cursor {
+				pos = i
+				break
+			}
+		}
+
+		// the cursor transaction is not pending anymore
+		if pos < 0 {
+			return &types.TransactionList{Collection: make([]*types.Transaction, 0), Total: uint64(len(all)), IsStart: count < 0, IsEnd: count > 0}
+		}
+
+		if count > 0 {
+			lo = pos + 1
+		} else {
+			hi = pos
+		}
+	}
+
+	if count > 0 && lo+int(count) < hi {
+		hi = lo + int(count)
+	}
+	if count < 0 && hi+int(count) > lo {
+		lo = hi + int(count)
+	}
+
+	return &types.TransactionList{
+		Collection: all[lo:hi],
+		Total:      uint64(len(all)),
+		IsStart:    lo == 0,
+		IsEnd:      hi == len(all),
+	}
+}
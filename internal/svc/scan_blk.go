@@ -3,6 +3,7 @@ package svc
 
 import (
 	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/metrics"
 	"fantom-api-graphql/internal/types"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -30,6 +31,19 @@ const blsBlockBufferCapacity = 1000
 // blsReScanHysteresis is the number of blocks we wait from dispatcher until a re-scan kicks in.
 const blsReScanHysteresis = 100
 
+// blsFetchAheadRatio represents the number of blocks per fetch worker the scanner may request
+// ahead of the next block to be pushed for processing.
+const blsFetchAheadRatio = 4
+
+// blsFetchRetryDelay represents the delay before a failed block fetch is repeated.
+const blsFetchRetryDelay = 2 * time.Second
+
+// blsRescan represents a request to re-scan a range of blocks.
+type blsRescan struct {
+	from uint64
+	to   uint64
+}
+
 // blkScanner implements scanner loading previous/unknown blockchain blocks.
 type blkScanner struct {
 	service
@@ -37,13 +51,22 @@ type blkScanner struct {
 	outBlock       chan *types.Block
 	outStateSwitch chan bool
 	inDispatched   chan uint64
+	inRescan       chan blsRescan
 	observeTick    *time.Ticker
 	scanTick       *time.Ticker
+	fetchQueue     chan uint64
+	fetchResult    chan *types.Block
+	fetched        map[uint64]*types.Block
 	onIdle         bool
+	start          uint64
 	from           uint64
 	next           uint64
+	ahead          uint64
 	to             uint64
 	done           uint64
+	rescanTo       uint64
+	resume         uint64
+	rescanning     bool
 }
 
 // name returns the name of the service used by orchestrator.
@@ -54,9 +77,20 @@ func (bls *blkScanner) name() string {
 // init prepares the block scanner.
 func (bls *blkScanner) init() {
 	bls.onIdle = false
+	bls.rescanning = false
+	bls.inRescan = make(chan blsRescan, 1)
+	atomic.StoreUint64(&bls.done, 0)
 	bls.sigStop = make(chan struct{})
 	bls.outStateSwitch = make(chan bool, 1)
 	bls.outBlock = make(chan *types.Block, blsBlockBufferCapacity)
+
+	// prep the fetch pipeline
+	if bls.cfg.BlockScanWorkers < 1 {
+		bls.cfg.BlockScanWorkers = 1
+	}
+	bls.fetchQueue = make(chan uint64, bls.cfg.BlockScanWorkers*blsFetchAheadRatio)
+	bls.fetchResult = make(chan *types.Block, bls.cfg.BlockScanWorkers*blsFetchAheadRatio)
+	bls.fetched = make(map[uint64]*types.Block, bls.cfg.BlockScanWorkers*blsFetchAheadRatio)
 }
 
 // run starts the block dispatcher
@@ -71,14 +105,15 @@ func (bls *blkScanner) run() {
 	// get the scanner range
 	start, err := bls.boundaries()
 	if err != nil {
-		log.Errorf("scanner can not proceed; %s", err.Error())
+		bls.mgr.failed(bls, fmt.Errorf("scanner can not proceed; %s", err.Error()))
 		return
 	}
 
 	// signal orchestrator we started and go
 	log.Noticef("block scan starts at #%d", start)
+	bls.start = start
 	bls.from = start
-	bls.next = start
+	bls.rewind(start)
 
 	bls.mgr.started(bls)
 	go bls.execute()
@@ -126,17 +161,36 @@ func (bls *blkScanner) execute() {
 	bls.observeTick = time.NewTicker(blsObserverTickBaseDuration)
 	bls.scanTick = time.NewTicker(blsScanTickBaseDuration)
 
+	// start block fetch workers
+	for i := 0; i < bls.cfg.BlockScanWorkers; i++ {
+		go bls.fetch()
+	}
+
 	// do the scan
 	for {
 		select {
 		case <-bls.sigStop:
 			return
 		case bin, ok := <-bls.inDispatched:
+			// the dispatcher is gone; stop reading the closed channel
+			if !ok {
+				bls.inDispatched = nil
+				continue
+			}
+
 			// ignore block re-scans; do not skip blocks in dispatched # counter
 			done := atomic.LoadUint64(&bls.done)
-			if ok && (done == 0 || int64(bin)-int64(done) == 1) {
+			if done == 0 || int64(bin)-int64(done) == 1 {
 				atomic.StoreUint64(&bls.done, bin)
 			}
+		case req := <-bls.inRescan:
+			bls.rescan(req)
+		case blk := <-bls.fetchResult:
+			// keep the block until it's its turn to be processed
+			if uint64(blk.Number) >= bls.next {
+				bls.fetched[uint64(blk.Number)] = blk
+			}
+			bls.flush()
 		case <-bls.observeTick.C:
 			bls.updateState(bls.observe())
 		case <-bls.scanTick.C:
@@ -161,8 +215,10 @@ func (bls *blkScanner) observe() bool {
 	target := bh.ToInt().Uint64()
 	done := atomic.LoadUint64(&bls.done)
 
+	metrics.ObserveScanner(bls.next, target, done)
+
 	if bls.onIdle && target < done+blsReScanHysteresis {
-		bls.next = done
+		bls.rewind(done)
 		bls.from = done
 		log.Infof("block scanner idling at #%d, head at #%d", bls.next, target)
 		return true
@@ -185,6 +241,7 @@ func (bls *blkScanner) updateState(target bool) {
 	// switch the state; advertise the transition
 	log.Noticef("block scanner idle state toggled to %t", target)
 	bls.onIdle = target
+	bls.mgr.idle(bls, target)
 
 	select {
 	case bls.outStateSwitch <- target:
@@ -204,7 +261,8 @@ func (bls *blkScanner) updateState(target bool) {
 	bls.scanTick.Reset(blsScanTickIdleDuration)
 }
 
-// next pulls the next block if available and pushes it for processing.
+// shift requests blocks to be fetched ahead of the scanner position
+// and pushes already fetched blocks for processing.
 func (bls *blkScanner) shift() {
 	// we may not need to pull at all, if on updateState
 	if bls.onIdle {
@@ -217,19 +275,115 @@ func (bls *blkScanner) shift() {
 		return
 	}
 
-	// pull the current block
-	block, err := repo.BlockByNumber((*hexutil.Uint64)(&bls.next))
-	if err != nil {
-		log.Errorf("block #%d not available; %s", bls.next, err.Error())
-		return
+	// request more blocks, but do not go too far ahead of the processing
+	for bls.ahead <= bls.to && bls.ahead-bls.next < uint64(cap(bls.fetchQueue)) {
+		select {
+		case bls.fetchQueue <- bls.ahead:
+			bls.ahead++
+		default:
+			bls.flush()
+			return
+		}
 	}
+	bls.flush()
+}
 
-	// push the block for processing and advance to the next expected block
-	// observe possible stop signal during a wait for the block queue slot
-	select {
-	case bls.outBlock <- block:
-		bls.next++
-	case <-bls.sigStop:
+// flush pushes fetched blocks for processing strictly in the block order.
+// The push does not wait for the block queue slot so the dispatched blocks counter
+// is never blocked; the rest of the blocks is pushed on the next scan tick.
+func (bls *blkScanner) flush() {
+	for {
+		block, ok := bls.fetched[bls.next]
+		if !ok {
+			return
+		}
+
+		select {
+		case bls.outBlock <- block:
+			delete(bls.fetched, bls.next)
+			bls.next++
+			bls.mgr.progress(bls)
+
+			// return to the regular scan once a re-scan range is done
+			if bls.rescanning && bls.next > bls.rescanTo {
+				log.Noticef("block scanner re-scan done at #%d, resuming at #%d", bls.rescanTo, bls.resume)
+				bls.rescanning = false
+				bls.rewind(bls.resume)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// rescan moves the scanner back to re-process the requested range of blocks.
+// The current position is kept, so the regular scan can resume once the range is done.
+func (bls *blkScanner) rescan(req blsRescan) {
+	if !bls.rescanning {
+		bls.resume = bls.next
+	}
+
+	// the range may reach the regular scan position; just continue from there
+	bls.rescanning = req.to+1 < bls.resume
+	bls.rescanTo = req.to
+	bls.rewind(req.from)
+
+	log.Noticef("block scanner re-scanning <#%d, #%d>, resuming at #%d", req.from, req.to, bls.resume)
+	bls.updateState(false)
+}
+
+// rewind moves the scanner to the given block number dropping all the blocks fetched ahead.
+func (bls *blkScanner) rewind(num uint64) {
+	bls.next = num
+	bls.ahead = num
+	for k := range bls.fetched {
+		delete(bls.fetched, k)
+	}
+}
+
+// fetch pulls blocks requested by the scanner and sends them back.
+// Transactions of the block are pre-loaded, so they are available
+// in the cache when the block is dispatched.
+func (bls *blkScanner) fetch() {
+	for {
+		select {
+		case <-bls.sigStop:
+			return
+		case num := <-bls.fetchQueue:
+			block := bls.pull(num)
+			if block == nil {
+				return
+			}
+
+			select {
+			case bls.fetchResult <- block:
+			case <-bls.sigStop:
+				return
+			}
+		}
+	}
+}
+
+// pull loads the block of the given number and its transactions.
+// Failed loads are repeated until the block is available, or the scanner is terminated.
+func (bls *blkScanner) pull(num uint64) *types.Block {
+	for {
+		block, err := repo.BlockByNumber((*hexutil.Uint64)(&num))
+		if err == nil {
+			for _, th := range block.Txs {
+				if _, err := repo.Transaction(th); err != nil {
+					log.Errorf("transaction %s of block #%d not available; %s", th.String(), num, err.Error())
+				}
+			}
+			return block
+		}
+
+		log.Errorf("block #%d not available; %s", num, err.Error())
+		select {
+		case <-time.After(blsFetchRetryDelay):
+		case <-bls.sigStop:
+			return nil
+		}
 	}
 }
 
@@ -94,6 +94,7 @@ func (eps *epochScanner) execute() {
 			return
 		case <-eps.scanTick.C:
 			eps.next()
+			eps.mgr.progress(eps)
 		case <-eps.observeTick.C:
 			if eps.top != nil && uint64(eps.top.Id) > eps.current {
 				log.Noticef("epoch scan at #%d of #%d", eps.current, eps.top.Id)
@@ -114,6 +114,7 @@ func (gps *gpsMonitor) execute() {
 			return
 		case <-gps.monTicker.C:
 			gps.read()
+			gps.mgr.progress(gps)
 		case <-gps.flipTicker.C:
 			gps.flip()
 		case <-gps.logTicker.C:
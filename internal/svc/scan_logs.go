@@ -0,0 +1,254 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	retypes "github.com/ethereum/go-ethereum/core/types"
+	"strings"
+	"sync"
+	"time"
+)
+
+// lbfRangeSize represents the number of blocks covered by a single logs filter query.
+const lbfRangeSize = 2000
+
+// lbfScanTickDuration represents the frequency of the back-fill progress.
+const lbfScanTickDuration = 100 * time.Millisecond
+
+// lbfJob represents a range of blocks to be back-filled for a log handler family.
+// The job is done when the checkpoint reaches the blocks processed by the block pipeline.
+type lbfJob struct {
+	family *logHandlerFamily
+	cp     types.Checkpoint
+}
+
+// logBackfill implements a service replaying historical logs for log handler families
+// lagging behind the block scanner. Only logs of the topics handled by the family are loaded
+// from the node, blocks are not re-dispatched. The checkpoint of the family is updated with each
+// log processed, so an interrupted back-fill does not repeat the handlers.
+type logBackfill struct {
+	service
+	families []logHandlerFamily
+	pending  []*lbfJob
+	scanTick *time.Ticker
+}
+
+// name returns the name of the service used by orchestrator.
+func (lbf *logBackfill) name() string {
+	return "log back-fill"
+}
+
+// run starts the log back-fill service.
+func (lbf *logBackfill) run() {
+	// make sure we are orchestrated
+	if lbf.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", lbf.name()))
+	}
+	if lbf.mgr.lgd == nil || lbf.mgr.bls == nil {
+		panic(fmt.Errorf("no log dispatcher or block scanner available"))
+	}
+
+	// find the families to be back-filled
+	lbf.families = lbf.mgr.lgd.families
+	if err := lbf.prepare(); err != nil {
+		lbf.mgr.failed(lbf, fmt.Errorf("log back-fill can not proceed; %s", err.Error()))
+		return
+	}
+
+	// signal orchestrator we started and go
+	lbf.mgr.started(lbf)
+	go lbf.execute()
+}
+
+// prepare collects the checkpoints of the log handler families
+// and builds the list of back-fill jobs.
+func (lbf *logBackfill) prepare() error {
+	lnb, err := repo.LastKnownBlock()
+	if err != nil {
+		return err
+	}
+
+	cps, err := repo.Checkpoints()
+	if err != nil {
+		return err
+	}
+
+	// the first block processed by the block pipeline; the genesis block has no logs
+	live := lbf.mgr.bls.start
+	if live == 0 {
+		live = 1
+	}
+
+	// families explicitly requested to be back-filled from the start
+	forced := make(map[string]bool)
+	for _, name := range strings.Split(cfg.RepoCommand.Backfill, ",") {
+		forced[strings.TrimSpace(name)] = true
+	}
+
+	lbf.pending = make([]*lbfJob, 0)
+	known := make(map[string]bool, len(lbf.families))
+	for i := range lbf.families {
+		fam := &lbf.families[i]
+		cp, ok := cps[fam.name]
+		known[fam.name] = true
+
+		switch {
+		case forced[fam.name]:
+			cp = types.Checkpoint{Live: live}
+		case !ok && len(cps) == 0:
+			// no checkpoints at all; existing families are expected to be in sync with the block pipeline
+			cp = types.Checkpoint{Next: live, Live: live}
+		case !ok:
+			// a new family we have never seen before
+			cp = types.Checkpoint{Live: live}
+		case cp.Live == 0 || cp.Live > live:
+			// the family has been processed by the block pipeline without interruption since the cp.Live
+			cp.Live = live
+		}
+
+		if err := repo.UpdateCheckpoint(fam.name, &cp); err != nil {
+			return err
+		}
+		if !cp.Synced() {
+			lbf.pending = append(lbf.pending, &lbfJob{family: fam, cp: cp})
+		}
+	}
+
+	for _, job := range lbf.pending {
+		log.Noticef("log handlers %s will be back-filled in <#%d, #%d>", job.family.name, job.cp.Next, job.cp.Live-1)
+	}
+	return lbf.detach(cps, known, lnb)
+}
+
+// detach stops the checkpoints of the families no longer processed by the block pipeline
+// at the last block processed, so they are back-filled from there if they are processed again.
+func (lbf *logBackfill) detach(cps map[string]types.Checkpoint, known map[string]bool, lnb uint64) error {
+	for name, cp := range cps {
+		if known[name] || cp.Live == 0 {
+			continue
+		}
+
+		if cp.Synced() {
+			cp.Next, cp.Log = lnb+1, 0
+		}
+		cp.Live = 0
+
+		log.Noticef("log handlers %s not processed anymore, checkpoint stopped at #%d", name, cp.Next)
+		if err := repo.UpdateCheckpoint(name, &cp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close terminates the log back-fill service.
+func (lbf *logBackfill) close() {
+	if lbf.scanTick != nil {
+		lbf.scanTick.Stop()
+	}
+	if lbf.sigStop != nil {
+		close(lbf.sigStop)
+	}
+}
+
+// execute replays the logs of the pending back-fill jobs.
+func (lbf *logBackfill) execute() {
+	lbf.scanTick = time.NewTicker(lbfScanTickDuration)
+	defer func() {
+		lbf.mgr.finished(lbf)
+	}()
+
+	for {
+		select {
+		case <-lbf.sigStop:
+			return
+		case <-lbf.scanTick.C:
+			lbf.step()
+		}
+	}
+}
+
+// step processes the next range of blocks of the first pending back-fill job.
+// Logs processed already by an interrupted step are skipped.
+func (lbf *logBackfill) step() {
+	if len(lbf.pending) == 0 {
+		lbf.scanTick.Stop()
+		return
+	}
+
+	job := lbf.pending[0]
+	from := job.cp.Next
+	to := from + lbfRangeSize - 1
+	if to >= job.cp.Live {
+		to = job.cp.Live - 1
+	}
+
+	// load the logs of the family topics
+	list, err := repo.FilterLogs(from, to, job.family.topics())
+	if err != nil {
+		log.Errorf("can not back-fill %s logs in <#%d, #%d>; %s", job.family.name, from, to, err.Error())
+		return
+	}
+
+	for _, lg := range list {
+		if job.cp.Done(lg.BlockNumber, lg.Index) {
+			continue
+		}
+		if !lbf.replay(job.family, lg) {
+			return
+		}
+
+		job.cp.Next, job.cp.Log = lg.BlockNumber, lg.Index+1
+		if err := repo.UpdateCheckpoint(job.family.name, &job.cp); err != nil {
+			return
+		}
+	}
+
+	job.cp.Next, job.cp.Log = to+1, 0
+	if err := repo.UpdateCheckpoint(job.family.name, &job.cp); err != nil {
+		return
+	}
+	lbf.mgr.progress(lbf)
+	log.Infof("log handlers %s back-filled to #%d of #%d, %d logs processed", job.family.name, to, job.cp.Live-1, len(list))
+
+	// is the job done?
+	if job.cp.Synced() {
+		log.Noticef("log handlers %s back-fill done", job.family.name)
+		lbf.pending = lbf.pending[1:]
+	}
+}
+
+// replay passes the given log to its handler in the family.
+func (lbf *logBackfill) replay(fam *logHandlerFamily, lg retypes.Log) bool {
+	if lg.Removed || len(lg.Topics) == 0 {
+		return true
+	}
+
+	handler, ok := fam.handlers[lg.Topics[0]]
+	if !ok {
+		return true
+	}
+
+	blk, err := repo.BlockByNumber((*hexutil.Uint64)(&lg.BlockNumber))
+	if err != nil {
+		log.Errorf("block #%d of log not available; %s", lg.BlockNumber, err.Error())
+		return false
+	}
+
+	trx, err := repo.Transaction(&lg.TxHash)
+	if err != nil {
+		log.Errorf("transaction %s of log not available; %s", lg.TxHash.String(), err.Error())
+		return false
+	}
+	trx.TimeStamp = time.Unix(int64(blk.TimeStamp), 0)
+
+	handler(&types.LogRecord{
+		WatchDog: new(sync.WaitGroup),
+		Block:    blk,
+		Trx:      trx,
+		Log:      lg,
+	})
+	return true
+}
@@ -59,6 +59,7 @@ func (sti *stiScanner) execute() {
 			if sti.next() {
 				scanTick.Reset(stiScannerTickDurationSlow)
 			}
+			sti.mgr.progress(sti)
 		}
 	}
 }
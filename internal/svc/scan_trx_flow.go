@@ -74,6 +74,7 @@ func (tfm *trxFlowMonitor) execute() {
 			return
 		case <-flowTicker.C:
 			repo.TrxFlowUpdate()
+			tfm.mgr.progress(tfm)
 		case <-burnTicker.C:
 			repo.BurnDailyUpdate()
 		case <-countTicker.C:
@@ -0,0 +1,403 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// svcRestartBaseDelay represents the delay before the first restart of a failed service.
+// The delay doubles with each subsequent failure of the service up to svcRestartMaxDelay.
+const svcRestartBaseDelay = 1 * time.Second
+
+// svcRestartMaxDelay represents the longest delay between restarts of a failing service.
+const svcRestartMaxDelay = 2 * time.Minute
+
+// svcRestartResetPeriod represents the period a restarted service has to run
+// without failure to have its restart back-off reset.
+const svcRestartResetPeriod = 5 * time.Minute
+
+// svcStopTimeout represents the time we wait for a service to terminate on restart.
+const svcStopTimeout = 30 * time.Second
+
+// svcFailedQueueCapacity represents the capacity of the failed services queue.
+const svcFailedQueueCapacity = 64
+
+// states of the managed services
+const (
+	SvcStateStopped    = "stopped"
+	SvcStateRunning    = "running"
+	SvcStateIdle       = "idle"
+	SvcStateFailed     = "failed"
+	SvcStateRestarting = "restarting"
+	SvcStatePaused     = "paused"
+)
+
+// ServiceStatus represents the status of a managed service.
+type ServiceStatus struct {
+	Name         string    `json:"name"`
+	State        string    `json:"state"`
+	LastError    string    `json:"lastError,omitempty"`
+	LastProgress time.Time `json:"lastProgress"`
+	Since        time.Time `json:"since"`
+	Restarts     int       `json:"restarts"`
+}
+
+// svcState represents the supervised state of a managed service.
+type svcState struct {
+	ServiceStatus
+	threads  int
+	failures int
+	stopped  chan struct{}
+}
+
+// supervisor keeps track of the managed services state and restarts failed services.
+// Services connected by the processing pipeline (scanner, dispatchers and the orchestrator)
+// depend on each other's channels, so they are always restarted together as a group.
+type supervisor struct {
+	mu       sync.Mutex
+	states   map[Svc]*svcState
+	groups   map[Svc][]Svc
+	closing  bool
+	sigStop  chan struct{}
+	sigFail  chan Svc
+	restarts sync.WaitGroup
+}
+
+// newSupervisor creates a new services supervisor.
+func newSupervisor() *supervisor {
+	return &supervisor{
+		states:  make(map[Svc]*svcState),
+		groups:  make(map[Svc][]Svc),
+		sigStop: make(chan struct{}),
+		sigFail: make(chan Svc, svcFailedQueueCapacity),
+	}
+}
+
+// add registers a group of services to be supervised and restarted together.
+func (sup *supervisor) add(group ...Svc) {
+	sup.mu.Lock()
+	defer sup.mu.Unlock()
+
+	for _, s := range group {
+		sup.states[s] = &svcState{ServiceStatus: ServiceStatus{Name: s.name(), State: SvcStateStopped}}
+		sup.groups[s] = group
+	}
+}
+
+// state provides the state of the given service; the caller is expected to hold the lock.
+func (sup *supervisor) state(s Svc) *svcState {
+	st, ok := sup.states[s]
+	if !ok {
+		// unknown services are supervised on their own
+		st = &svcState{ServiceStatus: ServiceStatus{Name: s.name(), State: SvcStateStopped}}
+		sup.states[s] = st
+		sup.groups[s] = []Svc{s}
+	}
+	return st
+}
+
+// started marks a new thread of the given service running.
+func (sup *supervisor) started(s Svc) {
+	sup.mu.Lock()
+	defer sup.mu.Unlock()
+
+	st := sup.state(s)
+	if st.threads == 0 {
+		st.stopped = make(chan struct{})
+		st.Since = time.Now()
+		st.LastProgress = st.Since
+	}
+	st.threads++
+	st.State = SvcStateRunning
+}
+
+// finished marks a thread of the given service terminated. A service terminated
+// without being asked to is considered failed and scheduled for a restart.
+func (sup *supervisor) finished(s Svc) {
+	sup.mu.Lock()
+	defer sup.mu.Unlock()
+
+	st := sup.state(s)
+	if st.threads > 0 {
+		st.threads--
+	}
+	if st.threads > 0 {
+		return
+	}
+	if st.stopped != nil {
+		close(st.stopped)
+		st.stopped = nil
+	}
+
+	switch {
+	case st.State == SvcStateRestarting:
+		// the restart is already on its way
+	case st.State == SvcStatePaused:
+		// the service has been paused on purpose
+	case sup.closing:
+		st.State = SvcStateStopped
+	default:
+		if st.LastError == "" {
+			st.LastError = "terminated unexpectedly"
+		}
+		sup.fail(s, st)
+	}
+}
+
+// failed records an error of the given service. If the service is not running,
+// i.e. it could not start at all, it's scheduled for a restart.
+func (sup *supervisor) failed(s Svc, err error) {
+	sup.mu.Lock()
+	defer sup.mu.Unlock()
+
+	st := sup.state(s)
+	st.LastError = err.Error()
+	if st.threads == 0 && st.State != SvcStateRestarting && st.State != SvcStatePaused && !sup.closing {
+		sup.fail(s, st)
+	}
+}
+
+// fail marks the service failed and signals the supervisor loop; the caller is expected to hold the lock.
+func (sup *supervisor) fail(s Svc, st *svcState) {
+	if time.Since(st.Since) > svcRestartResetPeriod {
+		st.failures = 0
+	}
+	st.failures++
+	st.State = SvcStateFailed
+
+	select {
+	case sup.sigFail <- s:
+	default:
+		log.Criticalf("%s failed and can not be scheduled for restart", s.name())
+	}
+}
+
+// progress marks the given service making progress.
+func (sup *supervisor) progress(s Svc) {
+	sup.mu.Lock()
+	sup.state(s).LastProgress = time.Now()
+	sup.mu.Unlock()
+}
+
+// idle switches the given running service between idle and running state.
+func (sup *supervisor) idle(s Svc, idle bool) {
+	sup.mu.Lock()
+	defer sup.mu.Unlock()
+
+	st := sup.state(s)
+	if st.State != SvcStateRunning && st.State != SvcStateIdle {
+		return
+	}
+
+	st.State = SvcStateRunning
+	if idle {
+		st.State = SvcStateIdle
+	}
+}
+
+// active checks if the given service is running, or idle.
+func (sup *supervisor) active(s Svc) bool {
+	sup.mu.Lock()
+	defer sup.mu.Unlock()
+
+	st := sup.state(s)
+	return st.State == SvcStateRunning || st.State == SvcStateIdle
+}
+
+// status provides the list of the supervised services status in the order of the given services.
+func (sup *supervisor) status(list []Svc) []ServiceStatus {
+	sup.mu.Lock()
+	defer sup.mu.Unlock()
+
+	res := make([]ServiceStatus, 0, len(list))
+	for _, s := range list {
+		res = append(res, sup.state(s).ServiceStatus)
+	}
+	return res
+}
+
+// run starts the supervisor loop.
+func (sup *supervisor) run() {
+	go sup.execute()
+}
+
+// close signals the supervisor to terminate and waits for pending restarts to finish.
+func (sup *supervisor) close() {
+	sup.mu.Lock()
+	sup.closing = true
+	sup.mu.Unlock()
+
+	close(sup.sigStop)
+	sup.restarts.Wait()
+}
+
+// execute waits for failed services and schedules their restart.
+func (sup *supervisor) execute() {
+	for {
+		select {
+		case <-sup.sigStop:
+			return
+		case s := <-sup.sigFail:
+			sup.schedule(s)
+		}
+	}
+}
+
+// schedule plans the restart of the group of the given failed service with a back-off delay.
+func (sup *supervisor) schedule(s Svc) {
+	sup.mu.Lock()
+	defer sup.mu.Unlock()
+
+	// the service may have already been scheduled with its group
+	if sup.closing || sup.state(s).State != SvcStateFailed {
+		return
+	}
+
+	group := sup.groups[s]
+	for _, gs := range group {
+		sup.state(gs).State = SvcStateRestarting
+	}
+
+	delay := svcRestartBaseDelay << uint(sup.state(s).failures-1)
+	if delay > svcRestartMaxDelay || delay <= 0 {
+		delay = svcRestartMaxDelay
+	}
+
+	log.Errorf("%s failed; %s; restarting in %s", s.name(), sup.state(s).LastError, delay)
+	sup.restarts.Add(1)
+	go sup.restart(group, delay)
+}
+
+// restart stops the given group of services and starts them again after the given delay.
+func (sup *supervisor) restart(group []Svc, delay time.Duration) {
+	defer sup.restarts.Done()
+
+	select {
+	case <-sup.sigStop:
+		return
+	case <-time.After(delay):
+	}
+
+	// stop services of the group still running and init them again
+	sup.stop(group)
+
+	// we may have been asked to terminate in the meantime
+	sup.mu.Lock()
+	if sup.closing {
+		sup.mu.Unlock()
+		return
+	}
+	for _, s := range group {
+		st := sup.state(s)
+		st.Restarts++
+		st.State = SvcStateStopped
+	}
+	sup.mu.Unlock()
+
+	for _, s := range group {
+		log.Noticef("restarting %s", s.name())
+		sup.start(s)
+	}
+}
+
+// stop terminates services of the given group still running and waits for them to finish.
+// The services are initialized again, so they are ready to be started, or closed.
+func (sup *supervisor) stop(group []Svc) {
+	for _, s := range group {
+		sup.mu.Lock()
+		stopped := sup.state(s).stopped
+		sup.mu.Unlock()
+
+		if stopped == nil {
+			continue
+		}
+
+		log.Noticef("stopping %s", s.name())
+		s.close()
+		select {
+		case <-stopped:
+		case <-time.After(svcStopTimeout):
+			log.Criticalf("%s did not stop in time", s.name())
+		}
+	}
+
+	// init the group again, the order of services is kept
+	for _, s := range group {
+		s.init()
+	}
+}
+
+// pause stops the group of the given service and keeps it stopped until resumed.
+// It returns the group of services paused.
+func (sup *supervisor) pause(s Svc) ([]Svc, error) {
+	sup.mu.Lock()
+	if sup.closing {
+		sup.mu.Unlock()
+		return nil, fmt.Errorf("services are closing")
+	}
+
+	group := sup.group(s)
+	for _, gs := range group {
+		switch sup.state(gs).State {
+		case SvcStatePaused:
+			sup.mu.Unlock()
+			return nil, fmt.Errorf("%s is already paused", gs.name())
+		case SvcStateRestarting:
+			sup.mu.Unlock()
+			return nil, fmt.Errorf("%s is being restarted", gs.name())
+		}
+	}
+	for _, gs := range group {
+		sup.state(gs).State = SvcStatePaused
+	}
+	sup.mu.Unlock()
+
+	sup.stop(group)
+	return group, nil
+}
+
+// resume starts the paused group of the given service again.
+// It returns the group of services resumed.
+func (sup *supervisor) resume(s Svc) ([]Svc, error) {
+	sup.mu.Lock()
+	if sup.closing {
+		sup.mu.Unlock()
+		return nil, fmt.Errorf("services are closing")
+	}
+
+	group := sup.group(s)
+	if sup.state(s).State != SvcStatePaused {
+		sup.mu.Unlock()
+		return nil, fmt.Errorf("%s is not paused", s.name())
+	}
+	for _, gs := range group {
+		st := sup.state(gs)
+		st.State = SvcStateStopped
+		st.failures = 0
+	}
+	sup.mu.Unlock()
+
+	for _, gs := range group {
+		sup.start(gs)
+	}
+	return group, nil
+}
+
+// group provides the group of services supervised together with the given service;
+// the caller is expected to hold the lock.
+func (sup *supervisor) group(s Svc) []Svc {
+	sup.state(s)
+	return sup.groups[s]
+}
+
+// start runs the given service, a panic on start is recorded as the service failure.
+func (sup *supervisor) start(s Svc) {
+	defer func() {
+		if r := recover(); r != nil {
+			sup.failed(s, fmt.Errorf("start failed; %v", r))
+		}
+	}()
+	s.run()
+}
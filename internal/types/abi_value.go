@@ -0,0 +1,136 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// AbiValueString provides the canonical string form of a value decoded by ABI.
+// Numbers are formatted as decimal, addresses, hashes and byte arrays are hex encoded.
+// Composite values are JSON encoded.
+func AbiValueString(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case bool:
+		return strconv.FormatBool(val)
+	case *big.Int:
+		return val.String()
+	case common.Address:
+		return val.String()
+	case common.Hash:
+		return val.String()
+	case []byte:
+		return hexutil.Encode(val)
+	case uint8, uint16, uint32, uint64, int8, int16, int32, int64:
+		return fmt.Sprintf("%d", val)
+	}
+
+	// fixed size byte arrays
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		b := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(b), rv)
+		return hexutil.Encode(b)
+	}
+
+	// anything else is encoded as JSON
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+// AbiJsonValue provides the JSON friendly form of a value decoded by ABI.
+// Numbers are provided as decimal strings so no precision is lost, addresses, hashes
+// and byte arrays are hex encoded. Lists keep their structure and tuples are provided
+// as objects keyed by the names of their components.
+func AbiJsonValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case nil, string, bool:
+		return val
+	case *big.Int, common.Address, common.Hash, []byte, uint8, uint16, uint32, uint64, int8, int16, int32, int64:
+		return AbiValueString(val)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil
+		}
+		return AbiJsonValue(rv.Elem().Interface())
+	case reflect.Array, reflect.Slice:
+		// fixed size byte arrays
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return AbiValueString(v)
+		}
+
+		list := make([]interface{}, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			list[i] = AbiJsonValue(rv.Index(i).Interface())
+		}
+		return list
+	case reflect.Struct:
+		// tuple components keep their ABI name in the JSON tag
+		obj := make(map[string]interface{}, rv.NumField())
+		for i := 0; i < rv.NumField(); i++ {
+			fi := rv.Type().Field(i)
+			name := fi.Tag.Get("json")
+			if name == "" {
+				name = fi.Name
+			}
+			obj[name] = AbiJsonValue(rv.Field(i).Interface())
+		}
+		return obj
+	}
+	return AbiValueString(v)
+}
+
+// DecodeAbiArguments decodes values of the given ABI arguments from the indexed topics
+// and the data. The topics must not include the event signature.
+func DecodeAbiArguments(args abi.Arguments, topics []common.Hash, data []byte) ([]ContractEventArg, error) {
+	values := make(map[string]interface{}, len(args))
+
+	// make sure all the arguments have a name we can use to pick the value
+	named := make(abi.Arguments, len(args))
+	indexed := make(abi.Arguments, 0, len(args))
+	for i, arg := range args {
+		named[i] = arg
+		if named[i].Name == "" {
+			named[i].Name = fmt.Sprintf("arg%d", i)
+		}
+		if arg.Indexed {
+			indexed = append(indexed, named[i])
+		}
+	}
+
+	// decode indexed arguments from topics and the rest from data
+	if err := abi.ParseTopicsIntoMap(values, indexed, topics); err != nil {
+		return nil, err
+	}
+	if err := named.UnpackIntoMap(values, data); err != nil {
+		return nil, err
+	}
+
+	list := make([]ContractEventArg, len(named))
+	for i, arg := range named {
+		list[i] = ContractEventArg{
+			Name:    arg.Name,
+			Type:    arg.Type.String(),
+			Value:   AbiValueString(values[arg.Name]),
+			Indexed: arg.Indexed,
+		}
+	}
+	return list, nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"math/big"
 	"time"
 )
@@ -29,47 +30,48 @@ type FtmBurn struct {
 }
 
 // FtmDailyBurn represents a burn of native tokens by days.
+// The amounts are exact sums in WEI units.
 type FtmDailyBurn struct {
-	TickDate       time.Time `bson:"_id"`
-	BlocksCount    int32     `bson:"blocks_count"`
-	BurnedAmount   int64     `bson:"burn_amount"`
-	TreasuryAmount int64     `bson:"treasury_amount"`
-	RewardsAmount  int64     `bson:"rewards_amount"`
-	FeeAmount      int64     `bson:"fee_amount"`
+	TickDate       time.Time            `bson:"_id"`
+	BlocksCount    int32                `bson:"blocks_count"`
+	BurnedAmount   primitive.Decimal128 `bson:"burn_amount"`
+	TreasuryAmount primitive.Decimal128 `bson:"treasury_amount"`
+	RewardsAmount  primitive.Decimal128 `bson:"rewards_amount"`
+	FeeAmount      primitive.Decimal128 `bson:"fee_amount"`
 }
 
 // MarshalBSON returns a BSON document for the FTM burn.
 func (burn *FtmBurn) MarshalBSON() ([]byte, error) {
-	amount := new(big.Int).Div(burn.BurnAmount.ToInt(), BurnDecimalsCorrection)
-	fee := new(big.Int).Div(burn.FeeAmount.ToInt(), BurnDecimalsCorrection)
-	treasury := new(big.Int).Div(burn.TreasuryAmount.ToInt(), BurnDecimalsCorrection)
-	rewards := new(big.Int).Div(burn.RewardsAmount.ToInt(), BurnDecimalsCorrection)
-
+	var dec decimalRow
 	row := struct {
-		Block          int64     `bson:"block"`
-		TimeStamp      time.Time `bson:"ts"`
-		Value          string    `bson:"value"`
-		Amount         int64     `bson:"amount"`
-		FeeValue       string    `bson:"fee_value"`
-		FeeAmount      int64     `bson:"fee_amount"`
-		TreasuryValue  string    `bson:"try_value"`
-		TreasuryAmount int64     `bson:"try_amount"`
-		RewardsValue   string    `bson:"rew_value"`
-		RewardsAmount  int64     `bson:"rew_amount"`
-		TxList         []string  `bson:"tx_list"`
+		Block          int64                `bson:"block"`
+		TimeStamp      time.Time            `bson:"ts"`
+		Value          string               `bson:"value"`
+		Amount         primitive.Decimal128 `bson:"amount"`
+		FeeValue       string               `bson:"fee_value"`
+		FeeAmount      primitive.Decimal128 `bson:"fee_amount"`
+		TreasuryValue  string               `bson:"try_value"`
+		TreasuryAmount primitive.Decimal128 `bson:"try_amount"`
+		RewardsValue   string               `bson:"rew_value"`
+		RewardsAmount  primitive.Decimal128 `bson:"rew_amount"`
+		TxList         []string             `bson:"tx_list"`
 	}{
 		Block:          int64(burn.BlockNumber),
 		TimeStamp:      burn.BlkTimeStamp,
 		Value:          burn.BurnAmount.String(),
-		Amount:         amount.Int64(),
+		Amount:         dec.of(burn.BurnAmount.ToInt()),
 		FeeValue:       burn.FeeAmount.String(),
-		FeeAmount:      fee.Int64(),
+		FeeAmount:      dec.of(burn.FeeAmount.ToInt()),
 		TreasuryValue:  burn.TreasuryAmount.String(),
-		TreasuryAmount: treasury.Int64(),
+		TreasuryAmount: dec.of(burn.TreasuryAmount.ToInt()),
 		RewardsValue:   burn.RewardsAmount.String(),
-		RewardsAmount:  rewards.Int64(),
+		RewardsAmount:  dec.of(burn.RewardsAmount.ToInt()),
 		TxList:         make([]string, len(burn.TxList)),
 	}
+	if dec.err != nil {
+		return nil, dec.err
+	}
+
 	for i, v := range burn.TxList {
 		row.TxList[i] = v.String()
 	}
@@ -117,9 +119,9 @@ func (burn *FtmBurn) FtmValue() float64 {
 	return float64(new(big.Int).Div(burn.BurnAmount.ToInt(), BurnDecimalsCorrection).Int64()) / BurnFTMDecimalsCorrection
 }
 
-// Value returns FTM amount of burned tokens.
-func (burn *FtmBurn) Value() int64 {
-	return new(big.Int).Div(burn.BurnAmount.ToInt(), BurnDecimalsCorrection).Int64()
+// Value returns the exact amount of burned tokens in WEI encoded as BSON Decimal128.
+func (burn *FtmBurn) Value() (primitive.Decimal128, error) {
+	return DecimalFromBig(burn.BurnAmount.ToInt())
 }
 
 // Amount returns amount of burned tokens in WEI.
@@ -0,0 +1,42 @@
+// Package types implements different core types of the API.
+package types
+
+import "encoding/json"
+
+// Checkpoint represents the ingestion progress of a log handler family.
+// Logs of blocks before the Next block, and logs of the Next block with index lower than the Log,
+// have been processed. Blocks starting with the Live block are processed by the block pipeline.
+type Checkpoint struct {
+	// Next represents the number of the next block to be processed.
+	Next uint64 `json:"next"`
+
+	// Log represents the index of the next log of the Next block to be processed.
+	Log uint `json:"log"`
+
+	// Live represents the number of the first block processed by the block pipeline;
+	// zero if not known.
+	Live uint64 `json:"live"`
+}
+
+// Synced checks if the checkpoint reached the blocks processed by the block pipeline.
+func (cp *Checkpoint) Synced() bool {
+	return cp.Live > 0 && cp.Next >= cp.Live
+}
+
+// Done checks if the log of the given block and index has been processed already.
+func (cp *Checkpoint) Done(blk uint64, index uint) bool {
+	return blk < cp.Next || (blk == cp.Next && index < cp.Log)
+}
+
+// UnmarshalJSON decodes the checkpoint from JSON. Checkpoints of earlier versions
+// are represented by the number of the last block processed.
+func (cp *Checkpoint) UnmarshalJSON(data []byte) error {
+	var blk uint64
+	if err := json.Unmarshal(data, &blk); err == nil {
+		*cp = Checkpoint{Next: blk + 1}
+		return nil
+	}
+
+	type checkpoint Checkpoint
+	return json.Unmarshal(data, (*checkpoint)(cp))
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"encoding/json"
+	"github.com/onsi/gomega"
+	"testing"
+)
+
+func TestCheckpointDone(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	cp := Checkpoint{Next: 100, Log: 3, Live: 200}
+	g.Expect(cp.Done(99, 10)).To(gomega.BeTrue())
+	g.Expect(cp.Done(100, 2)).To(gomega.BeTrue())
+	g.Expect(cp.Done(100, 3)).To(gomega.BeFalse())
+	g.Expect(cp.Done(101, 0)).To(gomega.BeFalse())
+	g.Expect(cp.Synced()).To(gomega.BeFalse())
+
+	cp.Next, cp.Log = 200, 0
+	g.Expect(cp.Synced()).To(gomega.BeTrue())
+
+	// the block pipeline start is not known
+	cp.Live = 0
+	g.Expect(cp.Synced()).To(gomega.BeFalse())
+}
+
+func TestCheckpointUnmarshal(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	var cps map[string]Checkpoint
+	g.Expect(json.Unmarshal([]byte(`{"sfc": 150, "erc": {"next": 10, "log": 2, "live": 20}}`), &cps)).To(gomega.Succeed())
+	g.Expect(cps["sfc"]).To(gomega.Equal(Checkpoint{Next: 151}))
+	g.Expect(cps["erc"]).To(gomega.Equal(Checkpoint{Next: 10, Log: 2, Live: 20}))
+}
@@ -0,0 +1,121 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"time"
+)
+
+const (
+	FiContractEventPk        = "_id"
+	FiContractEventOrdinal   = "orx"
+	FiContractEventAddress   = "addr"
+	FiContractEventName      = "event"
+	FiContractEventTopic     = "topic"
+	FiContractEventTrx       = "trx"
+	FiContractEventArgs      = "args"
+	FiContractEventArgName   = "n"
+	FiContractEventArgValue  = "v"
+	FiContractEventTimeStamp = "stamp"
+)
+
+// ContractEvent represents a decoded event emitted by a contract
+// indexed by the custom contract events indexer.
+type ContractEvent struct {
+	Address     common.Address
+	Event       string
+	Signature   string
+	Topic       common.Hash
+	BlockNumber hexutil.Uint64
+	Transaction common.Hash
+	LogIndex    hexutil.Uint
+	TimeStamp   time.Time
+	Args        []ContractEventArg
+}
+
+// ContractEventArg represents a single decoded argument of a contract event.
+// The value is kept in its canonical string form; numbers are decimal,
+// addresses, hashes and byte arrays are hex encoded.
+type ContractEventArg struct {
+	Name    string `bson:"n"`
+	Type    string `bson:"t"`
+	Value   string `bson:"v"`
+	Indexed bool   `bson:"i"`
+}
+
+// BsonContractEvent represents the BSON i/o struct for a decoded contract event.
+type BsonContractEvent struct {
+	ID        string             `bson:"_id"`
+	Orx       uint64             `bson:"orx"`
+	Address   string             `bson:"addr"`
+	Event     string             `bson:"event"`
+	Signature string             `bson:"sig"`
+	Topic     string             `bson:"topic"`
+	Block     uint64             `bson:"blk"`
+	Trx       string             `bson:"trx"`
+	LogIndex  uint64             `bson:"lix"`
+	Stamp     time.Time          `bson:"stamp"`
+	Args      []ContractEventArg `bson:"args"`
+}
+
+// Pk generates unique identifier of the contract event from the block number and the log index.
+func (ce *ContractEvent) Pk() string {
+	bytes := make([]byte, 12)
+	binary.BigEndian.PutUint64(bytes[0:8], uint64(ce.BlockNumber))
+	binary.BigEndian.PutUint32(bytes[8:12], uint32(ce.LogIndex))
+	return hexutil.Encode(bytes)
+}
+
+// OrdinalIndex returns an ordinal index for the given contract event.
+// The index is built from the block number and the index of the log in the block.
+func (ce *ContractEvent) OrdinalIndex() uint64 {
+	return (uint64(ce.BlockNumber)&0xFFFFFFFFFFF)<<20 | (uint64(ce.LogIndex) & 0xFFFFF)
+}
+
+// MarshalBSON creates a BSON representation of the contract event record.
+func (ce *ContractEvent) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(BsonContractEvent{
+		ID:        ce.Pk(),
+		Orx:       ce.OrdinalIndex(),
+		Address:   ce.Address.String(),
+		Event:     ce.Event,
+		Signature: ce.Signature,
+		Topic:     ce.Topic.String(),
+		Block:     uint64(ce.BlockNumber),
+		Trx:       ce.Transaction.String(),
+		LogIndex:  uint64(ce.LogIndex),
+		Stamp:     ce.TimeStamp,
+		Args:      ce.Args,
+	})
+}
+
+// UnmarshalBSON updates the value from BSON source.
+func (ce *ContractEvent) UnmarshalBSON(data []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("can not decode contract event")
+		}
+	}()
+
+	// try to decode the BSON data
+	var row BsonContractEvent
+	if err = bson.Unmarshal(data, &row); err != nil {
+		return err
+	}
+
+	// copy the data
+	ce.Address = common.HexToAddress(row.Address)
+	ce.Event = row.Event
+	ce.Signature = row.Signature
+	ce.Topic = common.HexToHash(row.Topic)
+	ce.BlockNumber = hexutil.Uint64(row.Block)
+	ce.Transaction = common.HexToHash(row.Trx)
+	ce.LogIndex = hexutil.Uint(row.LogIndex)
+	ce.TimeStamp = row.Stamp
+	ce.Args = row.Args
+	return nil
+}
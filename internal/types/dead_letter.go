@@ -0,0 +1,63 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"time"
+)
+
+const (
+	// DeadLetterTypeLog represents a failed processing of a transaction log.
+	DeadLetterTypeLog = "log"
+
+	// DeadLetterTypeTrx represents a failed processing of a transaction.
+	DeadLetterTypeTrx = "trx"
+)
+
+// DeadLetter represents a record of a failed processing of a transaction,
+// or a transaction log kept for the processing to be retried.
+type DeadLetter struct {
+	// ID represents the unique identifier of the record.
+	ID string `json:"id"`
+
+	// Type represents the type of the failed record, a log or a transaction.
+	Type string `json:"type"`
+
+	// Handler represents the name of the handler which failed to process the record.
+	Handler string `json:"handler"`
+
+	// BlockNumber represents the number of the block of the failed record.
+	BlockNumber hexutil.Uint64 `json:"block"`
+
+	// Transaction represents the hash of the transaction of the failed record.
+	Transaction common.Hash `json:"trx"`
+
+	// LogIndex represents the index of the failed log in the block; nil for transactions.
+	LogIndex *hexutil.Uint `json:"logIndex,omitempty"`
+
+	// Error represents the error of the last failed attempt.
+	Error string `json:"error"`
+
+	// Attempts represents the number of failed attempts to process the record.
+	Attempts int32 `json:"attempts"`
+
+	// Created represents the time of the first failure.
+	Created time.Time `json:"created"`
+
+	// Failed represents the time of the last failure.
+	Failed time.Time `json:"failed"`
+
+	// NextRetry represents the time of the next planned retry.
+	NextRetry time.Time `json:"nextRetry"`
+}
+
+// Pk generates unique identifier of the dead letter record
+// from the failed record identification and the name of the handler.
+func (dl *DeadLetter) Pk() string {
+	if dl.LogIndex == nil {
+		return fmt.Sprintf("%s-%s-%s", dl.Type, dl.Transaction.String(), dl.Handler)
+	}
+	return fmt.Sprintf("%s-%s-%d-%s", dl.Type, dl.Transaction.String(), uint(*dl.LogIndex), dl.Handler)
+}
@@ -0,0 +1,105 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"math/big"
+)
+
+// decimalMaxDigits represents the max number of significant digits of a Decimal128 value.
+const decimalMaxDigits = 34
+
+// decimalTen is used to shift big integers by decimal digits.
+var decimalTen = big.NewInt(10)
+
+// DecimalZero represents a zero amount encoded as BSON Decimal128.
+var DecimalZero, _ = primitive.ParseDecimal128FromBigInt(new(big.Int), 0)
+
+// ErrDecimalOverflow signals an amount which can not be represented by Decimal128 exactly.
+var ErrDecimalOverflow = fmt.Errorf("amount exceeds %d decimal digits", decimalMaxDigits)
+
+// DecimalFromBig converts the given amount into a BSON Decimal128 value so the database
+// can sum and compare amounts exactly. Amounts up to 34 decimal digits are converted exactly.
+// Larger amounts are truncated to 34 significant digits and the ErrDecimalOverflow is returned
+// along with the truncated value; the caller has to keep the exact amount if it needs it.
+func DecimalFromBig(v *big.Int) (primitive.Decimal128, error) {
+	if v == nil {
+		return DecimalZero, nil
+	}
+
+	exp := len(new(big.Int).Abs(v).String()) - decimalMaxDigits
+	if exp > 0 {
+		v = new(big.Int).Quo(v, new(big.Int).Exp(decimalTen, big.NewInt(int64(exp)), nil))
+	} else {
+		exp = 0
+	}
+
+	d, ok := primitive.ParseDecimal128FromBigInt(v, exp)
+	if !ok {
+		return primitive.Decimal128{}, fmt.Errorf("amount %s can not be converted to decimal", v.String())
+	}
+	if exp > 0 {
+		return d, ErrDecimalOverflow
+	}
+	return d, nil
+}
+
+// DecimalFromHex converts the given hex encoded amount into a BSON Decimal128 value.
+// Amounts which do not fit are handled the same way as by DecimalFromBig.
+func DecimalFromHex(s string) (primitive.Decimal128, error) {
+	v, err := hexutil.DecodeBig(s)
+	if err != nil {
+		return primitive.Decimal128{}, err
+	}
+	return DecimalFromBig(v)
+}
+
+// decimalRow converts amounts of a BSON row into Decimal128 values. Rows keep the exact amounts
+// along with the Decimal128 values; amounts which can not be converted exactly are refused.
+type decimalRow struct {
+	err error
+}
+
+// of converts the given amount into a Decimal128 value; the first failed conversion is kept.
+func (dr *decimalRow) of(v *big.Int) primitive.Decimal128 {
+	d, err := DecimalFromBig(v)
+	if err != nil && dr.err == nil {
+		dr.err = fmt.Errorf("invalid amount %s; %s", v.String(), err.Error())
+	}
+	return d
+}
+
+// BigFromDecimal converts the given BSON Decimal128 value into an integer amount.
+// Fractional digits of the value, if any, are truncated.
+func BigFromDecimal(d primitive.Decimal128) (*big.Int, error) {
+	v, exp, err := d.BigInt()
+	if err != nil {
+		return nil, fmt.Errorf("invalid decimal %s; %s", d.String(), err.Error())
+	}
+
+	switch {
+	case exp > 0:
+		v.Mul(v, new(big.Int).Exp(decimalTen, big.NewInt(int64(exp)), nil))
+	case exp < 0:
+		v.Quo(v, new(big.Int).Exp(decimalTen, big.NewInt(int64(-exp)), nil))
+	}
+	return v, nil
+}
+
+// BigFromDecimalValue converts the given BSON value of an aggregated amount into an integer amount.
+// The database provides sums over no Decimal128 values as integer zero.
+func BigFromDecimalValue(v bson.RawValue) (*big.Int, error) {
+	switch v.Type {
+	case bsontype.Decimal128:
+		return BigFromDecimal(v.Decimal128())
+	case bsontype.Int32, bsontype.Int64:
+		return big.NewInt(v.AsInt64()), nil
+	case bsontype.Null, bsontype.Undefined:
+		return new(big.Int), nil
+	}
+	return nil, fmt.Errorf("invalid amount type %s", v.Type.String())
+}
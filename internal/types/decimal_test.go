@@ -0,0 +1,80 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+	"math/big"
+	"testing"
+)
+
+func TestDecimalFromBig(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// 12345.678901234567890123 FTM in WEI, too large for the former int64 encoding
+	wei, _ := new(big.Int).SetString("12345678901234567890123", 10)
+	for _, v := range []*big.Int{new(big.Int), big.NewInt(1), wei, new(big.Int).Neg(wei)} {
+		d, err := DecimalFromBig(v)
+		g.Expect(err).To(gomega.BeNil())
+		g.Expect(d.String()).To(gomega.Equal(v.String()))
+
+		back, err := BigFromDecimal(d)
+		g.Expect(err).To(gomega.BeNil())
+		g.Expect(back).To(gomega.Equal(v))
+	}
+
+	// amounts beyond 34 digits keep the 34 most significant digits and report the overflow
+	large, _ := new(big.Int).SetString("123456789012345678901234567890123456789", 10)
+	d, err := DecimalFromBig(large)
+	g.Expect(err).To(gomega.Equal(ErrDecimalOverflow))
+
+	back, err := BigFromDecimal(d)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(back.String()).To(gomega.Equal("123456789012345678901234567890123400000"))
+
+	_, err = DecimalFromHex("0x" + large.Text(16))
+	g.Expect(err).To(gomega.Equal(ErrDecimalOverflow))
+}
+
+func TestDecimalRowOverflow(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// rows keep the exact amounts; amounts which can not be stored exactly are refused
+	rwc := RewardClaim{Amount: hexutil.Big(*big.NewInt(1_000_000_000_000_000_000))}
+	_, err := bson.Marshal(&rwc)
+	g.Expect(err).To(gomega.BeNil())
+
+	large, _ := new(big.Int).SetString("123456789012345678901234567890123456789", 10)
+	rwc.Amount = hexutil.Big(*large)
+	_, err = bson.Marshal(&rwc)
+	g.Expect(err).NotTo(gomega.BeNil())
+	g.Expect(err.Error()).To(gomega.ContainSubstring(large.String()))
+}
+
+func TestBigFromDecimalValue(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	d, err := DecimalFromHex("0xde0b6b3a7640000")
+	g.Expect(err).To(gomega.BeNil())
+
+	for _, tc := range []struct {
+		val  interface{}
+		want *big.Int
+	}{
+		{val: d, want: big.NewInt(1_000_000_000_000_000_000)},
+		{val: int32(0), want: new(big.Int)},
+		{val: nil, want: new(big.Int)},
+	} {
+		raw, err := bson.Marshal(bson.D{{Key: "total", Value: tc.val}})
+		g.Expect(err).To(gomega.BeNil())
+
+		v, err := BigFromDecimalValue(bson.Raw(raw).Lookup("total"))
+		g.Expect(err).To(gomega.BeNil())
+		g.Expect(v.Cmp(tc.want)).To(gomega.Equal(0))
+	}
+
+	raw, err := bson.Marshal(bson.D{{Key: "total", Value: "0x1"}})
+	g.Expect(err).To(gomega.BeNil())
+	_, err = BigFromDecimalValue(bson.Raw(raw).Lookup("total"))
+	g.Expect(err).NotTo(gomega.BeNil())
+}
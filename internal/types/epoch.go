@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"go.mongodb.org/mongo-driver/bson"
-	"math/big"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"time"
 )
 
@@ -26,19 +26,19 @@ type Epoch struct {
 
 // BsonEpoch represents the epoch data structure for BSON formatting.
 type BsonEpoch struct {
-	ID                  int64     `bson:"_id"`
-	EndTime             int64     `bson:"et"`
-	End                 time.Time `bson:"end"`
-	Fee                 string    `bson:"fee"`
-	FeeBurn             string    `bson:"feb"`
-	FeeTreasury         string    `bson:"fet"`
-	BaseRewardWeight    string    `bson:"brw"`
-	TrxRewardWeight     string    `bson:"trw"`
-	BaseRewardPerSecond string    `bson:"rew"`
-	TotalStake          string    `bson:"stake"`
-	TotalSupply         string    `bson:"supply"`
-	Burned              int64     `bson:"burned"`
-	Treasured           int64     `bson:"treasured"`
+	ID                  int64                `bson:"_id"`
+	EndTime             int64                `bson:"et"`
+	End                 time.Time            `bson:"end"`
+	Fee                 string               `bson:"fee"`
+	FeeBurn             string               `bson:"feb"`
+	FeeTreasury         string               `bson:"fet"`
+	BaseRewardWeight    string               `bson:"brw"`
+	TrxRewardWeight     string               `bson:"trw"`
+	BaseRewardPerSecond string               `bson:"rew"`
+	TotalStake          string               `bson:"stake"`
+	TotalSupply         string               `bson:"supply"`
+	Burned              primitive.Decimal128 `bson:"burned"`
+	Treasured           primitive.Decimal128 `bson:"treasured"`
 }
 
 // UnmarshalEpoch parses the JSON-encoded Epoch data.
@@ -55,11 +55,9 @@ func (e *Epoch) Marshal() ([]byte, error) {
 
 // MarshalBSON creates a BSON representation of the Epoch record.
 func (e *Epoch) MarshalBSON() ([]byte, error) {
-	amBurned := new(big.Int).Div(e.EpochFeeBurn.ToInt(), BurnDecimalsCorrection)
-	amTreasured := new(big.Int).Div(e.EpochFeeTreasury.ToInt(), BurnDecimalsCorrection)
-
 	// prep the structure for saving
-	return bson.Marshal(BsonEpoch{
+	var dec decimalRow
+	row := BsonEpoch{
 		ID:                  int64(e.Id),
 		EndTime:             int64(e.EndTime),
 		End:                 time.Unix(int64(e.EndTime), 0),
@@ -71,9 +69,13 @@ func (e *Epoch) MarshalBSON() ([]byte, error) {
 		BaseRewardPerSecond: e.BaseRewardPerSecond.String(),
 		TotalStake:          e.StakeTotalAmount.String(),
 		TotalSupply:         e.TotalSupply.String(),
-		Burned:              amBurned.Int64(),
-		Treasured:           amTreasured.Int64(),
-	})
+		Burned:              dec.of(e.EpochFeeBurn.ToInt()),
+		Treasured:           dec.of(e.EpochFeeTreasury.ToInt()),
+	}
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	return bson.Marshal(row)
 }
 
 // UnmarshalBSON updates the value from BSON source.
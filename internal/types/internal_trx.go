@@ -0,0 +1,238 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	FiInternalTrxPk        = "_id"
+	FiInternalTrxOrdinal   = "orx"
+	FiInternalTrxParent    = "trx"
+	FiInternalTrxBlock     = "blk"
+	FiInternalTrxPosition  = "pos"
+	FiInternalTrxFrom      = "from"
+	FiInternalTrxTo        = "to"
+	FiInternalTrxTimeStamp = "stamp"
+)
+
+// CallFrame represents a single call frame of the call tracer output.
+// The top level frame represents the transaction itself, internal calls
+// made by the executed contracts are nested in the Calls list.
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+}
+
+// InternalTransaction represents a call made by a contract during execution
+// of a transaction, e.g. a value transfer from a contract, a contract creation,
+// or a self-destruct of a contract.
+type InternalTransaction struct {
+	// Transaction represents the hash of the parent transaction.
+	Transaction common.Hash
+
+	// BlockNumber represents the number of the block of the parent transaction.
+	BlockNumber hexutil.Uint64
+
+	// TrxIndex represents the index of the parent transaction in the block.
+	TrxIndex hexutil.Uint64
+
+	// Position represents the position of the call in the flattened call tree.
+	Position int32
+
+	// TraceAddress represents the path to the call in the call tree.
+	TraceAddress []int32
+
+	// Type represents the type of the call, i.e. CALL, DELEGATECALL, CREATE, SELFDESTRUCT.
+	Type string
+
+	// From represents the address of the caller.
+	From common.Address
+
+	// To represents the address of the callee, or the created contract.
+	To *common.Address
+
+	// Value represents the value transferred by the call in WEI.
+	Value hexutil.Big
+
+	// Gas represents the gas provided to the call.
+	Gas hexutil.Uint64
+
+	// GasUsed represents the gas used by the call.
+	GasUsed hexutil.Uint64
+
+	// Input represents the input data of the call; large inputs are not stored.
+	Input hexutil.Bytes
+
+	// Error represents the error of the call execution, if any.
+	Error *string
+
+	// TimeStamp represents the time stamp of the parent transaction.
+	TimeStamp time.Time
+}
+
+// BsonInternalTransaction represents the BSON i/o struct for an internal transaction.
+type BsonInternalTransaction struct {
+	ID       string    `bson:"_id"`
+	Orx      uint64    `bson:"orx"`
+	Trx      string    `bson:"trx"`
+	Block    uint64    `bson:"blk"`
+	TrxIndex uint64    `bson:"tix"`
+	Position int32     `bson:"pos"`
+	Trace    []int32   `bson:"trace"`
+	Type     string    `bson:"type"`
+	From     string    `bson:"from"`
+	To       *string   `bson:"to"`
+	Value    string    `bson:"value"`
+	Gas      uint64    `bson:"gas"`
+	GasUsed  uint64    `bson:"gas_use"`
+	Input    []byte    `bson:"input"`
+	Error    *string   `bson:"err"`
+	Stamp    time.Time `bson:"stamp"`
+}
+
+// InternalTransactions flattens the call tree of the given transaction into a list of internal transactions.
+// The top level frame represents the transaction itself and is not included. Static calls are skipped
+// since they can not change the state and transfer value.
+func InternalTransactions(trx *Transaction, root *CallFrame) []*InternalTransaction {
+	list := make([]*InternalTransaction, 0)
+	if root == nil || trx.BlockNumber == nil || trx.Index == nil {
+		return list
+	}
+
+	var walk func(cf *CallFrame, trace []int32)
+	walk = func(cf *CallFrame, trace []int32) {
+		for i := range cf.Calls {
+			sub := &cf.Calls[i]
+			path := append(append(make([]int32, 0, len(trace)+1), trace...), int32(i))
+
+			if sub.Type != "STATICCALL" {
+				list = append(list, newInternalTransaction(trx, sub, path, int32(len(list))))
+			}
+			walk(sub, path)
+		}
+	}
+	walk(root, []int32{})
+	return list
+}
+
+// newInternalTransaction creates an internal transaction from the given call frame.
+func newInternalTransaction(trx *Transaction, cf *CallFrame, trace []int32, pos int32) *InternalTransaction {
+	itx := InternalTransaction{
+		Transaction:  trx.Hash,
+		BlockNumber:  *trx.BlockNumber,
+		TrxIndex:     *trx.Index,
+		Position:     pos,
+		TraceAddress: trace,
+		Type:         strings.ToUpper(cf.Type),
+		From:         cf.From,
+		To:           cf.To,
+		Gas:          cf.Gas,
+		GasUsed:      cf.GasUsed,
+		TimeStamp:    trx.TimeStamp,
+	}
+
+	if cf.Value != nil {
+		itx.Value = *cf.Value
+	}
+	if len(cf.Input) <= trxLargeInputWall {
+		itx.Input = cf.Input
+	}
+	if cf.Error != "" {
+		er := cf.Error
+		itx.Error = &er
+	}
+	return &itx
+}
+
+// Pk generates unique identifier of the internal transaction
+// from the parent transaction hash and the position in the call tree.
+func (itx *InternalTransaction) Pk() string {
+	return fmt.Sprintf("%s-%d", itx.Transaction.String(), itx.Position)
+}
+
+// OrdinalIndex returns an ordinal index for the given internal transaction.
+// The index is built from the ordinal of the parent transaction and the position in the call tree.
+func (itx *InternalTransaction) OrdinalIndex() uint64 {
+	return ((uint64(itx.BlockNumber)<<14)|(uint64(itx.TrxIndex)&0x3fff))<<16 | (uint64(itx.Position) & 0xffff)
+}
+
+// MarshalBSON creates a BSON representation of the internal transaction record.
+func (itx *InternalTransaction) MarshalBSON() ([]byte, error) {
+	row := BsonInternalTransaction{
+		ID:       itx.Pk(),
+		Orx:      itx.OrdinalIndex(),
+		Trx:      itx.Transaction.String(),
+		Block:    uint64(itx.BlockNumber),
+		TrxIndex: uint64(itx.TrxIndex),
+		Position: itx.Position,
+		Trace:    itx.TraceAddress,
+		Type:     itx.Type,
+		From:     itx.From.String(),
+		Value:    itx.Value.String(),
+		Gas:      uint64(itx.Gas),
+		GasUsed:  uint64(itx.GasUsed),
+		Input:    itx.Input,
+		Error:    itx.Error,
+		Stamp:    itx.TimeStamp,
+	}
+
+	if itx.To != nil {
+		to := itx.To.String()
+		row.To = &to
+	}
+	return bson.Marshal(row)
+}
+
+// UnmarshalBSON updates the value from BSON source.
+func (itx *InternalTransaction) UnmarshalBSON(data []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("can not decode internal transaction")
+		}
+	}()
+
+	// try to decode the BSON data
+	var row BsonInternalTransaction
+	if err = bson.Unmarshal(data, &row); err != nil {
+		return err
+	}
+
+	// copy the data
+	itx.Transaction = common.HexToHash(row.Trx)
+	itx.BlockNumber = hexutil.Uint64(row.Block)
+	itx.TrxIndex = hexutil.Uint64(row.TrxIndex)
+	itx.Position = row.Position
+	itx.TraceAddress = row.Trace
+	itx.Type = row.Type
+	itx.From = common.HexToAddress(row.From)
+	itx.Gas = hexutil.Uint64(row.Gas)
+	itx.GasUsed = hexutil.Uint64(row.GasUsed)
+	itx.Input = row.Input
+	itx.Error = row.Error
+	itx.TimeStamp = row.Stamp
+
+	if row.To != nil {
+		to := common.HexToAddress(*row.To)
+		itx.To = &to
+	}
+
+	itx.Value = hexutil.Big{}
+	if val, ok := new(big.Int).SetString(strings.TrimPrefix(row.Value, "0x"), 16); ok {
+		itx.Value = hexutil.Big(*val)
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+// Package types implements different core types of the API.
+package types
+
+// ListSort represents a sort order of a paginated list.
+// Items with the same value of the sort field are ordered by the list ordinal.
+type ListSort struct {
+	// Field is the name of the document field the list is sorted by.
+	Field string
+
+	// Ascending starts the list with the lowest value of the field.
+	Ascending bool
+}
@@ -0,0 +1,186 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"strings"
+)
+
+// LogEvent represents a decoded event of a transaction log record.
+type LogEvent struct {
+	// Event is the name of the event.
+	Event string
+
+	// Signature is the canonical signature of the event, i.e. Transfer(address,address,uint256).
+	Signature string
+
+	// Topic is the hash of the event signature.
+	Topic common.Hash
+
+	// Verified signals the event was decoded by the ABI of the verified emitting contract
+	// and not by the table of known event signatures.
+	Verified bool
+
+	// Args is the list of decoded event arguments in the order of the event signature.
+	Args []ContractEventArg
+}
+
+// eventParam represents a single parameter of a human-readable event signature.
+type eventParam struct {
+	typ     string
+	name    string
+	indexed bool
+}
+
+// ParseEventSignature parses the given human-readable event signature,
+// i.e. Transfer(address indexed from, address indexed to, uint256 value),
+// into an ABI event definition. Unnamed parameters are named by their position.
+func ParseEventSignature(sig string) (*abi.Event, error) {
+	sig = strings.TrimSpace(sig)
+	open := strings.Index(sig, "(")
+	if open < 1 || !strings.HasSuffix(sig, ")") {
+		return nil, fmt.Errorf("invalid event signature %s", sig)
+	}
+
+	params, err := eventParams(sig[open+1 : len(sig)-1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid event signature %s; %s", sig, err.Error())
+	}
+
+	// the canonical signature is parsed as a function selector to get the parameter types
+	typ := make([]string, len(params))
+	for i, p := range params {
+		typ[i] = p.typ
+	}
+	sm, err := abi.ParseSelector(fmt.Sprintf("%s(%s)", sig[:open], strings.Join(typ, ",")))
+	if err != nil {
+		return nil, err
+	}
+
+	abiPositionalNames(sm.Inputs)
+	for i, p := range params {
+		if p.name != "" {
+			sm.Inputs[i].Name = p.name
+		}
+		sm.Inputs[i].Indexed = p.indexed
+	}
+	sm.Type = "event"
+
+	data, err := json.Marshal([]abi.SelectorMarshaling{sm})
+	if err != nil {
+		return nil, err
+	}
+
+	def, err := abi.JSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	for _, ev := range def.Events {
+		return &ev, nil
+	}
+	return nil, fmt.Errorf("invalid event signature %s", sig)
+}
+
+// eventParams splits the list of parameters of a human-readable event signature.
+func eventParams(list string) ([]eventParam, error) {
+	res := make([]eventParam, 0)
+	if strings.TrimSpace(list) == "" {
+		return res, nil
+	}
+
+	var depth, start int
+	for i := 0; i <= len(list); i++ {
+		if i < len(list) {
+			switch list[i] {
+			case '(':
+				depth++
+				continue
+			case ')':
+				depth--
+				continue
+			case ',':
+				if depth > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+
+		p, err := eventParamOf(strings.TrimSpace(list[start:i]))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+		start = i + 1
+	}
+	return res, nil
+}
+
+// eventParamOf parses a single parameter of a human-readable event signature.
+func eventParamOf(s string) (eventParam, error) {
+	// tuple types may contain spaces
+	end := strings.IndexAny(s, " \t")
+	if strings.HasPrefix(s, "(") {
+		end = strings.LastIndex(s, ")") + 1
+		if sp := strings.IndexAny(s[end:], " \t"); sp >= 0 {
+			end += sp
+		} else {
+			end = -1
+		}
+	}
+	if end < 0 {
+		end = len(s)
+	}
+
+	p := eventParam{typ: s[:end]}
+	if p.typ == "" {
+		return p, fmt.Errorf("missing parameter type")
+	}
+
+	for _, word := range strings.Fields(s[end:]) {
+		switch {
+		case word == "indexed" && !p.indexed && p.name == "":
+			p.indexed = true
+		case p.name == "":
+			p.name = word
+		default:
+			return p, fmt.Errorf("invalid parameter %s", s)
+		}
+	}
+	return p, nil
+}
+
+// DecodeLogEvent decodes the given log record by the given ABI event definition.
+// The event must match the topic and the number of indexed arguments of the record.
+func DecodeLogEvent(ev *abi.Event, topics []common.Hash, data []byte, verified bool) (*LogEvent, error) {
+	if ev.Anonymous || len(topics) == 0 || topics[0] != ev.ID {
+		return nil, fmt.Errorf("log record is not %s", ev.Sig)
+	}
+
+	var indexed int
+	for _, arg := range ev.Inputs {
+		if arg.Indexed {
+			indexed++
+		}
+	}
+	if indexed != len(topics)-1 {
+		return nil, fmt.Errorf("log record has %d indexed arguments, %s expects %d", len(topics)-1, ev.Sig, indexed)
+	}
+
+	args, err := DecodeAbiArguments(ev.Inputs, topics[1:], data)
+	if err != nil {
+		return nil, err
+	}
+	return &LogEvent{
+		Event:     ev.RawName,
+		Signature: ev.Sig,
+		Topic:     ev.ID,
+		Verified:  verified,
+		Args:      args,
+	}, nil
+}
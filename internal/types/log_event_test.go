@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
+	"testing"
+)
+
+func TestParseEventSignature(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	ev, err := ParseEventSignature("Transfer(address indexed from, address indexed to, uint256 value)")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(ev.Sig).To(gomega.Equal("Transfer(address,address,uint256)"))
+	g.Expect(ev.ID).To(gomega.Equal(common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")))
+	g.Expect(ev.Inputs[0].Name).To(gomega.Equal("from"))
+	g.Expect(ev.Inputs[0].Indexed).To(gomega.BeTrue())
+	g.Expect(ev.Inputs[2].Indexed).To(gomega.BeFalse())
+
+	// unnamed parameters and tuples
+	ev, err = ParseEventSignature("Executed(uint256 indexed, (address,uint256)[] calls)")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(ev.Sig).To(gomega.Equal("Executed(uint256,(address,uint256)[])"))
+	g.Expect(ev.Inputs[0].Name).To(gomega.Equal("arg0"))
+	g.Expect(ev.Inputs[1].Name).To(gomega.Equal("calls"))
+
+	_, err = ParseEventSignature("Transfer(address indexed from to)")
+	g.Expect(err).NotTo(gomega.BeNil())
+}
+
+func TestDecodeLogEvent(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	erc20, err := ParseEventSignature("Transfer(address indexed from, address indexed to, uint256 value)")
+	g.Expect(err).To(gomega.BeNil())
+	erc721, err := ParseEventSignature("Transfer(address indexed from, address indexed to, uint256 indexed tokenId)")
+	g.Expect(err).To(gomega.BeNil())
+
+	// ERC-20 transfer of 1 token
+	from := common.HexToAddress("0x5aa5")
+	to := common.HexToAddress("0xa55a")
+	topics := []common.Hash{erc20.ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())}
+	data := hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000de0b6b3a7640000")
+
+	le, err := DecodeLogEvent(erc20, topics, data, false)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(le.Event).To(gomega.Equal("Transfer"))
+	g.Expect(le.Args).To(gomega.Equal([]ContractEventArg{
+		{Name: "from", Type: "address", Value: from.String(), Indexed: true},
+		{Name: "to", Type: "address", Value: to.String(), Indexed: true},
+		{Name: "value", Type: "uint256", Value: "1000000000000000000", Indexed: false},
+	}))
+
+	// the ERC-721 transfer shares the topic, but not the indexed arguments
+	_, err = DecodeLogEvent(erc721, topics, data, false)
+	g.Expect(err).NotTo(gomega.BeNil())
+}
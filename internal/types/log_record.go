@@ -11,5 +11,15 @@ type LogRecord struct {
 	WatchDog *sync.WaitGroup
 	Block    *Block
 	Trx      *Transaction
+	Batch    BlockWriter
 	retypes.Log
 }
+
+// BlockWriter represents a writer of the data collected from a block.
+type BlockWriter interface {
+	// StoreTokenTransaction stores the given token transaction.
+	StoreTokenTransaction(*TokenTransaction) error
+
+	// StoreRewardClaim stores the given reward claim.
+	StoreRewardClaim(*RewardClaim) error
+}
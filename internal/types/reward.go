@@ -7,7 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"go.mongodb.org/mongo-driver/bson"
-	"math/big"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"time"
 )
 
@@ -20,10 +20,6 @@ const (
 	FiRewardClaimedTimeStamp = "stamp"
 )
 
-// RewardDecimalsCorrection is used to manipulate precision of a rewards value,
-// so it can be stored in database as UINT64 without loosing too much data
-var RewardDecimalsCorrection = new(big.Int).SetUint64(1000000000)
-
 // RewardClaim represents a reward claim record in Opera staking
 // SFC contract. A reward can be claimed directly towards the account balance,
 // or it can be re-staked into the SFC contract as an increased delegation.
@@ -38,15 +34,15 @@ type RewardClaim struct {
 
 // BsonRewardClaim represents BSON rew structure of the reward claim.
 type BsonRewardClaim struct {
-	ID        string    `bson:"_id"`
-	Ordinal   uint64    `bson:"orx"`
-	Addr      string    `bson:"addr"`
-	To        string    `bson:"to"`
-	ClaimTime uint64    `bson:"when"`
-	TimeStamp time.Time `bson:"stamp"`
-	Amount    string    `bson:"amount"`
-	Value     uint64    `bson:"value"`
-	IsDlg     bool      `bson:"red"`
+	ID        string               `bson:"_id"`
+	Ordinal   uint64               `bson:"orx"`
+	Addr      string               `bson:"addr"`
+	To        string               `bson:"to"`
+	ClaimTime uint64               `bson:"when"`
+	TimeStamp time.Time            `bson:"stamp"`
+	Amount    string               `bson:"amount"`
+	Value     primitive.Decimal128 `bson:"value"`
+	IsDlg     bool                 `bson:"red"`
 }
 
 // Pk returns a unique primary key of the claim.
@@ -61,10 +57,8 @@ func (rwc *RewardClaim) OrdinalIndex() uint64 {
 
 // MarshalBSON creates a BSON representation of the reward claim request record.
 func (rwc *RewardClaim) MarshalBSON() ([]byte, error) {
-	// calculate the value to 9 digits (and 18 billions remain available)
-	val := new(big.Int).Div(rwc.Amount.ToInt(), RewardDecimalsCorrection)
-
 	// prep the structure for saving
+	var dec decimalRow
 	pom := BsonRewardClaim{
 		ID:        rwc.Pk(),
 		Ordinal:   rwc.OrdinalIndex(),
@@ -73,9 +67,12 @@ func (rwc *RewardClaim) MarshalBSON() ([]byte, error) {
 		ClaimTime: uint64(rwc.Claimed),
 		TimeStamp: time.Unix(int64(rwc.Claimed), 0),
 		Amount:    rwc.Amount.String(),
-		Value:     val.Uint64(),
+		Value:     dec.of(rwc.Amount.ToInt()),
 		IsDlg:     rwc.IsDelegated,
 	}
+	if dec.err != nil {
+		return nil, dec.err
+	}
 	return bson.Marshal(pom)
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	retypes "github.com/ethereum/go-ethereum/core/types"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"math/big"
 	"time"
 )
@@ -95,27 +96,28 @@ type BsonLog struct {
 
 // BsonTransaction represents the transaction data structure for BSON formatting.
 type BsonTransaction struct {
-	Hash       string    `bson:"_id"`
-	Ordinal    uint64    `bson:"orx"`
-	BlockID    *uint64   `bson:"blk"`
-	BlockHash  *string   `bson:"blk_h"`
-	BlkIndex   *uint64   `bson:"bix"`
-	From       string    `bson:"from"`
-	To         *string   `bson:"to"`
-	Value      string    `bson:"value"`
-	Amount     int64     `bson:"amo"`
-	LargeInput bool      `bson:"large"`
-	Input      []byte    `bson:"input"`
-	Gas        int64     `bson:"gas_lim"`
-	UsedGas    *uint64   `bson:"gas_use"`
-	CumGas     *uint64   `bson:"gas_cum"`
-	GasPrice   string    `bson:"gas_pri"`
-	GasGWei    int64     `bson:"gwx100"`
-	Nonce      int64     `bson:"nonce"`
-	Contract   *string   `bson:"contr"`
-	Status     uint64    `bson:"stat"`
-	Stamp      time.Time `bson:"stamp"`
-	Logs       []BsonLog `bson:"logs"`
+	Hash       string               `bson:"_id"`
+	Ordinal    uint64               `bson:"orx"`
+	BlockID    *uint64              `bson:"blk"`
+	BlockHash  *string              `bson:"blk_h"`
+	BlkIndex   *uint64              `bson:"bix"`
+	From       string               `bson:"from"`
+	To         *string              `bson:"to"`
+	Value      string               `bson:"value"`
+	Amount     primitive.Decimal128 `bson:"amo"`
+	LargeInput bool                 `bson:"large"`
+	Input      []byte               `bson:"input"`
+	Selector   *string              `bson:"sel,omitempty"`
+	Gas        int64                `bson:"gas_lim"`
+	UsedGas    *uint64              `bson:"gas_use"`
+	CumGas     *uint64              `bson:"gas_cum"`
+	GasPrice   string               `bson:"gas_pri"`
+	GasWei     primitive.Decimal128 `bson:"gas_wei"`
+	Nonce      int64                `bson:"nonce"`
+	Contract   *string              `bson:"contr"`
+	Status     uint64               `bson:"stat"`
+	Stamp      time.Time            `bson:"stamp"`
+	Logs       []BsonLog            `bson:"logs"`
 }
 
 // Uid calculates an ordinal index of the transaction referenced.
@@ -138,30 +140,36 @@ func (trx *Transaction) Marshal() ([]byte, error) {
 
 // MarshalBSON creates a BSON representation of the Transaction record.
 func (trx *Transaction) MarshalBSON() ([]byte, error) {
-	// calculate the value to 9 digits (and 18 billions remain available)
-	val := new(big.Int).Div(trx.Value.ToInt(), TransactionDecimalsCorrection)
-	gWei := new(big.Int).Div(trx.GasPrice.ToInt(), TransactionGasCorrection)
-
 	// prep the structure for saving
+	var dec decimalRow
 	pom := BsonTransaction{
 		Hash:       trx.Hash.String(),
 		Ordinal:    trx.Uid(),
 		From:       trx.From.String(),
 		Gas:        int64(trx.Gas),
 		GasPrice:   trx.GasPrice.String(),
-		GasGWei:    gWei.Int64(),
+		GasWei:     dec.of(trx.GasPrice.ToInt()),
 		Nonce:      int64(trx.Nonce),
 		Value:      trx.Value.String(),
-		Amount:     val.Int64(),
+		Amount:     dec.of(trx.Value.ToInt()),
 		LargeInput: len(trx.InputData) > trxLargeInputWall,
 		Stamp:      trx.TimeStamp,
 	}
+	if dec.err != nil {
+		return nil, dec.err
+	}
 
 	// store the input data along with the trx
 	if !pom.LargeInput {
 		pom.Input = trx.InputData
 	}
 
+	// method selector of contract calls, even with large input
+	if sel := TrxSelectorOf(trx.To, trx.InputData); sel != nil {
+		s := sel.String()
+		pom.Selector = &s
+	}
+
 	// transaction has been mined, we have all the extra info, too
 	if trx.BlockHash != nil {
 		// block hash
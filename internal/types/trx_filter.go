@@ -0,0 +1,67 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+	"time"
+)
+
+// TransactionFilter represents a filter of stored transactions.
+// Empty conditions are not applied; ranges include their borders.
+type TransactionFilter struct {
+	// From is the address of the sender.
+	From *common.Address
+
+	// To is the address of the recipient.
+	To *common.Address
+
+	// Party is the address of either the sender, or the recipient.
+	Party *common.Address
+
+	// ContractCreated selects transactions deploying a contract, or the other ones.
+	ContractCreated *bool
+
+	// MinValue and MaxValue limit the transferred value in WEI.
+	MinValue *big.Int
+	MaxValue *big.Int
+
+	// MinTime and MaxTime limit the time stamp of the transaction.
+	MinTime *time.Time
+	MaxTime *time.Time
+
+	// MinBlock and MaxBlock limit the number of the block of the transaction.
+	MinBlock *uint64
+	MaxBlock *uint64
+
+	// Status is the status of the transaction; 1 for success, 0 for failure.
+	Status *uint64
+
+	// Selector is the 4 bytes selector of the called contract method.
+	Selector *TrxSelector
+
+	// MinGasPrice and MaxGasPrice limit the gas price in WEI.
+	MinGasPrice *big.Int
+	MaxGasPrice *big.Int
+}
+
+// TrxSelector represents the 4 bytes selector of a contract method called by a transaction.
+type TrxSelector [4]byte
+
+// String returns the hex encoded selector.
+func (sel TrxSelector) String() string {
+	return hexutil.Encode(sel[:])
+}
+
+// TrxSelectorOf provides the method selector of a contract call with the given input data.
+// Contract deployments and calls without a full selector do not have any.
+func TrxSelectorOf(to *common.Address, input []byte) *TrxSelector {
+	if to == nil || len(input) < len(TrxSelector{}) {
+		return nil
+	}
+
+	var sel TrxSelector
+	copy(sel[:], input)
+	return &sel
+}
@@ -0,0 +1,122 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"strings"
+)
+
+// TrxMethod represents a contract method called by a transaction.
+type TrxMethod struct {
+	// Name is the name of the called method.
+	Name string
+
+	// Signature is the canonical signature of the method, i.e. transfer(address,uint256).
+	Signature string
+
+	// Selector is the 4 bytes selector of the method.
+	Selector TrxSelector
+
+	// Verified signals the method was resolved from the ABI of a verified contract
+	// and not guessed from a database of known function signatures.
+	Verified bool
+
+	// Abi is the ABI definition of the method used to decode the call input.
+	Abi *abi.Method `json:"-"`
+}
+
+// TrxCallArg represents a single decoded argument of a contract call.
+// Numbers are provided as decimal strings, addresses, hashes and byte arrays are hex encoded,
+// tuples are provided as objects keyed by the names of their components.
+type TrxCallArg struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// NewTrxMethod creates the called method record from the given ABI method definition.
+func NewTrxMethod(m *abi.Method, verified bool) *TrxMethod {
+	var sel TrxSelector
+	copy(sel[:], m.ID)
+
+	return &TrxMethod{
+		Name:      m.RawName,
+		Signature: m.Sig,
+		Selector:  sel,
+		Verified:  verified,
+		Abi:       m,
+	}
+}
+
+// ParseMethodSignature parses the given canonical function signature, i.e. transfer(address,uint256),
+// into an ABI method definition. Arguments of the method are named by their position.
+func ParseMethodSignature(sig string) (*abi.Method, error) {
+	sm, err := abi.ParseSelector(strings.TrimSpace(sig))
+	if err != nil {
+		return nil, err
+	}
+	abiPositionalNames(sm.Inputs)
+
+	data, err := json.Marshal([]abi.SelectorMarshaling{sm})
+	if err != nil {
+		return nil, err
+	}
+
+	def, err := abi.JSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range def.Methods {
+		return &m, nil
+	}
+	return nil, fmt.Errorf("invalid function signature %s", sig)
+}
+
+// abiPositionalNames names the given arguments and their tuple components by their position.
+func abiPositionalNames(args []abi.ArgumentMarshaling) {
+	for i := range args {
+		args[i].Name = fmt.Sprintf("arg%d", i)
+		abiPositionalNames(args[i].Components)
+	}
+}
+
+// DecodeCallInput decodes arguments of the given method from the call input, including the selector.
+func DecodeCallInput(m *abi.Method, input []byte) ([]TrxCallArg, error) {
+	if len(input) < len(TrxSelector{}) || !bytes.Equal(input[:len(TrxSelector{})], m.ID) {
+		return nil, fmt.Errorf("input does not call %s", m.Sig)
+	}
+
+	values, err := m.Inputs.Unpack(input[len(TrxSelector{}):])
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]TrxCallArg, len(m.Inputs))
+	for i, arg := range m.Inputs {
+		name := arg.Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		list[i] = TrxCallArg{Name: name, Type: arg.Type.String(), Value: AbiJsonValue(values[i])}
+	}
+	return list, nil
+}
+
+// IsCallInputOf checks if the call input is the exact encoding of a call of the given method.
+// Different functions may share the selector, but their arguments rarely encode the same way.
+func IsCallInputOf(m *abi.Method, input []byte) bool {
+	if len(input) < len(TrxSelector{}) || !bytes.Equal(input[:len(TrxSelector{})], m.ID) {
+		return false
+	}
+
+	values, err := m.Inputs.Unpack(input[len(TrxSelector{}):])
+	if err != nil {
+		return false
+	}
+
+	data, err := m.Inputs.Pack(values...)
+	return err == nil && bytes.Equal(data, input[len(TrxSelector{}):])
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
+	"testing"
+)
+
+func TestParseMethodSignature(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	m, err := ParseMethodSignature("transfer(address,uint256)")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(m.RawName).To(gomega.Equal("transfer"))
+	g.Expect(m.Sig).To(gomega.Equal("transfer(address,uint256)"))
+	g.Expect(hexutil.Encode(m.ID)).To(gomega.Equal("0xa9059cbb"))
+
+	// tuples are supported
+	m, err = ParseMethodSignature("aggregate((address,bytes)[])")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(hexutil.Encode(m.ID)).To(gomega.Equal("0x252dba42"))
+
+	_, err = ParseMethodSignature("transfer(address,uint256")
+	g.Expect(err).NotTo(gomega.BeNil())
+}
+
+func TestDecodeCallInput(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	m, err := ParseMethodSignature("transfer(address,uint256)")
+	g.Expect(err).To(gomega.BeNil())
+
+	// transfer of 1 FTM to the given address
+	to := common.HexToAddress("0x5aa5000000000000000000000000000000005aa5")
+	input := hexutil.MustDecode("0xa9059cbb" +
+		"0000000000000000000000005aa5000000000000000000000000000000005aa5" +
+		"0000000000000000000000000000000000000000000000000de0b6b3a7640000")
+
+	args, err := DecodeCallInput(m, input)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(args).To(gomega.Equal([]TrxCallArg{
+		{Name: "arg0", Type: "address", Value: to.String()},
+		{Name: "arg1", Type: "uint256", Value: "1000000000000000000"},
+	}))
+	g.Expect(IsCallInputOf(m, input)).To(gomega.BeTrue())
+
+	// trailing data do not belong to the call
+	g.Expect(IsCallInputOf(m, append(input, 0x01))).To(gomega.BeFalse())
+
+	// different selector
+	_, err = DecodeCallInput(m, hexutil.MustDecode("0x095ea7b3"))
+	g.Expect(err).NotTo(gomega.BeNil())
+
+	g.Expect(TrxSelectorOf(&to, input).String()).To(gomega.Equal("0xa9059cbb"))
+	g.Expect(TrxSelectorOf(nil, input)).To(gomega.BeNil())
+}
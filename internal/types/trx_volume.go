@@ -1,14 +1,15 @@
 package types
 
 import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"time"
 )
 
 // DailyTrxVolume represents a volume of daily transaction aggregation.
 type DailyTrxVolume struct {
-	Day            string    `bson:"_id"`
-	Stamp          time.Time `bson:"stamp"`
-	Counter        int64     `bson:"value"`
-	AmountAdjusted int64     `bson:"volume"`
-	Gas            int64     `bson:"gas"`
+	Day     string               `bson:"_id"`
+	Stamp   time.Time            `bson:"stamp"`
+	Counter int64                `bson:"value"`
+	Amount  primitive.Decimal128 `bson:"volume"`
+	Gas     int64                `bson:"gas"`
 }
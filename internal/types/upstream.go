@@ -0,0 +1,34 @@
+// Package types implements different core types of the API.
+package types
+
+import "time"
+
+// UpstreamStatus represents the status of an upstream node of the node pool.
+type UpstreamStatus struct {
+	// Name represents the name of the upstream node.
+	Name string `json:"name"`
+
+	// Weight represents the relative share of calls routed to the node.
+	Weight int `json:"weight"`
+
+	// Archive marks the node serves calls on historical state.
+	Archive bool `json:"archive"`
+
+	// Healthy marks the node is reachable and not lagging behind the pool head.
+	Healthy bool `json:"healthy"`
+
+	// Height represents the last block height reported by the node.
+	Height uint64 `json:"height"`
+
+	// Lag represents the number of blocks the node is behind the pool head.
+	Lag uint64 `json:"lag"`
+
+	// Failures represents the number of failed calls of the node.
+	Failures uint64 `json:"failures"`
+
+	// Checked represents the time of the last health check of the node.
+	Checked time.Time `json:"checked"`
+
+	// Error represents the error of the last failed health check, or call.
+	Error string `json:"error,omitempty"`
+}
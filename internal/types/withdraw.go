@@ -7,7 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"go.mongodb.org/mongo-driver/bson"
-	"math/big"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"time"
 )
 
@@ -53,26 +53,22 @@ type WithdrawRequest struct {
 
 // BsonWithdrawRequest represents a structure of withdraw request in BSON format.
 type BsonWithdrawRequest struct {
-	ID      string    `bson:"_id"`
-	Ordinal uint64    `bson:"orx"`
-	ReqID   string    `bson:"req_id"`
-	Addr    string    `bson:"adr"`
-	To      string    `bson:"to"`
-	CrTime  uint64    `bson:"crt"`
-	Stamp   time.Time `bson:"stamp"`
-	Amount  string    `bson:"amo"`
-	Penalty *string   `bson:"slash"`
-	Value   uint64    `bson:"val"`
-	ReqTrx  string    `bson:"req_trx"`
-	FinTrx  *string   `bson:"fin_trx"`
-	FinTime *uint64   `bson:"fin_time"`
-	Type    string    `bson:"type"`
+	ID      string               `bson:"_id"`
+	Ordinal uint64               `bson:"orx"`
+	ReqID   string               `bson:"req_id"`
+	Addr    string               `bson:"adr"`
+	To      string               `bson:"to"`
+	CrTime  uint64               `bson:"crt"`
+	Stamp   time.Time            `bson:"stamp"`
+	Amount  string               `bson:"amo"`
+	Penalty *string              `bson:"slash"`
+	Value   primitive.Decimal128 `bson:"val"`
+	ReqTrx  string               `bson:"req_trx"`
+	FinTrx  *string              `bson:"fin_trx"`
+	FinTime *uint64              `bson:"fin_time"`
+	Type    string               `bson:"type"`
 }
 
-// WithdrawDecimalsCorrection is used to manipulate precision of a withdrawal value
-// so it can be stored in database as UINT64 without loosing too much data
-var WithdrawDecimalsCorrection = new(big.Int).SetUint64(1000000000)
-
 // OrdinalIndex returns an ordinal index of the withdraw request.
 func (wr *WithdrawRequest) OrdinalIndex() uint64 {
 	return (uint64(wr.CreatedTime)&0xFFFFFFFFFF)<<24 | (wr.StakerID.ToInt().Uint64()&0xFFF)<<12 | (binary.BigEndian.Uint64(wr.RequestTrx[:8]) & 0xFFF)
@@ -80,10 +76,8 @@ func (wr *WithdrawRequest) OrdinalIndex() uint64 {
 
 // MarshalBSON returns a BSON document for the withdrawal request.
 func (wr *WithdrawRequest) MarshalBSON() ([]byte, error) {
-	// calculate the value to 9 digits (and 18 billions remain available)
-	val := new(big.Int).Div(wr.Amount.ToInt(), WithdrawDecimalsCorrection)
-
 	// prep the structure for saving
+	var dec decimalRow
 	pom := BsonWithdrawRequest{
 		ID:      wr.RequestTrx.String(),
 		ReqTrx:  wr.RequestTrx.String(),
@@ -94,9 +88,12 @@ func (wr *WithdrawRequest) MarshalBSON() ([]byte, error) {
 		CrTime:  uint64(wr.CreatedTime),
 		Stamp:   time.Unix(int64(wr.CreatedTime), 0),
 		Amount:  wr.Amount.String(),
-		Value:   val.Uint64(),
+		Value:   dec.of(wr.Amount.ToInt()),
 		Type:    wr.Type,
 	}
+	if dec.err != nil {
+		return nil, dec.err
+	}
 	if wr.WithdrawTrx != nil {
 		val := wr.WithdrawTrx.String()
 		pom.FinTrx = &val